@@ -5,18 +5,50 @@ import (
 	"os"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	gitDomain "github.com/azisuazusa/todo-cli/internal/domain/git"
+	integrationDomain "github.com/azisuazusa/todo-cli/internal/domain/integration"
 	jiraDomain "github.com/azisuazusa/todo-cli/internal/domain/jira"
 	projectDomain "github.com/azisuazusa/todo-cli/internal/domain/project"
+	reportDomain "github.com/azisuazusa/todo-cli/internal/domain/report"
+	slackDomain "github.com/azisuazusa/todo-cli/internal/domain/slack"
+	standupDomain "github.com/azisuazusa/todo-cli/internal/domain/standup"
 	syncintegrationDomain "github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
 	taskDomain "github.com/azisuazusa/todo-cli/internal/domain/task"
+	transferDomain "github.com/azisuazusa/todo-cli/internal/domain/transfer"
+	gitPresenter "github.com/azisuazusa/todo-cli/internal/presenter/git"
 	projectPresenter "github.com/azisuazusa/todo-cli/internal/presenter/project"
+	reportPresenter "github.com/azisuazusa/todo-cli/internal/presenter/report"
 	settingPresenter "github.com/azisuazusa/todo-cli/internal/presenter/setting"
+	standupPresenter "github.com/azisuazusa/todo-cli/internal/presenter/standup"
+	statusPresenter "github.com/azisuazusa/todo-cli/internal/presenter/status"
 	taskPresenter "github.com/azisuazusa/todo-cli/internal/presenter/task"
+	transferPresenter "github.com/azisuazusa/todo-cli/internal/presenter/transfer"
+	tuiPresenter "github.com/azisuazusa/todo-cli/internal/presenter/tui"
+	"github.com/azisuazusa/todo-cli/internal/repository/backup"
+	"github.com/azisuazusa/todo-cli/internal/repository/caldav"
+	"github.com/azisuazusa/todo-cli/internal/repository/database"
 	"github.com/azisuazusa/todo-cli/internal/repository/dropbox"
+	"github.com/azisuazusa/todo-cli/internal/repository/encryption"
+	"github.com/azisuazusa/todo-cli/internal/repository/event"
+	"github.com/azisuazusa/todo-cli/internal/repository/git"
+	"github.com/azisuazusa/todo-cli/internal/repository/gitlab"
+	"github.com/azisuazusa/todo-cli/internal/repository/hook"
+	"github.com/azisuazusa/todo-cli/internal/repository/idle"
 	"github.com/azisuazusa/todo-cli/internal/repository/jira"
+	"github.com/azisuazusa/todo-cli/internal/repository/linear"
+	"github.com/azisuazusa/todo-cli/internal/repository/note"
+	"github.com/azisuazusa/todo-cli/internal/repository/plugin"
 	projectRepository "github.com/azisuazusa/todo-cli/internal/repository/project"
+	reportRepository "github.com/azisuazusa/todo-cli/internal/repository/report"
+	"github.com/azisuazusa/todo-cli/internal/repository/secret"
 	settingRepository "github.com/azisuazusa/todo-cli/internal/repository/setting"
+	"github.com/azisuazusa/todo-cli/internal/repository/slack"
 	taskRepository "github.com/azisuazusa/todo-cli/internal/repository/task"
+	"github.com/azisuazusa/todo-cli/internal/repository/taskwarrior"
+	"github.com/azisuazusa/todo-cli/internal/repository/todotxt"
+	"github.com/azisuazusa/todo-cli/internal/repository/transaction"
+	"github.com/azisuazusa/todo-cli/internal/repository/webhook"
+	"github.com/manifoldco/promptui"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/urfave/cli/v2"
 )
@@ -27,40 +59,136 @@ func TodoCLI() *cli.App {
 		panic(err)
 	}
 
-	db, err := sql.Open("sqlite3", homeDir+"/.todo-cli.db")
+	// Transactions take the write lock up front, so a concurrent invocation
+	// waits for the busy timeout instead of failing halfway
+	db, err := sql.Open("sqlite3", homeDir+"/.todo-cli.db?_txlock=immediate")
 	if err != nil {
 		panic(err)
 	}
 
 	// Repositories
-	settingRepo := settingRepository.New(db)
+	secretRepo := secretRepository(homeDir)
+	settingRepo := settingRepository.New(db, secretRepo)
 	taskRepo := taskRepository.New(db)
-	projectRepo := projectRepository.New(db)
-	jiraRepo := jira.New()
+	noteRepo := note.New(db)
+	transactionRepo := transaction.New(db)
+	gitRepo := git.New("")
+	projectRepo := projectRepository.New(db, secretRepo)
+	jiraRepo := jira.New(projectRepo, secretRepo)
+	databaseRepo := database.New(homeDir+"/.todo-cli.db", homeDir+"/.todo-cli-remote.db")
+	encryptionRepo := encryption.New(secretRepo)
+	slackRepo := slack.New(secretRepo)
+	gitlabRepo := gitlab.New(secretRepo)
+	linearRepo := linear.New(secretRepo)
+	caldavRepo := caldav.New(taskRepo, secretRepo)
+	configDir := homeDir + "/.config/todo-cli"
+	webhookRepo := webhook.New(configDir+"/webhooks.json", secretRepo)
+	eventBus := event.NewBus(
+		webhookRepo,
+		hook.New(configDir+"/hooks"),
+	)
 	settingIntegrationRepo := map[syncintegrationDomain.SyncIntegrationType]syncintegrationDomain.IntegrationRepository{
-		syncintegrationDomain.Dropbox: dropbox.New(settingRepo),
+		syncintegrationDomain.Dropbox: dropbox.New(settingRepo, secretRepo),
 	}
-	projectIntegrationRepo := map[entity.IntegrationType]projectDomain.IntegrationRepository{
-		entity.IntegrationTypeJIRA: jiraRepo,
+	// Integrations are offered to the user in this order
+	integrationRegistry := integrationDomain.NewRegistry(jiraRepo, gitlabRepo, linearRepo, caldavRepo, slackRepo)
+	// External integrations are todo-integration-<name> executables on PATH,
+	// PATH is only scanned by the commands looking up an integration
+	integrationRegistry.Discover(func() []integrationDomain.Provider {
+		var providers []integrationDomain.Provider
+		for _, pluginRepo := range plugin.Discover(os.Getenv("PATH"), secretRepo) {
+			providers = append(providers, pluginRepo)
+		}
+
+		return providers
+	})
+	idleRepo := map[entity.IdleSourceType]taskDomain.IdleRepository{
+		entity.IdleSourceX11:   idle.NewX11(),
+		entity.IdleSourceGNOME: idle.NewGNOME(),
+		entity.IdleSourceFile:  idle.NewFile(),
+	}
+	transferFormatRepo := map[entity.TransferFormat]transferDomain.FormatRepository{
+		entity.TransferFormatJSON:        backup.New(),
+		entity.TransferFormatTodoTxt:     todotxt.New(),
+		entity.TransferFormatTaskwarrior: taskwarrior.New(),
+	}
+	reportFormatRepo := map[entity.ReportFormat]reportDomain.FormatRepository{
+		entity.ReportFormatMarkdown: reportRepository.NewMarkdown(configDir + "/templates"),
+		entity.ReportFormatHTML:     reportRepository.NewHTML(configDir + "/templates"),
 	}
 
 	// UseCases
-	taskUseCase := taskDomain.New(taskRepo, projectRepo)
-	settingUseCase := syncintegrationDomain.New(settingRepo, settingIntegrationRepo)
-	projectUseCase := projectDomain.New(projectRepo, projectIntegrationRepo, taskRepo)
+	taskUseCase := taskDomain.New(taskRepo, projectRepo, eventBus, noteRepo, settingRepo, idleRepo, transactionRepo)
+	settingUseCase := syncintegrationDomain.New(settingRepo, settingIntegrationRepo, projectRepo, databaseRepo, encryptionRepo, transactionRepo)
+	projectUseCase := projectDomain.New(projectRepo, integrationRegistry, taskRepo, transactionRepo)
+	integrationUseCase := integrationDomain.New(integrationRegistry, projectRepo, taskRepo)
 	jiraUseCase := jiraDomain.New(jiraRepo, projectRepo, taskRepo)
+	slackUseCase := slackDomain.New(slackRepo, projectRepo)
+	gitUseCase := gitDomain.New(gitRepo, taskRepo, noteRepo)
+	transferUseCase := transferDomain.New(transferFormatRepo, projectRepo, taskRepo, noteRepo, settingRepo, transactionRepo)
+	reportUseCase := reportDomain.New(reportFormatRepo, projectRepo, taskRepo)
+	standupUseCase := standupDomain.New(reportRepository.NewStandup(configDir+"/templates"), webhookRepo, projectRepo, taskRepo)
 
 	// Presenters
-	taskPresenter := taskPresenter.New(taskUseCase, settingUseCase, jiraUseCase)
+	taskPresenter := taskPresenter.New(taskUseCase, settingUseCase, jiraUseCase, slackUseCase, integrationUseCase)
 	settingPresenter := settingPresenter.New(settingUseCase)
-	projectPresenter := projectPresenter.New(projectUseCase, settingUseCase, taskUseCase)
+	projectPresenter := projectPresenter.New(projectUseCase, settingUseCase, taskUseCase, integrationUseCase)
+	statusPresenter := statusPresenter.New(taskUseCase)
+	gitPresenter := gitPresenter.New(gitUseCase)
+	tuiPresenter := tuiPresenter.New(taskUseCase, projectUseCase, settingUseCase, integrationUseCase, slackUseCase)
+	transferPresenter := transferPresenter.New(transferUseCase, settingUseCase)
+	reportPresenter := reportPresenter.New(reportUseCase)
+	standupPresenter := standupPresenter.New(standupUseCase)
 
 	commands := taskCLI(taskPresenter)
-	commands = append(commands, projectCLI(projectPresenter), settingCLI(settingPresenter), setupCLI(db))
+	commands = append(commands, projectCLI(projectPresenter), settingCLI(settingPresenter, taskPresenter), setupCLI(db), tuiCLI(tuiPresenter), statusCLI(statusPresenter), gitCLI(gitPresenter), timeCLI(taskPresenter), importCLI(transferPresenter), exportCLI(transferPresenter, reportPresenter), standupCLI(standupPresenter))
 	return &cli.App{
 		Name:     "todo",
 		Usage:    "todo-cli is a CLI for managing your todo list",
 		Commands: commands,
+		Before: func(c *cli.Context) error {
+			// Querying a missing database creates it empty, which todo setup
+			// would then refuse to set up
+			if _, err := os.Stat(homeDir + "/.todo-cli.db"); err != nil {
+				return nil
+			}
+
+			// todo status has to answer within STATUS_BUDGET, the schema is
+			// left to the other commands
+			if c.Args().First() == "status" {
+				return nil
+			}
+
+			// Every schema change is applied here, so databases synced from
+			// a device running an older version work with any command
+			if err := taskRepo.Migrate(c.Context); err != nil {
+				return err
+			}
+
+			return noteRepo.Migrate(c.Context)
+		},
 	}
 
 }
+
+// secretRepository picks where integration credentials are kept. The system
+// keyring is used when TODO_CLI_SECRET_BACKEND=keyring, otherwise they go to a
+// local keystore encrypted with a passphrase that is never synced.
+func secretRepository(homeDir string) secret.Repository {
+	if os.Getenv("TODO_CLI_SECRET_BACKEND") == "keyring" {
+		return secret.NewKeyring()
+	}
+
+	return secret.NewKeystore(homeDir+"/.todo-cli-secrets", func() (string, error) {
+		if passphrase := os.Getenv("TODO_CLI_PASSPHRASE"); passphrase != "" {
+			return passphrase, nil
+		}
+
+		prompt := promptui.Prompt{
+			Label: "Secret store passphrase",
+			Mask:  '*',
+		}
+
+		return prompt.Run()
+	})
+}
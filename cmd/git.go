@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"github.com/azisuazusa/todo-cli/internal/presenter/git"
+	"github.com/urfave/cli/v2"
+)
+
+func gitCLI(presenter *git.Presenter) *cli.Command {
+	return &cli.Command{
+		Name:  "git",
+		Usage: "Link git commits to tasks",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "install-hooks",
+				Usage: "Install the git hooks in the current repository",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "force",
+						Usage: "Replace existing hooks",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return presenter.InstallHooks(c.Context, c.Bool("force"))
+				},
+			},
+			{
+				Name:      "prepare-commit-msg",
+				Usage:     "Prefix the commit message with the started task, called by the hook",
+				ArgsUsage: "<message file> [source] [sha]",
+				Hidden:    true,
+				Action: func(c *cli.Context) error {
+					return presenter.PrepareCommitMsg(c.Context, c.Args().Get(0), c.Args().Get(1))
+				},
+			},
+			{
+				Name:   "post-commit",
+				Usage:  "Add the commit to the notes of the started task, called by the hook",
+				Hidden: true,
+				Action: func(c *cli.Context) error {
+					return presenter.PostCommit(c.Context)
+				},
+			},
+		},
+	}
+}
@@ -2,10 +2,11 @@ package cmd
 
 import (
 	"github.com/azisuazusa/todo-cli/internal/presenter/setting"
+	"github.com/azisuazusa/todo-cli/internal/presenter/task"
 	"github.com/urfave/cli/v2"
 )
 
-func settingCLI(presenter *setting.Presenter) *cli.Command {
+func settingCLI(presenter *setting.Presenter, taskPresenter *task.Presenter) *cli.Command {
 	return &cli.Command{
 		Name:  "setting",
 		Usage: "Manage settings",
@@ -24,6 +25,78 @@ func settingCLI(presenter *setting.Presenter) *cli.Command {
 					return presenter.SetSyncIntegration(c.Context)
 				},
 			},
+			{
+				Name:  "sync-encryption",
+				Usage: "Set the key used to encrypt the synced database",
+				Action: func(c *cli.Context) error {
+					return presenter.SetSyncEncryption(c.Context)
+				},
+			},
+			{
+				Name:  "rotate-sync-key",
+				Usage: "Re-encrypt the synced database with a new key",
+				Action: func(c *cli.Context) error {
+					return presenter.RotateSyncEncryption(c.Context)
+				},
+			},
+			{
+				Name:  "tracking",
+				Usage: "Protect the tracked time against timers left running",
+				Flags: []cli.Flag{
+					&cli.DurationFlag{
+						Name:  "max-session",
+						Usage: "Longest session before it counts as forgotten, 0 disables it",
+					},
+					&cli.BoolFlag{
+						Name:  "auto-trim",
+						Usage: "Stop forgotten sessions without asking",
+					},
+					&cli.StringFlag{
+						Name:  "idle-source",
+						Usage: "Where to read the idle time from: none, x11, gnome or file",
+					},
+					&cli.StringFlag{
+						Name:  "idle-file",
+						Usage: "File touched by a shell hook, for the file idle source",
+					},
+					&cli.DurationFlag{
+						Name:  "idle-after",
+						Usage: "Inactivity after which the session counts as forgotten",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					var flags task.TrackingFlags
+					if c.IsSet("max-session") {
+						maxSession := c.Duration("max-session")
+						flags.MaxSession = &maxSession
+					}
+					if c.IsSet("auto-trim") {
+						autoTrim := c.Bool("auto-trim")
+						flags.AutoTrim = &autoTrim
+					}
+					if c.IsSet("idle-source") {
+						idleSource := c.String("idle-source")
+						flags.IdleSource = &idleSource
+					}
+					if c.IsSet("idle-file") {
+						idleFile := c.String("idle-file")
+						flags.IdleFile = &idleFile
+					}
+					if c.IsSet("idle-after") {
+						idleAfter := c.Duration("idle-after")
+						flags.IdleAfter = &idleAfter
+					}
+
+					return taskPresenter.SetTrackingSetting(c.Context, flags)
+				},
+			},
+			{
+				Name:  "secure-credentials",
+				Usage: "Move plaintext integration credentials into the secret store",
+				Action: func(c *cli.Context) error {
+					return presenter.SecureCredentials(c.Context)
+				},
+			},
 		},
 	}
 }
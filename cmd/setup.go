@@ -51,10 +51,23 @@ func setupCLI(db *sql.DB) *cli.Command {
 					parent_task_id VARCHAR,
 					integration TEXT,
 					histories TEXT,
+					due_at DATETIME,
+					priority VARCHAR,
+					tags TEXT,
 					FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
 					FOREIGN KEY (parent_task_id) REFERENCES tasks(id) ON DELETE CASCADE
 				);
 
+				CREATE UNIQUE INDEX IF NOT EXISTS tasks_single_started ON tasks (is_started) WHERE is_started = true;
+
+				CREATE TABLE IF NOT EXISTS task_notes (
+					id VARCHAR PRIMARY KEY,
+					task_id VARCHAR NOT NULL,
+					content TEXT NOT NULL,
+					created_at DATETIME NOT NULL,
+					FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+				);
+
 				CREATE TABLE IF NOT EXISTS settings (
 					key VARCHAR PRIMARY KEY,
 					value TEXT NOT NULL
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"github.com/azisuazusa/todo-cli/internal/presenter/standup"
+	"github.com/urfave/cli/v2"
+)
+
+func standupCLI(presenter *standup.Presenter) *cli.Command {
+	return &cli.Command{
+		Name:  "standup",
+		Usage: "Summarize the previous working day, today and blockers for a standup",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "project",
+				Aliases: []string{"p"},
+				Usage:   "Only cover this project instead of every project",
+			},
+			&cli.StringFlag{
+				Name:    "date",
+				Aliases: []string{"d"},
+				Usage:   "The day of the standup, e.g. 2024-01-08, instead of today",
+			},
+			&cli.BoolFlag{
+				Name:  "post",
+				Usage: "Post the summary to the webhooks subscribed to Standup events",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			return presenter.Standup(c.Context, c.String("project"), c.String("date"), c.Bool("post"))
+		},
+	}
+}
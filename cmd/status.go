@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/presenter/status"
+	"github.com/urfave/cli/v2"
+)
+
+func statusCLI(presenter *status.Presenter) *cli.Command {
+	return &cli.Command{
+		Name:  "status",
+		Usage: "Print the started task for shell prompts and status bars",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "format",
+				Aliases: []string{"f"},
+				Usage:   fmt.Sprintf("Output format (%s)", strings.Join(status.Formats, ", ")),
+				Value:   status.FormatPlain,
+			},
+			&cli.DurationFlag{
+				Name:  "pomodoro",
+				Usage: "Pomodoro length, 0 to hide the countdown",
+				Value: 25 * time.Minute,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			return presenter.Status(c.Context, c.String("format"), c.Duration("pomodoro"))
+		},
+	}
+}
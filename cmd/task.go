@@ -1,11 +1,17 @@
 package cmd
 
 import (
+	"strings"
+
 	"github.com/azisuazusa/todo-cli/internal/presenter/task"
 	"github.com/urfave/cli/v2"
 )
 
 func taskCLI(presenter *task.Presenter) []*cli.Command {
+	// Commands working with the tracked time first look for a forgotten timer
+	checkSession := func(c *cli.Context) error {
+		return presenter.CheckSession(c.Context)
+	}
 
 	return []*cli.Command{
 		{
@@ -26,8 +32,9 @@ func taskCLI(presenter *task.Presenter) []*cli.Command {
 			},
 		},
 		{
-			Name:  "start",
-			Usage: "Start a task",
+			Name:   "start",
+			Usage:  "Start a task",
+			Before: checkSession,
 			Action: func(c *cli.Context) error {
 				return presenter.Start(c.Context)
 			},
@@ -39,6 +46,23 @@ func taskCLI(presenter *task.Presenter) []*cli.Command {
 				return presenter.Stop(c.Context)
 			},
 		},
+		{
+			Name:      "pause",
+			Usage:     "Pause the started task without ending its session",
+			ArgsUsage: "[reason]",
+			Before:    checkSession,
+			Action: func(c *cli.Context) error {
+				return presenter.Pause(c.Context, strings.Join(c.Args().Slice(), " "))
+			},
+		},
+		{
+			Name:   "resume",
+			Usage:  "Resume the paused task",
+			Before: checkSession,
+			Action: func(c *cli.Context) error {
+				return presenter.Resume(c.Context)
+			},
+		},
 		{
 			Name:  "remove",
 			Usage: "Remove a task",
@@ -47,19 +71,58 @@ func taskCLI(presenter *task.Presenter) []*cli.Command {
 			},
 		},
 		{
-			Name:  "complete",
-			Usage: "Complete a task",
+			Name:   "complete",
+			Usage:  "Complete a task",
+			Before: checkSession,
 			Action: func(c *cli.Context) error {
 				return presenter.Complete(c.Context)
 			},
 		},
 		{
-			Name:  "list",
-			Usage: "List tasks",
+			Name:      "note",
+			Usage:     "Add a note to a task, the started task by default",
+			ArgsUsage: "[task] <text>",
+			Before:    checkSession,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "jira",
+					Usage: "Also add the note as a comment on the JIRA issue",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return presenter.AddNote(c.Context, c.Args().Slice(), c.Bool("jira"))
+			},
+		},
+		{
+			Name:      "show",
+			Usage:     "Show everything about a task, the started task by default",
+			ArgsUsage: "[task]",
+			Before:    checkSession,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "json",
+					Usage: "Print as JSON",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return presenter.Show(c.Context, c.Args().First(), c.Bool("json"))
+			},
+		},
+		{
+			Name:   "list",
+			Usage:  "List tasks",
+			Before: checkSession,
 			Action: func(c *cli.Context) error {
 				return presenter.GetUncompleteTasks(c.Context)
 			},
 		},
+		{
+			Name:  "repair",
+			Usage: "Stop all started tasks but the last one",
+			Action: func(c *cli.Context) error {
+				return presenter.Repair(c.Context)
+			},
+		},
 	}
 
 }
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/azisuazusa/todo-cli/internal/presenter/task"
+	"github.com/urfave/cli/v2"
+)
+
+func timeCLI(presenter *task.Presenter) *cli.Command {
+	return &cli.Command{
+		Name:  "time",
+		Usage: "Add and correct the tracked time of a task",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "add",
+				Usage:     "Add a session that was not tracked, to the started task by default",
+				ArgsUsage: "[task]",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "from",
+						Usage: "Start of the session, e.g. 09:00 or \"2024-01-02 09:00\"",
+					},
+					&cli.StringFlag{
+						Name:  "to",
+						Usage: "End of the session, now by default",
+					},
+					&cli.DurationFlag{
+						Name:  "duration",
+						Usage: "Length of the session, instead of --from or --to",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return presenter.AddTime(c.Context, c.Args().First(), c.String("from"), c.String("to"), c.Duration("duration"))
+				},
+			},
+			{
+				Name:      "edit",
+				Usage:     "Change a session, numbered as in todo show",
+				ArgsUsage: "[task] <session>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "from",
+						Usage: "New start of the session",
+					},
+					&cli.StringFlag{
+						Name:  "to",
+						Usage: "New end of the session",
+					},
+					&cli.DurationFlag{
+						Name:  "duration",
+						Usage: "New length of the session, instead of --to",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					ref, session, err := sessionArgs(c)
+					if err != nil {
+						return err
+					}
+
+					return presenter.EditTime(c.Context, ref, session, c.String("from"), c.String("to"), c.Duration("duration"))
+				},
+			},
+			{
+				Name:  "check",
+				Usage: "Stop the started task when it was left running, for cron or a status bar",
+				Action: func(c *cli.Context) error {
+					return presenter.TrimForgotten(c.Context)
+				},
+			},
+			{
+				Name:      "delete",
+				Usage:     "Delete a session, numbered as in todo show",
+				ArgsUsage: "[task] <session>",
+				Action: func(c *cli.Context) error {
+					ref, session, err := sessionArgs(c)
+					if err != nil {
+						return err
+					}
+
+					return presenter.DeleteTime(c.Context, ref, session)
+				},
+			},
+		},
+	}
+}
+
+// sessionArgs reads "[task] <session>", the session number is always last.
+func sessionArgs(c *cli.Context) (string, int, error) {
+	args := c.Args().Slice()
+	if len(args) == 0 || len(args) > 2 {
+		return "", 0, fmt.Errorf("usage: %s %s", c.Command.HelpName, c.Command.ArgsUsage)
+	}
+
+	session, err := strconv.Atoi(args[len(args)-1])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid session number: %s", args[len(args)-1])
+	}
+
+	if len(args) == 2 {
+		return args[0], session, nil
+	}
+
+	return "", session, nil
+}
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/presenter/report"
+	"github.com/azisuazusa/todo-cli/internal/presenter/transfer"
+	"github.com/urfave/cli/v2"
+)
+
+// transferFormats are the applications tasks are imported from and exported
+// to, by subcommand name.
+var transferFormats = []struct {
+	format entity.TransferFormat
+	name   string
+	file   string
+}{
+	{format: entity.TransferFormatJSON, name: "json", file: "a full JSON backup"},
+	{format: entity.TransferFormatTodoTxt, name: "todotxt", file: "a todo.txt file"},
+	{format: entity.TransferFormatTaskwarrior, name: "taskwarrior", file: "the JSON written by task export"},
+}
+
+// reportFormats are the documents a project's tasks are exported to, by
+// subcommand name.
+var reportFormats = []struct {
+	format   entity.ReportFormat
+	name     string
+	document string
+}{
+	{format: entity.ReportFormatMarkdown, name: "markdown", document: "a Markdown"},
+	{format: entity.ReportFormatHTML, name: "html", document: "an HTML"},
+}
+
+// importCLI imports a JSON backup unless another format is given, either with
+// --format or as a subcommand.
+func importCLI(presenter *transfer.Presenter) *cli.Command {
+	command := &cli.Command{
+		Name:      "import",
+		Usage:     "Restore a backup or import projects and tasks from other applications",
+		ArgsUsage: "<file>",
+		Flags:     append([]cli.Flag{formatFlag()}, importFlags()...),
+		Action: func(c *cli.Context) error {
+			return presenter.Import(c.Context, entity.TransferFormat(c.String("format")), c.Args().First(), importOptions(c))
+		},
+	}
+
+	for _, transferFormat := range transferFormats {
+		format := transferFormat.format
+		command.Subcommands = append(command.Subcommands, &cli.Command{
+			Name:      transferFormat.name,
+			Usage:     "Import " + transferFormat.file,
+			ArgsUsage: "<file>",
+			Flags:     importFlags(),
+			Action: func(c *cli.Context) error {
+				return presenter.Import(c.Context, format, c.Args().First(), importOptions(c))
+			},
+		})
+	}
+
+	return command
+}
+
+func exportCLI(presenter *transfer.Presenter, reportPresenter *report.Presenter) *cli.Command {
+	command := &cli.Command{
+		Name:  "export",
+		Usage: "Back up or export projects and tasks to other applications",
+		Flags: []cli.Flag{formatFlag(), outputFlag()},
+		Action: func(c *cli.Context) error {
+			return presenter.Export(c.Context, entity.TransferFormat(c.String("format")), c.String("output"))
+		},
+	}
+
+	for _, transferFormat := range transferFormats {
+		format := transferFormat.format
+		command.Subcommands = append(command.Subcommands, &cli.Command{
+			Name:  transferFormat.name,
+			Usage: "Export to " + transferFormat.file,
+			Flags: []cli.Flag{outputFlag()},
+			Action: func(c *cli.Context) error {
+				return presenter.Export(c.Context, format, c.String("output"))
+			},
+		})
+	}
+
+	for _, reportFormat := range reportFormats {
+		format := reportFormat.format
+		command.Subcommands = append(command.Subcommands, &cli.Command{
+			Name:  reportFormat.name,
+			Usage: "Export " + reportFormat.document + " report of a project's tasks and of the week",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "project",
+					Aliases: []string{"p"},
+					Usage:   "Report on this project instead of the selected one",
+				},
+				&cli.StringFlag{
+					Name:    "week",
+					Aliases: []string{"w"},
+					Usage:   "Report on the week containing this date, e.g. 2024-01-08, instead of the current week",
+				},
+				outputFlag(),
+			},
+			Action: func(c *cli.Context) error {
+				return reportPresenter.Export(c.Context, format, c.String("project"), c.String("week"), c.String("output"))
+			},
+		})
+	}
+
+	return command
+}
+
+func formatFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    "format",
+		Aliases: []string{"f"},
+		Usage:   "json, todotxt or taskwarrior",
+		Value:   string(entity.TransferFormatJSON),
+	}
+}
+
+func outputFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    "output",
+		Aliases: []string{"o"},
+		Usage:   "Write to a file instead of the standard output",
+	}
+}
+
+func importFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "Report what would change without changing anything",
+		},
+		&cli.BoolFlag{
+			Name:  "keep-local",
+			Usage: "Keep what is stored when it conflicts with what is imported",
+		},
+	}
+}
+
+func importOptions(c *cli.Context) entity.ImportOptions {
+	return entity.ImportOptions{
+		DryRun:    c.Bool("dry-run"),
+		KeepLocal: c.Bool("keep-local"),
+	}
+}
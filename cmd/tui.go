@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"github.com/azisuazusa/todo-cli/internal/presenter/tui"
+	"github.com/urfave/cli/v2"
+)
+
+func tuiCLI(presenter *tui.Presenter) *cli.Command {
+	return &cli.Command{
+		Name:  "tui",
+		Usage: "Open the full-screen interface",
+		Action: func(c *cli.Context) error {
+			return presenter.Run(c.Context)
+		},
+	}
+}
@@ -0,0 +1,155 @@
+// Command todo-integration-example is a sample external integration. It reads
+// the issues from a JSON file, appends worklogs next to it and marks the
+// issues of completed tasks as done.
+//
+// Install it on PATH and add it to a project with:
+//
+//	go install ./examples/todo-integration-example
+//	todo project add-integration
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+type request struct {
+	Version int             `json:"version"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type response struct {
+	Result any    `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+type issue struct {
+	ID          string `json:"id"`
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Done        bool   `json:"done"`
+}
+
+type params struct {
+	ProjectID        string            `json:"project_id"`
+	IssueID          string            `json:"issue_id"`
+	TaskName         string            `json:"task_name"`
+	TimeSpentSeconds int64             `json:"time_spent_seconds"`
+	Details          map[string]string `json:"details"`
+}
+
+func main() {
+	result, err := handle()
+	res := response{Result: result}
+	if err != nil {
+		res = response{Error: err.Error()}
+	}
+
+	json.NewEncoder(os.Stdout).Encode(res)
+}
+
+func handle() (any, error) {
+	var req request
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	if req.Version != 1 {
+		return nil, fmt.Errorf("unsupported protocol version %d", req.Version)
+	}
+
+	var p params
+	if len(req.Params) > 0 {
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, fmt.Errorf("invalid params: %w", err)
+		}
+	}
+
+	switch req.Method {
+	case "describe":
+		return describe(), nil
+	case "get_tasks":
+		return getTasks(p)
+	case "add_worklog":
+		return nil, addWorklog(p)
+	case "transition":
+		return nil, transition(p)
+	}
+
+	return nil, fmt.Errorf("unknown method %s", req.Method)
+}
+
+func describe() any {
+	return map[string]any{
+		"fields": []map[string]any{
+			{"key": "file", "label": "Issues File", "help": "A JSON array of issues with id, key, name and description."},
+			{"key": "api_key", "label": "API Key", "type": "secret"},
+		},
+		"capabilities": map[string]bool{"worklog": true, "transition": true},
+	}
+}
+
+func getTasks(p params) (any, error) {
+	issues, err := readIssues(p.Details["file"])
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []issue{}
+	for _, issue := range issues {
+		if !issue.Done {
+			tasks = append(tasks, issue)
+		}
+	}
+
+	return map[string]any{"tasks": tasks}, nil
+}
+
+func addWorklog(p params) error {
+	file, err := os.OpenFile(p.Details["file"]+".worklog", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "%s\t%s\t%s\n", p.IssueID, time.Duration(p.TimeSpentSeconds)*time.Second, p.TaskName)
+	return err
+}
+
+func transition(p params) error {
+	issues, err := readIssues(p.Details["file"])
+	if err != nil {
+		return err
+	}
+
+	for i := range issues {
+		if issues[i].ID == p.IssueID {
+			issues[i].Done = true
+		}
+	}
+
+	content, err := json.MarshalIndent(issues, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(p.Details["file"], content, 0644)
+}
+
+func readIssues(path string) ([]issue, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []issue
+	if err = json.Unmarshal(content, &issues); err != nil {
+		return nil, fmt.Errorf("invalid issues file: %w", err)
+	}
+
+	return issues, nil
+}
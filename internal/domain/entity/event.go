@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+type EventType string
+
+const (
+	EventTypeTaskAdded     EventType = "TaskAdded"
+	EventTypeTaskStarted   EventType = "TaskStarted"
+	EventTypeTaskStopped   EventType = "TaskStopped"
+	EventTypeTaskPaused    EventType = "TaskPaused"
+	EventTypeTaskResumed   EventType = "TaskResumed"
+	EventTypeTaskCompleted EventType = "TaskCompleted"
+	EventTypeTaskRemoved   EventType = "TaskRemoved"
+)
+
+type Event struct {
+	Type       EventType
+	Task       Task
+	OccurredAt time.Time
+}
+
+func NewTaskEvent(eventType EventType, task Task) Event {
+	return Event{
+		Type:       eventType,
+		Task:       task,
+		OccurredAt: time.Now(),
+	}
+}
@@ -0,0 +1,70 @@
+package entity
+
+import "slices"
+
+type IntegrationFieldType string
+
+const (
+	IntegrationFieldText     IntegrationFieldType = ""
+	IntegrationFieldSecret   IntegrationFieldType = "secret"
+	IntegrationFieldSelect   IntegrationFieldType = "select"
+	IntegrationFieldDuration IntegrationFieldType = "duration"
+)
+
+// IntegrationField is a detail prompted when the integration is added, its
+// value is stored under Key.
+type IntegrationField struct {
+	Key   string
+	Label string
+	// Help is printed before the prompt
+	Help    string
+	Type    IntegrationFieldType
+	Default string
+	Options []string
+	// When only prompts the field if the details prompted before have
+	// these values
+	When map[string]string
+}
+
+func (f IntegrationField) IsShown(details map[string]string) bool {
+	for key, value := range f.When {
+		if details[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+type IntegrationMetadata struct {
+	Type        IntegrationType
+	Description string
+}
+
+// IntegrationCapabilities are the optional features of an integration.
+type IntegrationCapabilities struct {
+	Worklog    bool
+	Transition bool
+	Start      bool
+}
+
+// IntegrationDefinition describes an integration which can be added to a
+// project.
+type IntegrationDefinition struct {
+	IntegrationMetadata
+	Fields       []IntegrationField
+	Capabilities IntegrationCapabilities
+}
+
+// SecretFields are the keys of the fields typed secret, a key is listed once
+// even when several fields share it.
+func (d IntegrationDefinition) SecretFields() []string {
+	var keys []string
+	for _, field := range d.Fields {
+		if field.Type == IntegrationFieldSecret && !slices.Contains(keys, field.Key) {
+			keys = append(keys, field.Key)
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+type TaskNote struct {
+	ID        string
+	TaskID    string
+	Content   string
+	CreatedAt time.Time
+}
+
+type TaskNotes []TaskNote
@@ -1,11 +1,39 @@
 package entity
 
-import "errors"
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+const (
+	JIRAAuthMethodBasic  = "basic"
+	JIRAAuthMethodBearer = "bearer"
+	JIRAAuthMethodOAuth2 = "oauth2"
+)
+
+// JIRA integration detail keys. The OAuth 2.0 consent replaces the url with
+// the cloud API URL, keeps the site URL under site_url and adds the tokens.
+const (
+	JIRADetailAuthMethod   = "auth_method"
+	JIRADetailClientID     = "client_id"
+	JIRADetailClientSecret = "client_secret"
+	JIRADetailToken        = "token"
+	JIRADetailRefreshToken = "refresh_token"
+	JIRADetailExpiry       = "expiry"
+	JIRADetailSiteURL      = "site_url"
+)
 
 type Integration struct {
+	// ID tells apart integrations of the same type, it is set when the
+	// project is saved.
+	ID        string
 	IsEnabled bool
 	Type      IntegrationType
 	Details   map[string]string
+	// SecretFields are the details typed secret in the schema of the
+	// integration, see SecretKeys.
+	SecretFields []string
 }
 
 type Project struct {
@@ -16,6 +44,53 @@ type Project struct {
 	Integrations []Integration
 }
 
+// IssueURL links to the issue with the given key, it is empty for
+// integrations without a browsable URL.
+func (i Integration) IssueURL(key string) string {
+	siteURL := i.Details["url"]
+	// The url of JIRA integrations authorized with OAuth 2.0 is the API
+	if i.Details[JIRADetailSiteURL] != "" {
+		siteURL = i.Details[JIRADetailSiteURL]
+	}
+
+	if siteURL == "" || key == "" {
+		return ""
+	}
+
+	baseURL := strings.TrimSuffix(siteURL, "/")
+	switch i.Type {
+	case IntegrationTypeJIRA:
+		return baseURL + "/browse/" + key
+	case IntegrationTypeGitLab:
+		// GitLab keys are full references, e.g. group/project#12 or
+		// group/project!3 for merge requests
+		if path, iid, ok := strings.Cut(key, "#"); ok {
+			return baseURL + "/" + path + "/-/issues/" + iid
+		}
+
+		if path, iid, ok := strings.Cut(key, "!"); ok {
+			return baseURL + "/" + path + "/-/merge_requests/" + iid
+		}
+	}
+
+	return ""
+}
+
+// SecretKeys are the details of the integration holding credentials.
+func (i Integration) SecretKeys() []string {
+	return append(slices.Clone(SecretDetailKeys), i.SecretFields...)
+}
+
+func (p Project) Integration(integrationType IntegrationType) (Integration, bool) {
+	for _, integration := range p.Integrations {
+		if integration.Type == integrationType {
+			return integration, true
+		}
+	}
+
+	return Integration{}, false
+}
+
 type Projects []Project
 
 var ErrNoProjectSelected = errors.New("no project selected")
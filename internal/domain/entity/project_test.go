@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegrationIssueURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		integration Integration
+		key         string
+		expected    string
+	}{
+		{
+			name:        "jira issue",
+			integration: Integration{Type: IntegrationTypeJIRA, Details: map[string]string{"url": "https://any.atlassian.net/"}},
+			key:         "TODO-1",
+			expected:    "https://any.atlassian.net/browse/TODO-1",
+		},
+		{
+			name: "jira issue with oauth2",
+			integration: Integration{Type: IntegrationTypeJIRA, Details: map[string]string{
+				"url":             "https://api.atlassian.com/ex/jira/any-cloud-id",
+				JIRADetailSiteURL: "https://any.atlassian.net",
+			}},
+			key:      "TODO-1",
+			expected: "https://any.atlassian.net/browse/TODO-1",
+		},
+		{
+			name:        "gitlab issue",
+			integration: Integration{Type: IntegrationTypeGitLab, Details: map[string]string{"url": "https://gitlab.com"}},
+			key:         "group/project#12",
+			expected:    "https://gitlab.com/group/project/-/issues/12",
+		},
+		{
+			name:        "gitlab merge request",
+			integration: Integration{Type: IntegrationTypeGitLab, Details: map[string]string{"url": "https://gitlab.com"}},
+			key:         "group/project!3",
+			expected:    "https://gitlab.com/group/project/-/merge_requests/3",
+		},
+		{
+			name:        "integration without url",
+			integration: Integration{Type: IntegrationTypeJIRA},
+			key:         "TODO-1",
+		},
+		{
+			name:        "integration without issues",
+			integration: Integration{Type: IntegrationTypeSlack, Details: map[string]string{"url": "https://any.slack.com"}},
+			key:         "TODO-1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.integration.IssueURL(test.key))
+		})
+	}
+}
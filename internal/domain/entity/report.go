@@ -0,0 +1,56 @@
+package entity
+
+import "time"
+
+type ReportFormat string
+
+const (
+	ReportFormatMarkdown ReportFormat = "markdown"
+	ReportFormatHTML     ReportFormat = "html"
+)
+
+// ReportOptions pick what a report covers. The project is the selected one
+// when ProjectName is empty, and the week is the one containing Week, or the
+// current week when it is zero.
+type ReportOptions struct {
+	ProjectName string
+	Week        time.Time
+}
+
+// Report is what report templates are executed with.
+type Report struct {
+	Project     Project
+	GeneratedAt time.Time
+	// Tasks are the open tasks and the ones completed since the week started,
+	// with their subtasks nested.
+	Tasks []ReportTask
+	Week  WeekSummary
+}
+
+// ReportTask is a task with what is needed to render it. TimeSpent is the
+// time spent on the task itself, without its subtasks, and Depth is 0 for
+// top-level tasks.
+type ReportTask struct {
+	Task      Task
+	IssueURL  string
+	TimeSpent time.Duration
+	Depth     int
+	SubTasks  []ReportTask
+}
+
+// WeekSummary is the time spent from Monday to Sunday. Tasks are the ones
+// worked on during the week, the most worked on first, with the time spent
+// during the week, and Completed the ones completed during the week.
+type WeekSummary struct {
+	Start     time.Time
+	End       time.Time
+	TimeSpent time.Duration
+	Days      []DaySummary
+	Tasks     []ReportTask
+	Completed []ReportTask
+}
+
+type DaySummary struct {
+	Date      time.Time
+	TimeSpent time.Duration
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "strings"
+
+const SecretReferencePrefix = "secret:"
+
+// SecretDetailKeys are the details holding credentials whatever the
+// integration, like the tokens added by an authorization step. Their values
+// are kept in the secret store and only referenced from the database.
+var SecretDetailKeys = []string{"token", "refresh_token", "client_secret", "encryption_passphrase"}
+
+func IsSecretReference(value string) bool {
+	return strings.HasPrefix(value, SecretReferencePrefix)
+}
+
+// HasPlaintextSecrets tells whether one of secretKeys holds a credential
+// which is not in the secret store yet.
+func HasPlaintextSecrets(details map[string]string, secretKeys []string) bool {
+	for _, key := range secretKeys {
+		if value := details[key]; value != "" && !IsSecretReference(value) {
+			return true
+		}
+	}
+
+	return false
+}
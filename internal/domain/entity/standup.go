@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// BlockedTag marks the tasks reported as blockers in standups.
+const BlockedTag = "blocked"
+
+var ErrNoStandupWebhook = errors.New("no webhook is subscribed to Standup events")
+
+// StandupOptions pick what a standup covers. It covers every project when
+// ProjectName is empty, and today when Date is zero.
+type StandupOptions struct {
+	ProjectName string
+	Date        time.Time
+}
+
+// Standup is what standup templates are executed with. Since is the start
+// of the previous working day, weekends are skipped.
+type Standup struct {
+	Date  time.Time
+	Since time.Time
+	// Yesterday are the tasks worked on during the previous working day, with
+	// the time spent that day, and the tasks completed since then.
+	Yesterday []StandupTask
+	// Today are the started tasks.
+	Today []StandupTask
+	// Blockers are the open tasks tagged blocked.
+	Blockers []StandupTask
+}
+
+// StandupTask is a task with what is needed to render it.
+type StandupTask struct {
+	Task      Task
+	Project   Project
+	IssueURL  string
+	TimeSpent time.Duration
+}
@@ -1,10 +1,132 @@
 package entity
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+// TaskPause is an interruption inside a session, such as a meeting, that is
+// not counted as time spent.
+type TaskPause struct {
+	StartedAt time.Time
+	StoppedAt time.Time
+	Reason    string
+}
+
+// Duration counts a running pause up to now.
+func (p TaskPause) Duration(now time.Time) time.Duration {
+	if p.StoppedAt.IsZero() {
+		return now.Sub(p.StartedAt)
+	}
+
+	return p.StoppedAt.Sub(p.StartedAt)
+}
 
 type TaskHistory struct {
 	StartedAt time.Time
 	StoppedAt time.Time
+	Pauses    []TaskPause
+}
+
+// Duration counts a running session up to now, without its pauses.
+func (h TaskHistory) Duration(now time.Time) time.Duration {
+	if h.StoppedAt.IsZero() {
+		return now.Sub(h.StartedAt) - h.Paused(now)
+	}
+
+	return h.StoppedAt.Sub(h.StartedAt) - h.Paused(h.StoppedAt)
+}
+
+// Paused is the time spent in the pauses of the session.
+func (h TaskHistory) Paused(now time.Time) time.Duration {
+	var paused time.Duration
+	for _, pause := range h.Pauses {
+		paused += pause.Duration(now)
+	}
+
+	return paused
+}
+
+// ClipPauses returns the session with its pauses cut to the session, the
+// pauses falling outside of it are dropped. A running session has no end.
+func (h TaskHistory) ClipPauses() TaskHistory {
+	var pauses []TaskPause
+	for _, pause := range h.Pauses {
+		if !h.StoppedAt.IsZero() && !pause.StartedAt.Before(h.StoppedAt) {
+			continue
+		}
+
+		if !pause.StoppedAt.IsZero() && !pause.StoppedAt.After(h.StartedAt) {
+			continue
+		}
+
+		if pause.StartedAt.Before(h.StartedAt) {
+			pause.StartedAt = h.StartedAt
+		}
+
+		if !h.StoppedAt.IsZero() && (pause.StoppedAt.IsZero() || pause.StoppedAt.After(h.StoppedAt)) {
+			pause.StoppedAt = h.StoppedAt
+		}
+
+		pauses = append(pauses, pause)
+	}
+
+	h.Pauses = pauses
+	return h
+}
+
+func (h TaskHistory) IsPaused() bool {
+	return len(h.Pauses) > 0 && h.Pauses[len(h.Pauses)-1].StoppedAt.IsZero()
+}
+
+// DurationBetween is the part of Duration that falls between from and to, a
+// running session lasting until now.
+func (h TaskHistory) DurationBetween(from, to, now time.Time) time.Duration {
+	end := h.StoppedAt
+	if end.IsZero() {
+		end = now
+	}
+
+	duration := overlap(h.StartedAt, end, from, to)
+	for _, pause := range h.Pauses {
+		pauseEnd := pause.StoppedAt
+		if pauseEnd.IsZero() {
+			pauseEnd = end
+		}
+
+		duration -= overlap(pause.StartedAt, pauseEnd, from, to)
+	}
+
+	return duration
+}
+
+// overlap is how long the spans start to end and from to to share.
+func overlap(start, end, from, to time.Time) time.Duration {
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	if !start.Before(end) {
+		return 0
+	}
+
+	return end.Sub(start)
+}
+
+// Overlaps reports whether both sessions share some time, a running session
+// lasting until now.
+func (h TaskHistory) Overlaps(other TaskHistory, now time.Time) bool {
+	end, otherEnd := h.StoppedAt, other.StoppedAt
+	if end.IsZero() {
+		end = now
+	}
+	if otherEnd.IsZero() {
+		otherEnd = now
+	}
+
+	return h.StartedAt.Before(otherEnd) && other.StartedAt.Before(end)
 }
 
 type IntegrationType string
@@ -12,11 +134,18 @@ type IntegrationType string
 const (
 	IntegrationTypeJIRA   IntegrationType = "JIRA"
 	IntegrationTypeGitHub IntegrationType = "GitHub"
+	IntegrationTypeGitLab IntegrationType = "GitLab"
+	IntegrationTypeLinear IntegrationType = "Linear"
+	IntegrationTypeCalDAV IntegrationType = "CalDAV"
+	IntegrationTypeSlack  IntegrationType = "Slack"
 )
 
 type TaskIntegration struct {
 	ID   string
 	Type IntegrationType
+	// Revision is what the integration needs to detect changes since the last
+	// sync, e.g. the CalDAV ETag and checksum.
+	Revision string
 }
 
 type Task struct {
@@ -26,23 +155,109 @@ type Task struct {
 	Description  string
 	IsStarted    bool
 	CompletedAt  time.Time
+	DueAt        time.Time
 	ParentTaskID string
 	Integration  TaskIntegration
 	Histories    []TaskHistory
+	// Priority is a letter from A, the highest, to Z, or empty
+	Priority string
+	Tags     []string
+}
+
+// HasTag tells whether the task is tagged with tag, ignoring the case.
+func (t Task) HasTag(tag string) bool {
+	for _, taskTag := range t.Tags {
+		if strings.EqualFold(taskTag, tag) {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (t Task) TimeSpent() time.Duration {
 	var timeSpent time.Duration
 	for _, history := range t.Histories {
-		timeSpent += history.StoppedAt.Sub(history.StartedAt)
+		timeSpent += history.StoppedAt.Sub(history.StartedAt) - history.Paused(history.StoppedAt)
 	}
 
 	return timeSpent
 }
 
+// Elapsed is the time spent including the running session, for showing a live
+// timer while the task is started.
+func (t Task) Elapsed(now time.Time) time.Duration {
+	var elapsed time.Duration
+	for _, history := range t.Histories {
+		elapsed += history.Duration(now)
+	}
+
+	return elapsed
+}
+
+// TimeSpentBetween is the time spent between from and to, a running session
+// lasting until now.
+func (t Task) TimeSpentBetween(from, to, now time.Time) time.Duration {
+	var timeSpent time.Duration
+	for _, history := range t.Histories {
+		timeSpent += history.DurationBetween(from, to, now)
+	}
+
+	return timeSpent
+}
+
+// CurrentSession is how long the task has been worked on since it was last
+// started, or zero when it is not started.
+func (t Task) CurrentSession(now time.Time) time.Duration {
+	if !t.IsStarted || len(t.Histories) == 0 {
+		return 0
+	}
+
+	return t.Histories[len(t.Histories)-1].Duration(now)
+}
+
+// SessionStartedAt is when the last session started, or zero when the task
+// was never started.
+func (t Task) SessionStartedAt() time.Time {
+	if len(t.Histories) == 0 {
+		return time.Time{}
+	}
+
+	return t.Histories[len(t.Histories)-1].StartedAt
+}
+
+func (t Task) IsPaused() bool {
+	return t.IsStarted && len(t.Histories) > 0 && t.Histories[len(t.Histories)-1].IsPaused()
+}
+
 func (t *Task) Stop() {
+	t.StopAt(time.Now())
+}
+
+// StopAt stops the task as if it was stopped at stoppedAt. Pauses are cut
+// at that time too, a running pause ends with the session.
+func (t *Task) StopAt(stoppedAt time.Time) {
 	t.IsStarted = false
-	t.Histories[len(t.Histories)-1].StoppedAt = time.Now()
+	if len(t.Histories) == 0 {
+		return
+	}
+
+	history := &t.Histories[len(t.Histories)-1]
+	history.StoppedAt = stoppedAt
+	*history = history.ClipPauses()
+}
+
+func (t *Task) Pause(reason string) {
+	history := &t.Histories[len(t.Histories)-1]
+	history.Pauses = append(history.Pauses, TaskPause{
+		StartedAt: time.Now(),
+		Reason:    reason,
+	})
+}
+
+func (t *Task) Resume() {
+	history := &t.Histories[len(t.Histories)-1]
+	history.Pauses[len(history.Pauses)-1].StoppedAt = time.Now()
 }
 
 func (t *Task) Start() {
@@ -55,10 +270,19 @@ func (t *Task) Start() {
 func (t *Task) Complete() {
 	timeNow := time.Now()
 	if t.IsStarted {
-		t.IsStarted = false
-		t.Histories[len(t.Histories)-1].StoppedAt = timeNow
+		t.StopAt(timeNow)
 	}
 	t.CompletedAt = timeNow
 }
 
 type Tasks []Task
+
+// TaskDetail is everything known about one task.
+type TaskDetail struct {
+	Task     Task
+	Project  Project
+	Parent   *Task
+	SubTasks Tasks
+	Notes    TaskNotes
+	IssueURL string
+}
@@ -28,6 +28,122 @@ func TestTaskTimeSpent(t *testing.T) {
 	assert.Equal(t, expected, actual.String())
 }
 
+func TestTaskElapsed(t *testing.T) {
+	task := Task{
+		Histories: []TaskHistory{
+			{
+				StartedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				StoppedAt: time.Date(2020, 1, 1, 0, 0, 10, 0, time.UTC),
+			},
+			{
+				StartedAt: time.Date(2020, 1, 1, 0, 1, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	expected := "40s"
+
+	actual := task.Elapsed(time.Date(2020, 1, 1, 0, 1, 30, 0, time.UTC))
+
+	assert.Equal(t, expected, actual.String())
+}
+
+func TestTaskTimeSpentBetween(t *testing.T) {
+	day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	task := Task{
+		Histories: []TaskHistory{
+			{
+				StartedAt: day.Add(-time.Hour),
+				StoppedAt: day.Add(time.Hour),
+				Pauses:    []TaskPause{{StartedAt: day.Add(30 * time.Minute), StoppedAt: day.Add(40 * time.Minute)}},
+			},
+			{
+				StartedAt: day.Add(23 * time.Hour),
+				Pauses:    []TaskPause{{StartedAt: day.Add(23*time.Hour + 50*time.Minute)}},
+			},
+		},
+	}
+
+	actual := task.TimeSpentBetween(day, day.AddDate(0, 0, 1), day.Add(25*time.Hour))
+
+	assert.Equal(t, "1h40m0s", actual.String())
+}
+
+func TestTaskCurrentSession(t *testing.T) {
+	task := Task{
+		IsStarted: true,
+		Histories: []TaskHistory{
+			{
+				StartedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				StoppedAt: time.Date(2020, 1, 1, 0, 0, 10, 0, time.UTC),
+			},
+			{
+				StartedAt: time.Date(2020, 1, 1, 0, 1, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	now := time.Date(2020, 1, 1, 0, 1, 30, 0, time.UTC)
+
+	assert.Equal(t, "30s", task.CurrentSession(now).String())
+
+	task.IsStarted = false
+
+	assert.Equal(t, time.Duration(0), task.CurrentSession(now))
+}
+
+func TestTaskHistoryOverlaps(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	session := TaskHistory{
+		StartedAt: time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC),
+		StoppedAt: time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name     string
+		other    TaskHistory
+		expected bool
+	}{
+		{
+			name: "before",
+			other: TaskHistory{
+				StartedAt: time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC),
+				StoppedAt: time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC),
+			},
+			expected: false,
+		},
+		{
+			name: "inside",
+			other: TaskHistory{
+				StartedAt: time.Date(2020, 1, 1, 9, 15, 0, 0, time.UTC),
+				StoppedAt: time.Date(2020, 1, 1, 9, 45, 0, 0, time.UTC),
+			},
+			expected: true,
+		},
+		{
+			name: "running since before",
+			other: TaskHistory{
+				StartedAt: time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC),
+			},
+			expected: true,
+		},
+		{
+			name: "running since after",
+			other: TaskHistory{
+				StartedAt: time.Date(2020, 1, 1, 11, 0, 0, 0, time.UTC),
+			},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, session.Overlaps(test.other, now))
+			assert.Equal(t, test.expected, test.other.Overlaps(session, now))
+		})
+	}
+}
+
 func TestStop(t *testing.T) {
 	task := Task{
 		IsStarted: true,
@@ -68,3 +184,63 @@ func TestComplete(t *testing.T) {
 
 	assert.Equal(t, false, task.IsStarted)
 }
+
+func TestTaskPauses(t *testing.T) {
+	task := Task{
+		IsStarted: true,
+		Histories: []TaskHistory{
+			{
+				StartedAt: time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC),
+				Pauses: []TaskPause{
+					{
+						StartedAt: time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC),
+						StoppedAt: time.Date(2020, 1, 1, 10, 30, 0, 0, time.UTC),
+						Reason:    "meeting",
+					},
+					{
+						StartedAt: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+						Reason:    "lunch",
+					},
+				},
+			},
+		},
+	}
+
+	now := time.Date(2020, 1, 1, 12, 45, 0, 0, time.UTC)
+
+	assert.True(t, task.IsPaused())
+	assert.Equal(t, "2h30m0s", task.Elapsed(now).String())
+	assert.Equal(t, "2h30m0s", task.CurrentSession(now).String())
+
+	task.StopAt(time.Date(2020, 1, 1, 11, 0, 0, 0, time.UTC))
+
+	assert.False(t, task.IsPaused())
+	assert.Equal(t, []TaskPause{task.Histories[0].Pauses[0]}, task.Histories[0].Pauses)
+	assert.Equal(t, "1h30m0s", task.TimeSpent().String())
+}
+
+func TestTaskHistoryClipPauses(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2020, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+	history := TaskHistory{
+		StartedAt: at(10, 0),
+		StoppedAt: at(12, 0),
+		Pauses: []TaskPause{
+			{StartedAt: at(9, 0), StoppedAt: at(9, 30), Reason: "before"},
+			{StartedAt: at(9, 45), StoppedAt: at(10, 15), Reason: "start"},
+			{StartedAt: at(11, 0), StoppedAt: at(11, 15), Reason: "inside"},
+			{StartedAt: at(11, 45), StoppedAt: at(12, 30), Reason: "end"},
+			{StartedAt: at(12, 0), StoppedAt: at(12, 15), Reason: "after"},
+		},
+	}
+
+	assert.Equal(t, []TaskPause{
+		{StartedAt: at(10, 0), StoppedAt: at(10, 15), Reason: "start"},
+		{StartedAt: at(11, 0), StoppedAt: at(11, 15), Reason: "inside"},
+		{StartedAt: at(11, 45), StoppedAt: at(12, 0), Reason: "end"},
+	}, history.ClipPauses().Pauses)
+
+	history.StoppedAt = time.Time{}
+	assert.Len(t, history.ClipPauses().Pauses, 4)
+}
@@ -0,0 +1,51 @@
+package entity
+
+import "time"
+
+type IdleSourceType string
+
+const (
+	IdleSourceNone  IdleSourceType = ""
+	IdleSourceX11   IdleSourceType = "x11"
+	IdleSourceGNOME IdleSourceType = "gnome"
+	IdleSourceFile  IdleSourceType = "file"
+)
+
+var IdleSources = []IdleSourceType{IdleSourceX11, IdleSourceGNOME, IdleSourceFile}
+
+// TrackingSetting protects the histories against timers that were left
+// running. A zero MaxSession disables the limit.
+type TrackingSetting struct {
+	MaxSession time.Duration
+	AutoTrim   bool
+	IdleSource IdleSourceType
+	IdleFile   string
+	IdleAfter  time.Duration
+}
+
+func DefaultTrackingSetting() TrackingSetting {
+	return TrackingSetting{
+		MaxSession: 12 * time.Hour,
+		IdleAfter:  15 * time.Minute,
+	}
+}
+
+type ForgottenReason string
+
+const (
+	ForgottenReasonMaxSession ForgottenReason = "max_session"
+	ForgottenReasonIdle       ForgottenReason = "idle"
+)
+
+// ForgottenSession is a running session that most likely should have been
+// stopped at StopAt.
+type ForgottenSession struct {
+	Task    Task
+	StopAt  time.Time
+	Reason  ForgottenReason
+	Trimmed bool
+}
+
+func (s ForgottenSession) IsZero() bool {
+	return s.Task.ID == ""
+}
@@ -0,0 +1,70 @@
+package entity
+
+import "strings"
+
+type TransferFormat string
+
+const (
+	TransferFormatTodoTxt     TransferFormat = "todotxt"
+	TransferFormatTaskwarrior TransferFormat = "taskwarrior"
+	TransferFormatJSON        TransferFormat = "json"
+)
+
+// Transfer is what is imported from and exported to other applications.
+// Formats which do not know project IDs leave them empty, their tasks refer to
+// the project by its name in ProjectID instead. Tasks without a project go to
+// the selected project. Notes refer to the ID of their task in the transfer,
+// and TrackingSetting is nil for formats without settings.
+type Transfer struct {
+	Projects        Projects
+	Tasks           Tasks
+	Notes           TaskNotes
+	TrackingSetting *TrackingSetting
+}
+
+// ImportOptions change how an import is done. A dry run reports what would
+// change without changing anything, and KeepLocal keeps what is stored when
+// it conflicts with what is imported.
+type ImportOptions struct {
+	DryRun    bool
+	KeepLocal bool
+}
+
+// ImportResult counts what an import changed.
+type ImportResult struct {
+	AddedProjects   int
+	UpdatedProjects int
+	AddedTasks      int
+	UpdatedTasks    int
+	AddedNotes      int
+	UpdatedSettings int
+	Conflicts       []ImportConflict
+}
+
+type ImportConflictKind string
+
+const (
+	ImportConflictProject ImportConflictKind = "project"
+	ImportConflictTask    ImportConflictKind = "task"
+	ImportConflictSetting ImportConflictKind = "setting"
+)
+
+// ImportConflict is something both stored and imported, which differ in
+// Fields.
+type ImportConflict struct {
+	Kind   ImportConflictKind
+	ID     string
+	Name   string
+	Fields []string
+}
+
+// SameProjectName tells whether two project names are the same, ignoring the
+// case and whether words are separated by spaces or underscores, since
+// formats such as todo.txt can not have spaces in a project name.
+func SameProjectName(name, other string) bool {
+	normalize := func(name string) string {
+		return strings.Join(strings.Fields(strings.ReplaceAll(name, "_", " ")), " ")
+	}
+
+	return strings.EqualFold(normalize(name), normalize(other))
+}
@@ -0,0 +1,5 @@
+package git
+
+import "errors"
+
+var ErrHookExists = errors.New("hook already exists")
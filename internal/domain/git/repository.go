@@ -0,0 +1,26 @@
+package git
+
+import (
+	"context"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+type GitRepository interface {
+	InstallHook(ctx context.Context, name, script string, force bool) (string, error)
+	HeadCommit(ctx context.Context) (Commit, error)
+}
+
+type TaskRepository interface {
+	GetStartedTask(ctx context.Context) (entity.Task, error)
+	GetByID(ctx context.Context, id string) (entity.Task, error)
+}
+
+type NoteRepository interface {
+	Insert(ctx context.Context, note entity.TaskNote) error
+}
+
+type Commit struct {
+	SHA     string
+	Subject string
+}
@@ -0,0 +1,147 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/task"
+)
+
+const (
+	PrepareCommitMsgHook = "prepare-commit-msg"
+	PostCommitHook       = "post-commit"
+
+	// HookMarker tells hooks installed by todo-cli apart from the user's own,
+	// which are never overwritten without force.
+	HookMarker = "# Installed by todo-cli"
+
+	shortIDLength = 8
+)
+
+type UseCase interface {
+	InstallHooks(ctx context.Context, executable string, force bool) ([]string, error)
+	PrepareCommitMessage(ctx context.Context, message, source string) (string, error)
+	RecordCommit(ctx context.Context) error
+}
+
+type useCase struct {
+	gitRepo  GitRepository
+	taskRepo TaskRepository
+	noteRepo NoteRepository
+}
+
+func New(gitRepo GitRepository, taskRepo TaskRepository, noteRepo NoteRepository) UseCase {
+	return &useCase{
+		gitRepo:  gitRepo,
+		taskRepo: taskRepo,
+		noteRepo: noteRepo,
+	}
+}
+
+// InstallHooks writes the hooks calling back into executable and returns
+// their paths.
+func (u *useCase) InstallHooks(ctx context.Context, executable string, force bool) ([]string, error) {
+	var paths []string
+	for _, name := range []string{PrepareCommitMsgHook, PostCommitHook} {
+		script := fmt.Sprintf("#!/bin/sh\n%s\n%s git %s \"$@\" || true\n", HookMarker, shellQuote(executable), name)
+		path, err := u.gitRepo.InstallHook(ctx, name, script, force)
+		if err != nil {
+			return nil, fmt.Errorf("error while installing %s hook: %w", name, err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// PrepareCommitMessage prefixes the message with the key of the started task.
+// Merges, squashes, amends and messages already holding the key are kept as
+// they are, and so is everything when no task is started.
+func (u *useCase) PrepareCommitMessage(ctx context.Context, message, source string) (string, error) {
+	if source == "merge" || source == "squash" || source == "commit" {
+		return message, nil
+	}
+
+	startedTask, err := u.taskRepo.GetStartedTask(ctx)
+	if errors.Is(err, task.ErrTaskNotFound) {
+		return message, nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("error while getting started task: %w", err)
+	}
+
+	key, err := u.taskKey(ctx, startedTask)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.Contains(message, key) {
+		return message, nil
+	}
+
+	return fmt.Sprintf("[%s] %s", key, message), nil
+}
+
+// RecordCommit appends the HEAD commit to the notes of the started task.
+func (u *useCase) RecordCommit(ctx context.Context) error {
+	startedTask, err := u.taskRepo.GetStartedTask(ctx)
+	if errors.Is(err, task.ErrTaskNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while getting started task: %w", err)
+	}
+
+	commit, err := u.gitRepo.HeadCommit(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting head commit: %w", err)
+	}
+
+	note := entity.TaskNote{
+		TaskID:    startedTask.ID,
+		Content:   fmt.Sprintf("Commit %s %s", commit.SHA, commit.Subject),
+		CreatedAt: time.Now(),
+	}
+
+	if err = u.noteRepo.Insert(ctx, note); err != nil {
+		return fmt.Errorf("error while inserting note: %w", err)
+	}
+
+	return nil
+}
+
+// taskKey is the JIRA key of the task, or of its parent for subtasks, and
+// falls back to the short local ID.
+func (u *useCase) taskKey(ctx context.Context, t entity.Task) (string, error) {
+	if t.Integration.ID != "" {
+		return t.Integration.ID, nil
+	}
+
+	if t.ParentTaskID != "" {
+		parentTask, err := u.taskRepo.GetByID(ctx, t.ParentTaskID)
+		if err != nil {
+			return "", fmt.Errorf("error while getting parent task: %w", err)
+		}
+
+		if parentTask.Integration.ID != "" {
+			return parentTask.Integration.ID, nil
+		}
+	}
+
+	if len(t.ID) > shortIDLength {
+		return t.ID[:shortIDLength], nil
+	}
+
+	return t.ID, nil
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,213 @@
+package git_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/git"
+	"github.com/azisuazusa/todo-cli/internal/domain/git/mocks"
+	"github.com/azisuazusa/todo-cli/internal/domain/task"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseTestSuite struct {
+	suite.Suite
+	gitRepo  *mocks.GitRepository
+	taskRepo *mocks.TaskRepository
+	noteRepo *mocks.NoteRepository
+	useCase  git.UseCase
+}
+
+func (t *UseCaseTestSuite) SetupTest() {
+	t.gitRepo = new(mocks.GitRepository)
+	t.taskRepo = new(mocks.TaskRepository)
+	t.noteRepo = new(mocks.NoteRepository)
+	t.useCase = git.New(t.gitRepo, t.taskRepo, t.noteRepo)
+}
+
+func TestUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseTestSuite))
+}
+
+func (t *UseCaseTestSuite) TestInstallHooks() {
+	tests := []struct {
+		name        string
+		expected    []string
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "failed to install hook",
+			expectedErr: git.ErrHookExists,
+			mockFunc: func() {
+				t.gitRepo.On("InstallHook", mock.Anything, git.PrepareCommitMsgHook, mock.Anything, false).Return("", git.ErrHookExists).Once()
+			},
+		},
+		{
+			name:     "success",
+			expected: []string{".git/hooks/prepare-commit-msg", ".git/hooks/post-commit"},
+			mockFunc: func() {
+				t.gitRepo.On("InstallHook", mock.Anything, git.PrepareCommitMsgHook, "#!/bin/sh\n"+git.HookMarker+"\n'/usr/bin/it'\\''s todo' git prepare-commit-msg \"$@\" || true\n", false).Return(".git/hooks/prepare-commit-msg", nil).Once()
+				t.gitRepo.On("InstallHook", mock.Anything, git.PostCommitHook, "#!/bin/sh\n"+git.HookMarker+"\n'/usr/bin/it'\\''s todo' git post-commit \"$@\" || true\n", false).Return(".git/hooks/post-commit", nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.mockFunc()
+			paths, err := t.useCase.InstallHooks(context.Background(), "/usr/bin/it's todo", false)
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+			t.Equal(test.expected, paths)
+			t.Equal(test.expectedErr, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestPrepareCommitMessage() {
+	tests := []struct {
+		name        string
+		message     string
+		source      string
+		expected    string
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:     "merge commit",
+			message:  "Merge branch 'main'",
+			source:   "merge",
+			expected: "Merge branch 'main'",
+			mockFunc: func() {},
+		},
+		{
+			name:     "no started task",
+			message:  "any-message",
+			expected: "any-message",
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{}, task.ErrTaskNotFound).Once()
+			},
+		},
+		{
+			name:        "failed to get started task",
+			message:     "any-message",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:     "jira task",
+			message:  "any-message",
+			source:   "message",
+			expected: "[TODO-1] any-message",
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{ID: "task-1", Integration: entity.TaskIntegration{ID: "TODO-1"}}, nil).Once()
+			},
+		},
+		{
+			name:     "message already has the key",
+			message:  "TODO-1 any-message",
+			expected: "TODO-1 any-message",
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{ID: "task-1", Integration: entity.TaskIntegration{ID: "TODO-1"}}, nil).Once()
+			},
+		},
+		{
+			name:     "subtask of jira task",
+			message:  "any-message",
+			expected: "[TODO-2] any-message",
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{ID: "task-2", ParentTaskID: "task-1"}, nil).Once()
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1", Integration: entity.TaskIntegration{ID: "TODO-2"}}, nil).Once()
+			},
+		},
+		{
+			name:        "failed to get parent task",
+			message:     "any-message",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{ID: "task-2", ParentTaskID: "task-1"}, nil).Once()
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:     "local task",
+			message:  "\n# Please enter the commit message",
+			expected: "[0b5f3c2a] \n# Please enter the commit message",
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{ID: "0b5f3c2a-8d2e-4a61-9a4f-3c1d2e5f6a7b"}, nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.mockFunc()
+			message, err := t.useCase.PrepareCommitMessage(context.Background(), test.message, test.source)
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+			t.Equal(test.expected, message)
+			t.Equal(test.expectedErr, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestRecordCommit() {
+	tests := []struct {
+		name        string
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name: "no started task",
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{}, task.ErrTaskNotFound).Once()
+			},
+		},
+		{
+			name:        "failed to get head commit",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{ID: "task-1"}, nil).Once()
+				t.gitRepo.On("HeadCommit", mock.Anything).Return(git.Commit{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:        "failed to insert note",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{ID: "task-1"}, nil).Once()
+				t.gitRepo.On("HeadCommit", mock.Anything).Return(git.Commit{SHA: "abc123", Subject: "any-subject"}, nil).Once()
+				t.noteRepo.On("Insert", mock.Anything, mock.Anything).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name: "success",
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{ID: "task-1"}, nil).Once()
+				t.gitRepo.On("HeadCommit", mock.Anything).Return(git.Commit{SHA: "abc123", Subject: "any-subject"}, nil).Once()
+				t.noteRepo.On("Insert", mock.Anything, mock.MatchedBy(func(note entity.TaskNote) bool {
+					return note.TaskID == "task-1" && note.Content == "Commit abc123 any-subject" && !note.CreatedAt.IsZero()
+				})).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.mockFunc()
+			err := t.useCase.RecordCommit(context.Background())
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+			t.Equal(test.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package integration
+
+import "errors"
+
+var (
+	ErrUnsupportedIntegration = errors.New("unsupported integration")
+	ErrIntegrationNotFound    = errors.New("integration not found in the selected project")
+	ErrNotSupported           = errors.New("not supported by the integration")
+)
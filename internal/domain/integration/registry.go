@@ -0,0 +1,99 @@
+package integration
+
+import (
+	"sync"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+// Registry holds the providers in the order they are offered to the user.
+type Registry struct {
+	providers    []Provider
+	discover     func() []Provider
+	discoverOnce sync.Once
+}
+
+func NewRegistry(providers ...Provider) *Registry {
+	registry := &Registry{}
+	for _, provider := range providers {
+		registry.Register(provider)
+	}
+
+	return registry
+}
+
+// Register adds the provider, replacing the one of the same type.
+func (r *Registry) Register(provider Provider) {
+	for i, registered := range r.providers {
+		if registered.Metadata().Type == provider.Metadata().Type {
+			r.providers[i] = provider
+			return
+		}
+	}
+
+	r.providers = append(r.providers, provider)
+}
+
+// Discover adds the providers returned by discover the first time the
+// registry is looked up, so commands not using integrations do not pay for
+// it. They come after the registered providers and can not replace them.
+func (r *Registry) Discover(discover func() []Provider) {
+	r.discover = discover
+}
+
+func (r *Registry) load() {
+	r.discoverOnce.Do(func() {
+		if r.discover == nil {
+			return
+		}
+
+		for _, provider := range r.discover() {
+			if _, ok := r.get(provider.Metadata().Type); !ok {
+				r.Register(provider)
+			}
+		}
+	})
+}
+
+func (r *Registry) Get(integrationType entity.IntegrationType) (Provider, bool) {
+	r.load()
+	return r.get(integrationType)
+}
+
+func (r *Registry) get(integrationType entity.IntegrationType) (Provider, bool) {
+	for _, provider := range r.providers {
+		if provider.Metadata().Type == integrationType {
+			return provider, true
+		}
+	}
+
+	return nil, false
+}
+
+func (r *Registry) Providers() []Provider {
+	r.load()
+	return r.providers
+}
+
+// Definition describes the provider with the capabilities it implements.
+func Definition(provider Provider) entity.IntegrationDefinition {
+	_, worklog := provider.(Worklogger)
+	_, transition := provider.(Transitioner)
+	_, start := provider.(Starter)
+	if reporter, ok := provider.(CapabilityReporter); ok {
+		capabilities := reporter.Capabilities()
+		worklog = worklog && capabilities.Worklog
+		transition = transition && capabilities.Transition
+		start = start && capabilities.Start
+	}
+
+	return entity.IntegrationDefinition{
+		IntegrationMetadata: provider.Metadata(),
+		Fields:              provider.Schema(),
+		Capabilities: entity.IntegrationCapabilities{
+			Worklog:    worklog,
+			Transition: transition,
+			Start:      start,
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	provider := func(integrationType entity.IntegrationType) *mocks.Provider {
+		provider := new(mocks.Provider)
+		provider.On("Metadata").Return(entity.IntegrationMetadata{Type: integrationType})
+		return provider
+	}
+	jiraProvider := provider(entity.IntegrationTypeJIRA)
+	slackProvider := provider(entity.IntegrationTypeSlack)
+	replacedProvider := provider(entity.IntegrationTypeJIRA)
+
+	registry := integration.NewRegistry(jiraProvider, slackProvider)
+	registry.Register(replacedProvider)
+
+	assert.Equal(t, []integration.Provider{replacedProvider, slackProvider}, registry.Providers())
+
+	res, ok := registry.Get(entity.IntegrationTypeSlack)
+	assert.True(t, ok)
+	assert.Equal(t, slackProvider, res)
+
+	_, ok = registry.Get(entity.IntegrationTypeGitHub)
+	assert.False(t, ok)
+}
+
+func TestRegistryDiscover(t *testing.T) {
+	provider := func(integrationType entity.IntegrationType) *mocks.Provider {
+		provider := new(mocks.Provider)
+		provider.On("Metadata").Return(entity.IntegrationMetadata{Type: integrationType})
+		return provider
+	}
+	jiraProvider := provider(entity.IntegrationTypeJIRA)
+	pluginProvider := provider("acme")
+	shadowingProvider := provider(entity.IntegrationTypeJIRA)
+	discovered := 0
+
+	registry := integration.NewRegistry(jiraProvider)
+	registry.Discover(func() []integration.Provider {
+		discovered++
+		return []integration.Provider{shadowingProvider, pluginProvider}
+	})
+
+	assert.Equal(t, 0, discovered)
+
+	res, ok := registry.Get("acme")
+	assert.True(t, ok)
+	assert.Equal(t, pluginProvider, res)
+	assert.Equal(t, []integration.Provider{jiraProvider, pluginProvider}, registry.Providers())
+	assert.Equal(t, 1, discovered)
+}
+
+func TestDefinition(t *testing.T) {
+	plugin := struct {
+		*mocks.Provider
+		*mocks.Worklogger
+		*mocks.Transitioner
+		*mocks.CapabilityReporter
+	}{new(mocks.Provider), new(mocks.Worklogger), new(mocks.Transitioner), new(mocks.CapabilityReporter)}
+	plugin.Provider.On("Metadata").Return(entity.IntegrationMetadata{Type: "acme"})
+	plugin.Provider.On("Schema").Return([]entity.IntegrationField(nil))
+	plugin.CapabilityReporter.On("Capabilities").Return(entity.IntegrationCapabilities{Worklog: true})
+
+	expected := entity.IntegrationDefinition{
+		IntegrationMetadata: entity.IntegrationMetadata{Type: "acme"},
+		Capabilities:        entity.IntegrationCapabilities{Worklog: true},
+	}
+
+	assert.Equal(t, expected, integration.Definition(plugin))
+}
@@ -0,0 +1,57 @@
+package integration
+
+import (
+	"context"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+// Provider is an integration a project can be configured with. Notifier
+// integrations such as Slack have no tasks to sync and return none.
+type Provider interface {
+	Metadata() entity.IntegrationMetadata
+	Schema() []entity.IntegrationField
+	GetTasks(ctx context.Context, projectID string, details map[string]string) (entity.Tasks, error)
+}
+
+// Ask prompts the user for the value of the field.
+type Ask func(field entity.IntegrationField) (string, error)
+
+// Authorizer is implemented by providers which finish their configuration
+// interactively, e.g. with an OAuth consent.
+type Authorizer interface {
+	Authorize(ctx context.Context, details map[string]string, ask Ask) (map[string]string, error)
+}
+
+// Worklogger is implemented by providers which log the time spent on an issue.
+type Worklogger interface {
+	AddWorklog(ctx context.Context, issueID, taskName string, timeSpent time.Duration, integration entity.Integration) error
+}
+
+// Transitioner is implemented by providers which move the issue of a
+// completed task to done.
+type Transitioner interface {
+	Transition(ctx context.Context, issueID string, integration entity.Integration) error
+}
+
+// Starter is implemented by providers which move the issue of a started task
+// to in progress.
+type Starter interface {
+	Start(ctx context.Context, issueID string, integration entity.Integration) error
+}
+
+// CapabilityReporter is implemented by providers which only know their
+// capabilities at runtime, e.g. external plugins. The reported capabilities
+// narrow the ones implemented.
+type CapabilityReporter interface {
+	Capabilities() entity.IntegrationCapabilities
+}
+
+type ProjectRepository interface {
+	GetSelectedProject(ctx context.Context) (entity.Project, error)
+}
+
+type TaskRepository interface {
+	GetByID(ctx context.Context, id string) (entity.Task, error)
+}
@@ -0,0 +1,180 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+type UseCase interface {
+	GetDefinitions(ctx context.Context) []entity.IntegrationDefinition
+	GetDefinition(ctx context.Context, integrationType entity.IntegrationType) (entity.IntegrationDefinition, error)
+	Authorize(ctx context.Context, integrationType entity.IntegrationType, details map[string]string, ask Ask) (map[string]string, error)
+	AddWorklog(ctx context.Context, task entity.Task, timeSpent time.Duration) error
+	Transition(ctx context.Context, task entity.Task) error
+	Start(ctx context.Context, task entity.Task) error
+}
+
+type useCase struct {
+	registry    *Registry
+	projectRepo ProjectRepository
+	taskRepo    TaskRepository
+}
+
+func New(registry *Registry, projectRepo ProjectRepository, taskRepo TaskRepository) UseCase {
+	return &useCase{
+		registry:    registry,
+		projectRepo: projectRepo,
+		taskRepo:    taskRepo,
+	}
+}
+
+func (u *useCase) GetDefinitions(ctx context.Context) []entity.IntegrationDefinition {
+	var definitions []entity.IntegrationDefinition
+	for _, provider := range u.registry.Providers() {
+		definitions = append(definitions, Definition(provider))
+	}
+
+	return definitions
+}
+
+func (u *useCase) GetDefinition(ctx context.Context, integrationType entity.IntegrationType) (entity.IntegrationDefinition, error) {
+	provider, ok := u.registry.Get(integrationType)
+	if !ok {
+		return entity.IntegrationDefinition{}, fmt.Errorf("%w: %s", ErrUnsupportedIntegration, integrationType)
+	}
+
+	return Definition(provider), nil
+}
+
+// Authorize lets the provider finish the details prompted from its schema,
+// they are returned as-is for providers without an authorization step.
+func (u *useCase) Authorize(ctx context.Context, integrationType entity.IntegrationType, details map[string]string, ask Ask) (map[string]string, error) {
+	provider, ok := u.registry.Get(integrationType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedIntegration, integrationType)
+	}
+
+	authorizer, ok := provider.(Authorizer)
+	if !ok {
+		return details, nil
+	}
+
+	details, err := authorizer.Authorize(ctx, details, ask)
+	if err != nil {
+		return nil, fmt.Errorf("error while authorizing: %w", err)
+	}
+
+	return details, nil
+}
+
+// AddWorklog logs the time spent on the issue of the task, or of its parent
+// for subtasks.
+func (u *useCase) AddWorklog(ctx context.Context, task entity.Task, timeSpent time.Duration) error {
+	issueTask, err := u.issueTask(ctx, task)
+	if err != nil {
+		return err
+	}
+
+	provider, integration, err := u.provider(ctx, issueTask.Integration.Type)
+	if err != nil {
+		return err
+	}
+
+	worklogger, ok := provider.(Worklogger)
+	if !ok || !Definition(provider).Capabilities.Worklog {
+		return fmt.Errorf("%w: worklog", ErrNotSupported)
+	}
+
+	// The worklog comment is the completed task, which may be a subtask
+	err = worklogger.AddWorklog(ctx, issueTask.ID, task.Name, timeSpent, integration)
+	if err != nil {
+		return fmt.Errorf("error while adding worklog: %w", err)
+	}
+
+	return nil
+}
+
+// Transition moves the issue of the task to done. Local tasks and subtasks
+// only exist in todo-cli, so nothing is done for them.
+func (u *useCase) Transition(ctx context.Context, task entity.Task) error {
+	if task.Integration.Type == "" {
+		return nil
+	}
+
+	provider, integration, err := u.provider(ctx, task.Integration.Type)
+	if err != nil {
+		return err
+	}
+
+	transitioner, ok := provider.(Transitioner)
+	if !ok || !Definition(provider).Capabilities.Transition {
+		return fmt.Errorf("%w: transition", ErrNotSupported)
+	}
+
+	if err = transitioner.Transition(ctx, task.ID, integration); err != nil {
+		return fmt.Errorf("error while transitioning issue: %w", err)
+	}
+
+	return nil
+}
+
+func (u *useCase) issueTask(ctx context.Context, task entity.Task) (entity.Task, error) {
+	if task.ParentTaskID == "" {
+		return task, nil
+	}
+
+	parentTask, err := u.taskRepo.GetByID(ctx, task.ParentTaskID)
+	if err != nil {
+		return entity.Task{}, fmt.Errorf("error while getting parent task: %w", err)
+	}
+
+	return parentTask, nil
+}
+
+// provider returns the provider of the integration type together with its
+// configuration in the selected project.
+func (u *useCase) provider(ctx context.Context, integrationType entity.IntegrationType) (Provider, entity.Integration, error) {
+	provider, ok := u.registry.Get(integrationType)
+	if !ok {
+		return nil, entity.Integration{}, fmt.Errorf("%w: %s", ErrUnsupportedIntegration, integrationType)
+	}
+
+	project, err := u.projectRepo.GetSelectedProject(ctx)
+	if err != nil {
+		return nil, entity.Integration{}, fmt.Errorf("error while getting selected project: %w", err)
+	}
+
+	integration, ok := project.Integration(integrationType)
+	if !ok {
+		return nil, entity.Integration{}, fmt.Errorf("%w: %s", ErrIntegrationNotFound, integrationType)
+	}
+
+	return provider, integration, nil
+}
+
+// Start moves the issue of the started task to in progress, the same way as
+// Transition.
+func (u *useCase) Start(ctx context.Context, task entity.Task) error {
+	if task.Integration.Type == "" {
+		return nil
+	}
+
+	provider, integration, err := u.provider(ctx, task.Integration.Type)
+	if err != nil {
+		return err
+	}
+
+	starter, ok := provider.(Starter)
+	if !ok || !Definition(provider).Capabilities.Start {
+		return fmt.Errorf("%w: start", ErrNotSupported)
+	}
+
+	if err = starter.Start(ctx, task.ID, integration); err != nil {
+		return fmt.Errorf("error while starting issue: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,299 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// tracker is a provider with every optional capability.
+type tracker struct {
+	*mocks.Provider
+	*mocks.Authorizer
+	*mocks.Worklogger
+	*mocks.Transitioner
+	*mocks.Starter
+}
+
+type UseCaseTestSuite struct {
+	suite.Suite
+	tracker     tracker
+	notifier    *mocks.Provider
+	projectRepo *mocks.ProjectRepository
+	taskRepo    *mocks.TaskRepository
+	useCase     integration.UseCase
+}
+
+func (t *UseCaseTestSuite) SetupTest() {
+	t.tracker = tracker{
+		Provider:     new(mocks.Provider),
+		Authorizer:   new(mocks.Authorizer),
+		Worklogger:   new(mocks.Worklogger),
+		Transitioner: new(mocks.Transitioner),
+		Starter:      new(mocks.Starter),
+	}
+	t.tracker.Provider.On("Metadata").Return(entity.IntegrationMetadata{Type: entity.IntegrationTypeJIRA}).Maybe()
+	t.tracker.Provider.On("Schema").Return([]entity.IntegrationField{{Key: "url", Label: "JIRA URL"}}).Maybe()
+	t.notifier = new(mocks.Provider)
+	t.notifier.On("Metadata").Return(entity.IntegrationMetadata{Type: entity.IntegrationTypeSlack}).Maybe()
+	t.notifier.On("Schema").Return([]entity.IntegrationField(nil)).Maybe()
+	t.projectRepo = new(mocks.ProjectRepository)
+	t.taskRepo = new(mocks.TaskRepository)
+	t.useCase = integration.New(integration.NewRegistry(t.tracker, t.notifier), t.projectRepo, t.taskRepo)
+}
+
+func TestUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseTestSuite))
+}
+
+func (t *UseCaseTestSuite) TestGetDefinitions() {
+	expected := []entity.IntegrationDefinition{
+		{
+			IntegrationMetadata: entity.IntegrationMetadata{Type: entity.IntegrationTypeJIRA},
+			Fields:              []entity.IntegrationField{{Key: "url", Label: "JIRA URL"}},
+			Capabilities:        entity.IntegrationCapabilities{Worklog: true, Transition: true, Start: true},
+		},
+		{
+			IntegrationMetadata: entity.IntegrationMetadata{Type: entity.IntegrationTypeSlack},
+		},
+	}
+
+	t.Equal(expected, t.useCase.GetDefinitions(context.Background()))
+
+	_, err := t.useCase.GetDefinition(context.Background(), entity.IntegrationTypeGitHub)
+	t.ErrorIs(err, integration.ErrUnsupportedIntegration)
+}
+
+func (t *UseCaseTestSuite) TestAuthorize() {
+	details := map[string]string{"auth_method": "oauth2"}
+	authorizedDetails := map[string]string{"token": "any-token"}
+	t.tracker.Authorizer.On("Authorize", mock.Anything, details, mock.Anything).Return(authorizedDetails, nil).Once()
+
+	res, err := t.useCase.Authorize(context.Background(), entity.IntegrationTypeJIRA, details, nil)
+
+	t.NoError(err)
+	t.Equal(authorizedDetails, res)
+
+	res, err = t.useCase.Authorize(context.Background(), entity.IntegrationTypeSlack, details, nil)
+
+	t.NoError(err)
+	t.Equal(details, res)
+}
+
+func (t *UseCaseTestSuite) TestAddWorklog() {
+	project := entity.Project{
+		ID:           "project-1",
+		Integrations: []entity.Integration{{IsEnabled: true, Type: entity.IntegrationTypeJIRA}},
+	}
+	timeSpent := 30 * time.Minute
+
+	tests := []struct {
+		name          string
+		task          entity.Task
+		expectedError error
+		mockFunc      func(task entity.Task)
+	}{
+		{
+			name:          "task is child and failed to get parent task",
+			task:          entity.Task{ID: "task-1", ParentTaskID: "parent-task-1"},
+			expectedError: fmt.Errorf("error while getting parent task: %w", errors.New("any-error")),
+			mockFunc: func(task entity.Task) {
+				t.taskRepo.On("GetByID", mock.Anything, task.ParentTaskID).Return(entity.Task{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:          "unsupported integration",
+			task:          entity.Task{ID: "task-1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeGitHub}},
+			expectedError: fmt.Errorf("%w: %s", integration.ErrUnsupportedIntegration, entity.IntegrationTypeGitHub),
+		},
+		{
+			name:          "failed to get selected project",
+			task:          entity.Task{ID: "task-1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeJIRA}},
+			expectedError: fmt.Errorf("error while getting selected project: %w", entity.ErrNoProjectSelected),
+			mockFunc: func(_ entity.Task) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{}, entity.ErrNoProjectSelected).Once()
+			},
+		},
+		{
+			name:          "no jira integration found",
+			task:          entity.Task{ID: "task-1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeJIRA}},
+			expectedError: fmt.Errorf("%w: %s", integration.ErrIntegrationNotFound, entity.IntegrationTypeJIRA),
+			mockFunc: func(_ entity.Task) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{ID: "project-1"}, nil).Once()
+			},
+		},
+		{
+			name:          "worklog not supported",
+			task:          entity.Task{ID: "task-1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeSlack}},
+			expectedError: fmt.Errorf("%w: worklog", integration.ErrNotSupported),
+			mockFunc: func(_ entity.Task) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{
+					Integrations: []entity.Integration{{Type: entity.IntegrationTypeSlack}},
+				}, nil).Once()
+			},
+		},
+		{
+			name:          "failed to add worklog to task",
+			task:          entity.Task{ID: "task-1", Name: "Task 1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeJIRA}},
+			expectedError: fmt.Errorf("error while adding worklog: %w", errors.New("any-error")),
+			mockFunc: func(task entity.Task) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(project, nil).Once()
+				t.tracker.Worklogger.On("AddWorklog", mock.Anything, task.ID, task.Name, timeSpent, project.Integrations[0]).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name: "success add worklog to parent task",
+			task: entity.Task{ID: "task-1", Name: "Task 1", ParentTaskID: "parent-task-1"},
+			mockFunc: func(task entity.Task) {
+				t.taskRepo.On("GetByID", mock.Anything, task.ParentTaskID).Return(entity.Task{
+					ID:          task.ParentTaskID,
+					Name:        "Parent Task 1",
+					Integration: entity.TaskIntegration{Type: entity.IntegrationTypeJIRA},
+				}, nil).Once()
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(project, nil).Once()
+				t.tracker.Worklogger.On("AddWorklog", mock.Anything, "parent-task-1", "Task 1", timeSpent, project.Integrations[0]).Return(nil).Once()
+			},
+		},
+		{
+			name: "success add worklog to task",
+			task: entity.Task{ID: "task-1", Name: "Task 1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeJIRA}},
+			mockFunc: func(task entity.Task) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(project, nil).Once()
+				t.tracker.Worklogger.On("AddWorklog", mock.Anything, task.ID, task.Name, timeSpent, project.Integrations[0]).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			if test.mockFunc != nil {
+				test.mockFunc(test.task)
+			}
+
+			err := t.useCase.AddWorklog(context.Background(), test.task, timeSpent)
+			t.Equal(test.expectedError, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestTransition() {
+	project := entity.Project{
+		ID:           "project-1",
+		Integrations: []entity.Integration{{IsEnabled: true, Type: entity.IntegrationTypeJIRA}},
+	}
+
+	tests := []struct {
+		name          string
+		task          entity.Task
+		expectedError error
+		mockFunc      func(task entity.Task)
+	}{
+		{
+			name: "subtask is skipped",
+			task: entity.Task{ID: "task-1", ParentTaskID: "parent-task-1"},
+		},
+		{
+			name:          "transition not supported",
+			task:          entity.Task{ID: "task-1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeSlack}},
+			expectedError: fmt.Errorf("%w: transition", integration.ErrNotSupported),
+			mockFunc: func(_ entity.Task) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{
+					Integrations: []entity.Integration{{Type: entity.IntegrationTypeSlack}},
+				}, nil).Once()
+			},
+		},
+		{
+			name:          "failed to transition",
+			task:          entity.Task{ID: "task-1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeJIRA}},
+			expectedError: fmt.Errorf("error while transitioning issue: %w", errors.New("any-error")),
+			mockFunc: func(task entity.Task) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(project, nil).Once()
+				t.tracker.Transitioner.On("Transition", mock.Anything, task.ID, project.Integrations[0]).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name: "success",
+			task: entity.Task{ID: "task-1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeJIRA}},
+			mockFunc: func(task entity.Task) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(project, nil).Once()
+				t.tracker.Transitioner.On("Transition", mock.Anything, task.ID, project.Integrations[0]).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			if test.mockFunc != nil {
+				test.mockFunc(test.task)
+			}
+
+			err := t.useCase.Transition(context.Background(), test.task)
+			t.Equal(test.expectedError, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestStart() {
+	project := entity.Project{
+		ID:           "project-1",
+		Integrations: []entity.Integration{{IsEnabled: true, Type: entity.IntegrationTypeJIRA}},
+	}
+
+	tests := []struct {
+		name          string
+		task          entity.Task
+		expectedError error
+		mockFunc      func(task entity.Task)
+	}{
+		{
+			name: "local task is skipped",
+			task: entity.Task{ID: "task-1"},
+		},
+		{
+			name:          "start not supported",
+			task:          entity.Task{ID: "task-1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeSlack}},
+			expectedError: fmt.Errorf("%w: start", integration.ErrNotSupported),
+			mockFunc: func(_ entity.Task) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{
+					Integrations: []entity.Integration{{Type: entity.IntegrationTypeSlack}},
+				}, nil).Once()
+			},
+		},
+		{
+			name:          "failed to start",
+			task:          entity.Task{ID: "task-1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeJIRA}},
+			expectedError: fmt.Errorf("error while starting issue: %w", errors.New("any-error")),
+			mockFunc: func(task entity.Task) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(project, nil).Once()
+				t.tracker.Starter.On("Start", mock.Anything, task.ID, project.Integrations[0]).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name: "success",
+			task: entity.Task{ID: "task-1", Integration: entity.TaskIntegration{Type: entity.IntegrationTypeJIRA}},
+			mockFunc: func(task entity.Task) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(project, nil).Once()
+				t.tracker.Starter.On("Start", mock.Anything, task.ID, project.Integrations[0]).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			if test.mockFunc != nil {
+				test.mockFunc(test.task)
+			}
+
+			err := t.useCase.Start(context.Background(), test.task)
+			t.Equal(test.expectedError, err)
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package jira
+
+import "errors"
+
+var ErrNotJIRATask = errors.New("task is not linked to a jira issue")
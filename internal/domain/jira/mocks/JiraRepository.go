@@ -6,10 +6,7 @@ import (
 	context "context"
 
 	entity "github.com/azisuazusa/todo-cli/internal/domain/entity"
-
 	mock "github.com/stretchr/testify/mock"
-
-	time "time"
 )
 
 // JiraRepository is an autogenerated mock type for the JiraRepository type
@@ -25,17 +22,17 @@ func (_m *JiraRepository) EXPECT() *JiraRepository_Expecter {
 	return &JiraRepository_Expecter{mock: &_m.Mock}
 }
 
-// AddWorklog provides a mock function with given fields: ctx, issueID, taskName, timeSpent, integrationEntity
-func (_m *JiraRepository) AddWorklog(ctx context.Context, issueID string, taskName string, timeSpent time.Duration, integrationEntity entity.Integration) error {
-	ret := _m.Called(ctx, issueID, taskName, timeSpent, integrationEntity)
+// AddComment provides a mock function with given fields: ctx, issueID, comment, integrationEntity
+func (_m *JiraRepository) AddComment(ctx context.Context, issueID string, comment string, integrationEntity entity.Integration) error {
+	ret := _m.Called(ctx, issueID, comment, integrationEntity)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddWorklog")
+		panic("no return value specified for AddComment")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration, entity.Integration) error); ok {
-		r0 = rf(ctx, issueID, taskName, timeSpent, integrationEntity)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.Integration) error); ok {
+		r0 = rf(ctx, issueID, comment, integrationEntity)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -43,34 +40,33 @@ func (_m *JiraRepository) AddWorklog(ctx context.Context, issueID string, taskNa
 	return r0
 }
 
-// JiraRepository_AddWorklog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWorklog'
-type JiraRepository_AddWorklog_Call struct {
+// JiraRepository_AddComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddComment'
+type JiraRepository_AddComment_Call struct {
 	*mock.Call
 }
 
-// AddWorklog is a helper method to define mock.On call
+// AddComment is a helper method to define mock.On call
 //   - ctx context.Context
 //   - issueID string
-//   - taskName string
-//   - timeSpent time.Duration
+//   - comment string
 //   - integrationEntity entity.Integration
-func (_e *JiraRepository_Expecter) AddWorklog(ctx interface{}, issueID interface{}, taskName interface{}, timeSpent interface{}, integrationEntity interface{}) *JiraRepository_AddWorklog_Call {
-	return &JiraRepository_AddWorklog_Call{Call: _e.mock.On("AddWorklog", ctx, issueID, taskName, timeSpent, integrationEntity)}
+func (_e *JiraRepository_Expecter) AddComment(ctx interface{}, issueID interface{}, comment interface{}, integrationEntity interface{}) *JiraRepository_AddComment_Call {
+	return &JiraRepository_AddComment_Call{Call: _e.mock.On("AddComment", ctx, issueID, comment, integrationEntity)}
 }
 
-func (_c *JiraRepository_AddWorklog_Call) Run(run func(ctx context.Context, issueID string, taskName string, timeSpent time.Duration, integrationEntity entity.Integration)) *JiraRepository_AddWorklog_Call {
+func (_c *JiraRepository_AddComment_Call) Run(run func(ctx context.Context, issueID string, comment string, integrationEntity entity.Integration)) *JiraRepository_AddComment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration), args[4].(entity.Integration))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(entity.Integration))
 	})
 	return _c
 }
 
-func (_c *JiraRepository_AddWorklog_Call) Return(_a0 error) *JiraRepository_AddWorklog_Call {
+func (_c *JiraRepository_AddComment_Call) Return(_a0 error) *JiraRepository_AddComment_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *JiraRepository_AddWorklog_Call) RunAndReturn(run func(context.Context, string, string, time.Duration, entity.Integration) error) *JiraRepository_AddWorklog_Call {
+func (_c *JiraRepository_AddComment_Call) RunAndReturn(run func(context.Context, string, string, entity.Integration) error) *JiraRepository_AddComment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,10 +6,7 @@ import (
 	context "context"
 
 	entity "github.com/azisuazusa/todo-cli/internal/domain/entity"
-
 	mock "github.com/stretchr/testify/mock"
-
-	time "time"
 )
 
 // UseCase is an autogenerated mock type for the UseCase type
@@ -25,17 +22,17 @@ func (_m *UseCase) EXPECT() *UseCase_Expecter {
 	return &UseCase_Expecter{mock: &_m.Mock}
 }
 
-// AddWorklog provides a mock function with given fields: ctx, task, timeSpent
-func (_m *UseCase) AddWorklog(ctx context.Context, task entity.Task, timeSpent time.Duration) error {
-	ret := _m.Called(ctx, task, timeSpent)
+// AddComment provides a mock function with given fields: ctx, task, comment
+func (_m *UseCase) AddComment(ctx context.Context, task entity.Task, comment string) error {
+	ret := _m.Called(ctx, task, comment)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddWorklog")
+		panic("no return value specified for AddComment")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entity.Task, time.Duration) error); ok {
-		r0 = rf(ctx, task, timeSpent)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Task, string) error); ok {
+		r0 = rf(ctx, task, comment)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -43,32 +40,32 @@ func (_m *UseCase) AddWorklog(ctx context.Context, task entity.Task, timeSpent t
 	return r0
 }
 
-// UseCase_AddWorklog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWorklog'
-type UseCase_AddWorklog_Call struct {
+// UseCase_AddComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddComment'
+type UseCase_AddComment_Call struct {
 	*mock.Call
 }
 
-// AddWorklog is a helper method to define mock.On call
+// AddComment is a helper method to define mock.On call
 //   - ctx context.Context
 //   - task entity.Task
-//   - timeSpent time.Duration
-func (_e *UseCase_Expecter) AddWorklog(ctx interface{}, task interface{}, timeSpent interface{}) *UseCase_AddWorklog_Call {
-	return &UseCase_AddWorklog_Call{Call: _e.mock.On("AddWorklog", ctx, task, timeSpent)}
+//   - comment string
+func (_e *UseCase_Expecter) AddComment(ctx interface{}, task interface{}, comment interface{}) *UseCase_AddComment_Call {
+	return &UseCase_AddComment_Call{Call: _e.mock.On("AddComment", ctx, task, comment)}
 }
 
-func (_c *UseCase_AddWorklog_Call) Run(run func(ctx context.Context, task entity.Task, timeSpent time.Duration)) *UseCase_AddWorklog_Call {
+func (_c *UseCase_AddComment_Call) Run(run func(ctx context.Context, task entity.Task, comment string)) *UseCase_AddComment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entity.Task), args[2].(time.Duration))
+		run(args[0].(context.Context), args[1].(entity.Task), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *UseCase_AddWorklog_Call) Return(_a0 error) *UseCase_AddWorklog_Call {
+func (_c *UseCase_AddComment_Call) Return(_a0 error) *UseCase_AddComment_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *UseCase_AddWorklog_Call) RunAndReturn(run func(context.Context, entity.Task, time.Duration) error) *UseCase_AddWorklog_Call {
+func (_c *UseCase_AddComment_Call) RunAndReturn(run func(context.Context, entity.Task, string) error) *UseCase_AddComment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2,13 +2,12 @@ package jira
 
 import (
 	"context"
-	"time"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 )
 
 type JiraRepository interface {
-	AddWorklog(ctx context.Context, issueID, taskName string, timeSpent time.Duration, integrationEntity entity.Integration) error
+	AddComment(ctx context.Context, issueID, comment string, integrationEntity entity.Integration) error
 }
 
 type ProjectRepository interface {
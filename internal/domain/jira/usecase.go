@@ -3,13 +3,12 @@ package jira
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 )
 
 type UseCase interface {
-	AddWorklog(ctx context.Context, task entity.Task, timeSpent time.Duration) error
+	AddComment(ctx context.Context, task entity.Task, comment string) error
 }
 
 type useCase struct {
@@ -26,24 +25,17 @@ func New(jiraRepo JiraRepository, projectRepo ProjectRepository, taskRepo TaskRe
 	}
 }
 
-func (u *useCase) AddWorklog(ctx context.Context, task entity.Task, timeSpent time.Duration) error {
+// AddComment posts the comment on the issue of the task, or of its parent
+// for subtasks.
+func (u *useCase) AddComment(ctx context.Context, task entity.Task, comment string) error {
 	project, err := u.projectRepo.GetSelectedProject(ctx)
 	if err != nil {
 		return err
 	}
 
-	if len(project.Integrations) == 0 {
-		return fmt.Errorf("no integration found")
-	}
-
-	integrationEntity := entity.Integration{}
-	for _, integration := range project.Integrations {
-		if integration.Type == entity.IntegrationTypeJIRA {
-			integrationEntity = integration
-			break
-		}
-
-		return fmt.Errorf("no jira integration found")
+	integrationEntity, err := jiraIntegration(project)
+	if err != nil {
+		return err
 	}
 
 	if task.ParentTaskID != "" {
@@ -52,14 +44,31 @@ func (u *useCase) AddWorklog(ctx context.Context, task entity.Task, timeSpent ti
 			err = errGetParentTask
 			return fmt.Errorf("error while getting parent task: %w", err)
 		}
-		task.ID = parentTask.ID
+		task = parentTask
+	}
+
+	if task.Integration.Type != entity.IntegrationTypeJIRA {
+		return ErrNotJIRATask
 	}
 
-	err = u.jiraRepo.AddWorklog(ctx, task.ID, task.Name, timeSpent, integrationEntity)
+	err = u.jiraRepo.AddComment(ctx, task.ID, comment, integrationEntity)
 	if err != nil {
-		return fmt.Errorf("error while adding worklog: %w", err)
+		return fmt.Errorf("error while adding comment: %w", err)
 	}
 
 	return nil
+}
+
+func jiraIntegration(project entity.Project) (entity.Integration, error) {
+	if len(project.Integrations) == 0 {
+		return entity.Integration{}, fmt.Errorf("no integration found")
+	}
+
+	for _, integration := range project.Integrations {
+		if integration.Type == entity.IntegrationTypeJIRA {
+			return integration, nil
+		}
+	}
 
+	return entity.Integration{}, fmt.Errorf("no jira integration found")
 }
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 	"github.com/azisuazusa/todo-cli/internal/domain/jira/mocks"
@@ -32,219 +31,66 @@ func TestUseCaseTestSuite(t *testing.T) {
 	suite.Run(t, new(UseCaseTestSuite))
 }
 
-func (t *UseCaseTestSuite) TestAddWorklog() {
+func (t *UseCaseTestSuite) TestAddComment() {
+	jiraProject := entity.Project{
+		ID: "project-1",
+		Integrations: []entity.Integration{
+			{Type: entity.IntegrationTypeSlack},
+			{IsEnabled: true, Type: entity.IntegrationTypeJIRA},
+		},
+	}
+	jiraTask := entity.Task{ID: "10001", Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA}}
+
 	tests := []struct {
 		name          string
 		task          entity.Task
-		timeSpent     time.Duration
 		expectedError error
-		mockFunc      func(task entity.Task, timeSpent time.Duration)
+		mockFunc      func()
 	}{
 		{
-			name: "failed to get selected project",
-			task: entity.Task{
-				ProjectID: "project-1",
-			},
-			timeSpent:     time.Duration(0),
-			expectedError: entity.ErrNoProjectSelected,
-			mockFunc: func(_ entity.Task, _ time.Duration) {
-				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{}, entity.ErrNoProjectSelected).Once()
-			},
-		},
-		{
-			name: "no integration found",
-			task: entity.Task{
-				ProjectID: "project-1",
-			},
-			timeSpent:     time.Duration(0),
-			expectedError: fmt.Errorf("no integration found"),
-			mockFunc: func(_ entity.Task, _ time.Duration) {
-				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{
-					ID:           "project-1",
-					Name:         "Project 1",
-					Description:  "",
-					IsSelected:   true,
-					Integrations: []entity.Integration{},
-				}, nil).Once()
-			},
-		},
-		{
-			name: "no jira integration found",
-			task: entity.Task{
-				ProjectID: "project-1",
-			},
-			timeSpent:     time.Duration(0),
+			name:          "no jira integration found",
+			task:          jiraTask,
 			expectedError: fmt.Errorf("no jira integration found"),
-			mockFunc: func(_ entity.Task, _ time.Duration) {
-				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{
-					ID:          "project-1",
-					Name:        "Project 1",
-					Description: "",
-					IsSelected:  true,
-					Integrations: []entity.Integration{
-						{
-							IsEnabled: true,
-							Type:      entity.IntegrationTypeGitHub,
-							Details: map[string]string{
-								"token": "token",
-							},
-						},
-					},
-				}, nil).Once()
-			},
-		},
-		{
-			name: "task is child and failed to get parent task",
-			task: entity.Task{
-				ProjectID:    "project-1",
-				ParentTaskID: "parent-task-1",
-			},
-			timeSpent:     time.Duration(0),
-			expectedError: fmt.Errorf("error while getting parent task: %w", errors.New("any-error")),
-			mockFunc: func(task entity.Task, _ time.Duration) {
+			mockFunc: func() {
 				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{
-					ID:          "project-1",
-					Name:        "Project 1",
-					Description: "",
-					IsSelected:  true,
-					Integrations: []entity.Integration{
-						{
-							IsEnabled: true,
-							Type:      entity.IntegrationTypeJIRA,
-							Details: map[string]string{
-								"token": "token",
-							},
-						},
-					},
+					Integrations: []entity.Integration{{Type: entity.IntegrationTypeSlack}},
 				}, nil).Once()
-				t.taskRepo.On("GetByID", mock.Anything, task.ParentTaskID).Return(entity.Task{}, errors.New("any-error")).Once()
 			},
 		},
 		{
-			name: "failed to add worklog to parent task",
-			task: entity.Task{
-				ProjectID:    "project-1",
-				ParentTaskID: "parent-task-1",
-			},
-			timeSpent:     time.Duration(0),
-			expectedError: fmt.Errorf("error while adding worklog: %w", errors.New("any-error")),
-			mockFunc: func(task entity.Task, timeSpent time.Duration) {
-				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{
-					ID:          "project-1",
-					Name:        "Project 1",
-					Description: "",
-					IsSelected:  true,
-					Integrations: []entity.Integration{
-						{
-							IsEnabled: true,
-							Type:      entity.IntegrationTypeJIRA,
-							Details: map[string]string{
-								"token": "token",
-							},
-						},
-					},
-				}, nil).Once()
-				t.taskRepo.On("GetByID", mock.Anything, task.ParentTaskID).Return(entity.Task{
-					ID:   task.ParentTaskID,
-					Name: "Parent Task 1",
-				}, nil).Once()
-				t.jiraRepo.On("AddWorklog", mock.Anything, "parent-task-1", "Parent Task 1", timeSpent, mock.Anything).Return(errors.New("any-error")).Once()
+			name:          "local task",
+			task:          entity.Task{ID: "task-1"},
+			expectedError: ErrNotJIRATask,
+			mockFunc: func() {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(jiraProject, nil).Once()
 			},
 		},
 		{
-			name: "failed to add worklog to task",
-			task: entity.Task{
-				ProjectID: "project-1",
-			},
-			timeSpent:     time.Duration(0),
-			expectedError: fmt.Errorf("error while adding worklog: %w", errors.New("any-error")),
-			mockFunc: func(task entity.Task, timeSpent time.Duration) {
-				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{
-					ID:          "project-1",
-					Name:        "Project 1",
-					Description: "",
-					IsSelected:  true,
-					Integrations: []entity.Integration{
-						{
-							IsEnabled: true,
-							Type:      entity.IntegrationTypeJIRA,
-							Details: map[string]string{
-								"token": "token",
-							},
-						},
-					},
-				}, nil).Once()
-				t.jiraRepo.On("AddWorklog", mock.Anything, task.ID, task.Name, timeSpent, mock.Anything).Return(errors.New("any-error")).Once()
+			name:          "failed to add comment",
+			task:          jiraTask,
+			expectedError: fmt.Errorf("error while adding comment: %w", errors.New("any-error")),
+			mockFunc: func() {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(jiraProject, nil).Once()
+				t.jiraRepo.On("AddComment", mock.Anything, "10001", "any-comment", jiraProject.Integrations[1]).Return(errors.New("any-error")).Once()
 			},
 		},
 		{
-			name: "success add worklog to parent task",
-			task: entity.Task{
-				ProjectID:    "project-1",
-				ParentTaskID: "parent-task-1",
-			},
-			timeSpent:     time.Duration(0),
-			expectedError: nil,
-			mockFunc: func(task entity.Task, timeSpent time.Duration) {
-				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{
-					ID:          "project-1",
-					Name:        "Project 1",
-					Description: "",
-					IsSelected:  true,
-					Integrations: []entity.Integration{
-						{
-							IsEnabled: true,
-							Type:      entity.IntegrationTypeJIRA,
-							Details: map[string]string{
-								"token": "token",
-							},
-						},
-					},
-				}, nil).Once()
-				t.taskRepo.On("GetByID", mock.Anything, task.ParentTaskID).Return(entity.Task{
-					ID:   task.ParentTaskID,
-					Name: "Parent Task 1",
-				}, nil).Once()
-				t.jiraRepo.On("AddWorklog", mock.Anything, "parent-task-1", "Parent Task 1", timeSpent, mock.Anything).Return(nil).Once()
-			},
-		},
-		{
-			name: "success add worklog to task",
-			task: entity.Task{
-				ProjectID: "project-1",
-			},
-			timeSpent:     time.Duration(0),
-			expectedError: nil,
-			mockFunc: func(task entity.Task, timeSpent time.Duration) {
-				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{
-					ID:          "project-1",
-					Name:        "Project 1",
-					Description: "",
-					IsSelected:  true,
-					Integrations: []entity.Integration{
-						{
-							IsEnabled: true,
-							Type:      entity.IntegrationTypeJIRA,
-							Details: map[string]string{
-								"token": "token",
-							},
-						},
-					},
-				}, nil).Once()
-				t.jiraRepo.On("AddWorklog", mock.Anything, task.ID, task.Name, timeSpent, mock.Anything).Return(nil).Once()
+			name: "subtask of jira task",
+			task: entity.Task{ID: "task-2", ParentTaskID: "10001"},
+			mockFunc: func() {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(jiraProject, nil).Once()
+				t.taskRepo.On("GetByID", mock.Anything, "10001").Return(jiraTask, nil).Once()
+				t.jiraRepo.On("AddComment", mock.Anything, "10001", "any-comment", jiraProject.Integrations[1]).Return(nil).Once()
 			},
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func() {
-			if test.mockFunc != nil {
-				test.mockFunc(test.task, test.timeSpent)
-			}
+			test.mockFunc()
 
-			err := t.useCase.AddWorklog(context.Background(), test.task, test.timeSpent)
+			err := t.useCase.AddComment(context.Background(), test.task, "any-comment")
 			t.Equal(test.expectedError, err)
 		})
 	}
-
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration"
 )
 
 type ProjectRepository interface {
@@ -22,6 +23,12 @@ type TaskRepository interface {
 	Update(ctx context.Context, task entity.Task) error
 }
 
-type IntegrationRepository interface {
-	GetTasks(ctx context.Context, projectID string, details map[string]string) (entity.Tasks, error)
+type IntegrationRegistry interface {
+	Get(integrationType entity.IntegrationType) (integration.Provider, bool)
+}
+
+// TransactionRepository runs fn atomically, the repositories given the ctx
+// passed to fn take part in the transaction.
+type TransactionRepository interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
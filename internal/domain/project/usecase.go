@@ -8,9 +8,10 @@ import (
 )
 
 type useCase struct {
-	projectRepo      ProjectRepository
-	taskRepo         TaskRepository
-	integrationRepos map[entity.IntegrationType]IntegrationRepository
+	projectRepo     ProjectRepository
+	taskRepo        TaskRepository
+	registry        IntegrationRegistry
+	transactionRepo TransactionRepository
 }
 
 type UseCase interface {
@@ -26,11 +27,12 @@ type UseCase interface {
 	DisableIntegration(ctx context.Context, integrationType entity.IntegrationType) error
 }
 
-func New(projectRepo ProjectRepository, integrationRepos map[entity.IntegrationType]IntegrationRepository, taskRepo TaskRepository) UseCase {
+func New(projectRepo ProjectRepository, registry IntegrationRegistry, taskRepo TaskRepository, transactionRepo TransactionRepository) UseCase {
 	return &useCase{
-		projectRepo:      projectRepo,
-		taskRepo:         taskRepo,
-		integrationRepos: integrationRepos,
+		projectRepo:     projectRepo,
+		taskRepo:        taskRepo,
+		registry:        registry,
+		transactionRepo: transactionRepo,
 	}
 }
 
@@ -78,9 +80,9 @@ func (u *useCase) SyncTasks(ctx context.Context) error {
 
 	var tasks entity.Tasks
 	for _, integration := range project.Integrations {
-		if integration.IsEnabled {
-			integrationRepo := u.integrationRepos[integration.Type]
-			integrationTasks, err := integrationRepo.GetTasks(ctx, project.ID, integration.Details)
+		provider, ok := u.registry.Get(integration.Type)
+		if integration.IsEnabled && ok {
+			integrationTasks, err := provider.GetTasks(ctx, project.ID, integration.Details)
 			if err != nil {
 				return fmt.Errorf("error while syncing tasks: %w", err)
 			}
@@ -89,14 +91,17 @@ func (u *useCase) SyncTasks(ctx context.Context) error {
 		}
 	}
 
-	for _, task := range tasks {
-		err := u.taskRepo.Upsert(ctx, task)
-		if err != nil {
-			return fmt.Errorf("error while inserting task: %w", err)
+	// All tasks are synced or none, a failure halfway keeps the old tasks
+	return u.transactionRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, task := range tasks {
+			err := u.taskRepo.Upsert(ctx, task)
+			if err != nil {
+				return fmt.Errorf("error while inserting task: %w", err)
+			}
 		}
-	}
 
-	return nil
+		return nil
+	})
 }
 
 func (u *useCase) AddIntegration(ctx context.Context, integration entity.Integration) error {
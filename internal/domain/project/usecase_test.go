@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	integrationMocks "github.com/azisuazusa/todo-cli/internal/domain/integration/mocks"
 	"github.com/azisuazusa/todo-cli/internal/domain/project/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -14,26 +15,30 @@ import (
 
 type UseCaseTestSuite struct {
 	suite.Suite
-	projectRepo      *mocks.ProjectRepository
-	taskRepo         *mocks.TaskRepository
-	integrationRepos map[entity.IntegrationType]*mocks.IntegrationRepository
-	useCase          UseCase
+	projectRepo     *mocks.ProjectRepository
+	taskRepo        *mocks.TaskRepository
+	providers       map[entity.IntegrationType]*integrationMocks.Provider
+	transactionRepo *mocks.TransactionRepository
+	useCase         UseCase
 }
 
 func (t *UseCaseTestSuite) SetupTest() {
 	t.projectRepo = &mocks.ProjectRepository{}
 	t.taskRepo = &mocks.TaskRepository{}
-	jiraIntegrationRepo := &mocks.IntegrationRepository{}
-	githubIntegrationRepo := &mocks.IntegrationRepository{}
-	integrationRepos := map[entity.IntegrationType]IntegrationRepository{
-		entity.IntegrationTypeJIRA:   jiraIntegrationRepo,
-		entity.IntegrationTypeGitHub: githubIntegrationRepo,
+	t.providers = map[entity.IntegrationType]*integrationMocks.Provider{
+		entity.IntegrationTypeJIRA:   {},
+		entity.IntegrationTypeGitHub: {},
 	}
-	t.integrationRepos = map[entity.IntegrationType]*mocks.IntegrationRepository{
-		entity.IntegrationTypeJIRA:   jiraIntegrationRepo,
-		entity.IntegrationTypeGitHub: githubIntegrationRepo,
+	registry := &mocks.IntegrationRegistry{}
+	for integrationType, provider := range t.providers {
+		registry.On("Get", integrationType).Return(provider, true).Maybe()
 	}
-	t.useCase = New(t.projectRepo, integrationRepos, t.taskRepo)
+	registry.On("Get", mock.Anything).Return(nil, false).Maybe()
+	t.transactionRepo = &mocks.TransactionRepository{}
+	t.transactionRepo.On("WithinTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Maybe()
+	t.useCase = New(t.projectRepo, registry, t.taskRepo, t.transactionRepo)
 }
 
 func TestUseCaseTestSuite(t *testing.T) {
@@ -230,7 +235,7 @@ func (t *UseCaseTestSuite) TestSyncTasks() {
 						},
 					},
 				}, nil).Once()
-				t.integrationRepos[entity.IntegrationTypeJIRA].On("GetTasks", context.Background(), "any-id", mock.Anything).Return(entity.Tasks{}, errors.New("any-error")).Once()
+				t.providers[entity.IntegrationTypeJIRA].On("GetTasks", context.Background(), "any-id", mock.Anything).Return(entity.Tasks{}, errors.New("any-error")).Once()
 			},
 		},
 		{
@@ -252,7 +257,7 @@ func (t *UseCaseTestSuite) TestSyncTasks() {
 						},
 					},
 				}, nil).Once()
-				t.integrationRepos[entity.IntegrationTypeJIRA].On("GetTasks", context.Background(), "any-id", mock.Anything).Return(entity.Tasks{
+				t.providers[entity.IntegrationTypeJIRA].On("GetTasks", context.Background(), "any-id", mock.Anything).Return(entity.Tasks{
 					{
 						ID:           "any-id",
 						ProjectID:    "any-id",
@@ -271,6 +276,24 @@ func (t *UseCaseTestSuite) TestSyncTasks() {
 				t.taskRepo.On("Upsert", context.Background(), mock.Anything).Return(errors.New("any-error")).Once()
 			},
 		},
+		{
+			name:        "failed to upsert tasks midway",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.projectRepo.On("GetSelectedProject", context.Background()).Return(entity.Project{
+					ID: "any-id",
+					Integrations: []entity.Integration{
+						{IsEnabled: true, Type: entity.IntegrationTypeJIRA},
+					},
+				}, nil).Once()
+				t.providers[entity.IntegrationTypeJIRA].On("GetTasks", context.Background(), "any-id", mock.Anything).Return(entity.Tasks{
+					{ID: "task-1", ProjectID: "any-id"},
+					{ID: "task-2", ProjectID: "any-id"},
+				}, nil).Once()
+				t.taskRepo.On("Upsert", context.Background(), entity.Task{ID: "task-1", ProjectID: "any-id"}).Return(nil).Once()
+				t.taskRepo.On("Upsert", context.Background(), entity.Task{ID: "task-2", ProjectID: "any-id"}).Return(errors.New("any-error")).Once()
+			},
+		},
 		{
 			name:        "success",
 			expectedErr: nil,
@@ -288,9 +311,16 @@ func (t *UseCaseTestSuite) TestSyncTasks() {
 								"token": "token",
 							},
 						},
+						{
+							IsEnabled: true,
+							Type:      entity.IntegrationTypeSlack,
+							Details: map[string]string{
+								"token": "token",
+							},
+						},
 					},
 				}, nil).Once()
-				t.integrationRepos[entity.IntegrationTypeJIRA].On("GetTasks", context.Background(), "any-id", mock.Anything).Return(entity.Tasks{
+				t.providers[entity.IntegrationTypeJIRA].On("GetTasks", context.Background(), "any-id", mock.Anything).Return(entity.Tasks{
 					{
 						ID:           "any-id",
 						ProjectID:    "any-id",
@@ -319,6 +349,7 @@ func (t *UseCaseTestSuite) TestSyncTasks() {
 				err = errors.Unwrap(err)
 			}
 			t.Equal(test.expectedErr, err)
+			t.taskRepo.AssertExpectations(t.T())
 		})
 	}
 }
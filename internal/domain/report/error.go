@@ -0,0 +1,8 @@
+package report
+
+import "errors"
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported format")
+	ErrProjectNotFound   = errors.New("project not found")
+)
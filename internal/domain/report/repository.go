@@ -0,0 +1,22 @@
+package report
+
+import (
+	"context"
+	"io"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+// FormatRepository renders a report, e.g. as Markdown.
+type FormatRepository interface {
+	Render(ctx context.Context, w io.Writer, report entity.Report) error
+}
+
+type ProjectRepository interface {
+	GetAll(ctx context.Context) (entity.Projects, error)
+	GetSelectedProject(ctx context.Context) (entity.Project, error)
+}
+
+type TaskRepository interface {
+	GetAll(ctx context.Context) (entity.Tasks, error)
+}
@@ -0,0 +1,176 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+type UseCase interface {
+	Export(ctx context.Context, format entity.ReportFormat, options entity.ReportOptions, w io.Writer) error
+}
+
+type useCase struct {
+	formatRepos map[entity.ReportFormat]FormatRepository
+	projectRepo ProjectRepository
+	taskRepo    TaskRepository
+}
+
+func New(formatRepos map[entity.ReportFormat]FormatRepository, projectRepo ProjectRepository, taskRepo TaskRepository) UseCase {
+	return &useCase{
+		formatRepos: formatRepos,
+		projectRepo: projectRepo,
+		taskRepo:    taskRepo,
+	}
+}
+
+func (u *useCase) Export(ctx context.Context, format entity.ReportFormat, options entity.ReportOptions, w io.Writer) error {
+	formatRepo, ok := u.formatRepos[format]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+
+	project, err := u.getProject(ctx, options.ProjectName)
+	if err != nil {
+		return err
+	}
+
+	tasks, err := u.taskRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting tasks: %w", err)
+	}
+
+	var projectTasks entity.Tasks
+	for _, task := range tasks {
+		if task.ProjectID == project.ID {
+			projectTasks = append(projectTasks, task)
+		}
+	}
+
+	now := time.Now()
+	week := options.Week
+	if week.IsZero() {
+		week = now
+	}
+
+	report := entity.Report{
+		Project:     project,
+		GeneratedAt: now,
+		Week:        weekSummary(project, projectTasks, startOfWeek(week), now),
+	}
+	report.Tasks = taskTree(project, projectTasks, "", 0, report.Week.Start, now)
+
+	if err = formatRepo.Render(ctx, w, report); err != nil {
+		return fmt.Errorf("error while rendering %s: %w", format, err)
+	}
+
+	return nil
+}
+
+func (u *useCase) getProject(ctx context.Context, name string) (entity.Project, error) {
+	if name == "" {
+		project, err := u.projectRepo.GetSelectedProject(ctx)
+		if err != nil {
+			return entity.Project{}, fmt.Errorf("error while getting selected project: %w", err)
+		}
+
+		return project, nil
+	}
+
+	projects, err := u.projectRepo.GetAll(ctx)
+	if err != nil {
+		return entity.Project{}, fmt.Errorf("error while getting projects: %w", err)
+	}
+
+	for _, project := range projects {
+		if entity.SameProjectName(project.Name, name) {
+			return project, nil
+		}
+	}
+
+	return entity.Project{}, fmt.Errorf("%w: %s", ErrProjectNotFound, name)
+}
+
+// taskTree nests the subtasks of parentTaskID, the top-level tasks being the
+// ones without a parent in the project. Tasks completed before since are left
+// out, together with their subtasks.
+func taskTree(project entity.Project, tasks entity.Tasks, parentTaskID string, depth int, since, now time.Time) []entity.ReportTask {
+	isTask := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		isTask[task.ID] = true
+	}
+
+	var reportTasks []entity.ReportTask
+	for _, task := range tasks {
+		isTopLevel := task.ParentTaskID == "" || !isTask[task.ParentTaskID]
+		if parentTaskID == "" && !isTopLevel || parentTaskID != "" && task.ParentTaskID != parentTaskID {
+			continue
+		}
+
+		if !task.CompletedAt.IsZero() && task.CompletedAt.Before(since) {
+			continue
+		}
+
+		reportTask := newReportTask(project, task, task.Elapsed(now))
+		reportTask.Depth = depth
+		reportTask.SubTasks = taskTree(project, tasks, task.ID, depth+1, since, now)
+		reportTasks = append(reportTasks, reportTask)
+	}
+
+	return reportTasks
+}
+
+func weekSummary(project entity.Project, tasks entity.Tasks, start, now time.Time) entity.WeekSummary {
+	summary := entity.WeekSummary{
+		Start: start,
+		End:   start.AddDate(0, 0, 7),
+	}
+
+	for day := start; day.Before(summary.End); day = day.AddDate(0, 0, 1) {
+		daySummary := entity.DaySummary{Date: day}
+		for _, task := range tasks {
+			daySummary.TimeSpent += task.TimeSpentBetween(day, day.AddDate(0, 0, 1), now)
+		}
+
+		summary.Days = append(summary.Days, daySummary)
+		summary.TimeSpent += daySummary.TimeSpent
+	}
+
+	for _, task := range tasks {
+		if timeSpent := task.TimeSpentBetween(summary.Start, summary.End, now); timeSpent > 0 {
+			summary.Tasks = append(summary.Tasks, newReportTask(project, task, timeSpent))
+		}
+
+		if !task.CompletedAt.Before(summary.Start) && task.CompletedAt.Before(summary.End) {
+			summary.Completed = append(summary.Completed, newReportTask(project, task, task.Elapsed(now)))
+		}
+	}
+
+	sort.SliceStable(summary.Tasks, func(i, j int) bool {
+		return summary.Tasks[i].TimeSpent > summary.Tasks[j].TimeSpent
+	})
+	sort.SliceStable(summary.Completed, func(i, j int) bool {
+		return summary.Completed[i].Task.CompletedAt.Before(summary.Completed[j].Task.CompletedAt)
+	})
+
+	return summary
+}
+
+func newReportTask(project entity.Project, task entity.Task, timeSpent time.Duration) entity.ReportTask {
+	reportTask := entity.ReportTask{Task: task, TimeSpent: timeSpent}
+	if integration, ok := project.Integration(task.Integration.Type); ok {
+		reportTask.IssueURL = integration.IssueURL(task.Integration.ID)
+	}
+
+	return reportTask
+}
+
+// startOfWeek is the Monday of the week containing t, at midnight.
+func startOfWeek(t time.Time) time.Time {
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,155 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/report/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseTestSuite struct {
+	suite.Suite
+	formatRepo  *mocks.FormatRepository
+	projectRepo *mocks.ProjectRepository
+	taskRepo    *mocks.TaskRepository
+	useCase     UseCase
+}
+
+func (t *UseCaseTestSuite) SetupTest() {
+	t.formatRepo = new(mocks.FormatRepository)
+	t.projectRepo = new(mocks.ProjectRepository)
+	t.taskRepo = new(mocks.TaskRepository)
+	t.useCase = New(map[entity.ReportFormat]FormatRepository{
+		entity.ReportFormatMarkdown: t.formatRepo,
+	}, t.projectRepo, t.taskRepo)
+}
+
+func TestUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseTestSuite))
+}
+
+func (t *UseCaseTestSuite) TestExport() {
+	// Wednesday, the week starts on Monday the 8th
+	week := time.Date(2024, 1, 10, 15, 0, 0, 0, time.UTC)
+	monday := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	project := entity.Project{
+		ID:           "project-1",
+		Name:         "Work",
+		Integrations: []entity.Integration{{Type: entity.IntegrationTypeJIRA, Details: map[string]string{"url": "https://any.atlassian.net"}}},
+	}
+	tasks := entity.Tasks{
+		{
+			ID:          "task-1",
+			ProjectID:   "project-1",
+			Name:        "Review",
+			Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA},
+			Histories: []entity.TaskHistory{
+				{StartedAt: monday.Add(-time.Hour), StoppedAt: monday.Add(time.Hour)},
+				{StartedAt: monday.Add(33 * time.Hour), StoppedAt: monday.Add(35 * time.Hour)},
+			},
+		},
+		{
+			ID:           "task-2",
+			ProjectID:    "project-1",
+			ParentTaskID: "task-1",
+			Name:         "Answer comments",
+			CompletedAt:  monday.Add(34 * time.Hour),
+			Histories:    []entity.TaskHistory{{StartedAt: monday.Add(30 * time.Hour), StoppedAt: monday.Add(34 * time.Hour)}},
+		},
+		{ID: "task-3", ProjectID: "project-1", Name: "Old", CompletedAt: monday.Add(-time.Hour)},
+		{ID: "task-4", ProjectID: "project-2", Name: "Other project"},
+	}
+
+	tests := []struct {
+		name        string
+		format      entity.ReportFormat
+		options     entity.ReportOptions
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "unsupported format",
+			format:      "any-format",
+			expectedErr: ErrUnsupportedFormat,
+			mockFunc:    func() {},
+		},
+		{
+			name:        "project not found",
+			format:      entity.ReportFormatMarkdown,
+			options:     entity.ReportOptions{ProjectName: "Home"},
+			expectedErr: ErrProjectNotFound,
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(entity.Projects{project}, nil).Once()
+			},
+		},
+		{
+			name:        "failed to get selected project",
+			format:      entity.ReportFormatMarkdown,
+			expectedErr: entity.ErrNoProjectSelected,
+			mockFunc: func() {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{}, entity.ErrNoProjectSelected).Once()
+			},
+		},
+		{
+			name:        "failed to render",
+			format:      entity.ReportFormatMarkdown,
+			options:     entity.ReportOptions{Week: week},
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(project, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(tasks, nil).Once()
+				t.formatRepo.On("Render", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:    "success",
+			format:  entity.ReportFormatMarkdown,
+			options: entity.ReportOptions{ProjectName: "work", Week: week},
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(entity.Projects{project}, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(tasks, nil).Once()
+				t.formatRepo.On("Render", mock.Anything, mock.Anything, mock.MatchedBy(func(report entity.Report) bool {
+					issueURL := "https://any.atlassian.net/browse/TODO-1"
+					if report.Project.ID != "project-1" || len(report.Tasks) != 1 || len(report.Tasks[0].SubTasks) != 1 {
+						return false
+					}
+
+					review, answer := report.Tasks[0], report.Tasks[0].SubTasks[0]
+					if review.IssueURL != issueURL || review.TimeSpent != 4*time.Hour || answer.Depth != 1 || answer.TimeSpent != 4*time.Hour {
+						return false
+					}
+
+					summary := report.Week
+					return summary.Start.Equal(monday) && summary.End.Equal(monday.AddDate(0, 0, 7)) &&
+						summary.TimeSpent == 7*time.Hour && len(summary.Days) == 7 &&
+						summary.Days[0].TimeSpent == time.Hour && summary.Days[1].TimeSpent == 6*time.Hour &&
+						len(summary.Tasks) == 2 && summary.Tasks[0].Task.ID == "task-2" && summary.Tasks[1].TimeSpent == 3*time.Hour &&
+						summary.Tasks[1].IssueURL == issueURL &&
+						len(summary.Completed) == 1 && summary.Completed[0].Task.ID == "task-2"
+				})).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			t.SetupTest()
+			test.mockFunc()
+
+			err := t.useCase.Export(context.Background(), test.format, test.options, &bytes.Buffer{})
+
+			if test.expectedErr != nil {
+				t.ErrorContains(err, test.expectedErr.Error())
+			} else {
+				t.NoError(err)
+			}
+			t.formatRepo.AssertExpectations(t.T())
+		})
+	}
+}
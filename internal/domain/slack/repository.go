@@ -0,0 +1,22 @@
+package slack
+
+import (
+	"context"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+type SlackRepository interface {
+	SetStatus(ctx context.Context, status Status, integrationEntity entity.Integration) error
+}
+
+type ProjectRepository interface {
+	GetByID(ctx context.Context, id string) (entity.Project, error)
+}
+
+type Status struct {
+	Text       string
+	Emoji      string
+	Expiration time.Time
+}
@@ -0,0 +1,100 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+const (
+	StatusTextName = "name"
+	StatusTextKey  = "key"
+
+	DefaultStatusEmoji = ":computer:"
+)
+
+type UseCase interface {
+	SetStatus(ctx context.Context, task entity.Task) error
+	ClearStatus(ctx context.Context, task entity.Task) error
+}
+
+type useCase struct {
+	slackRepo   SlackRepository
+	projectRepo ProjectRepository
+}
+
+func New(slackRepo SlackRepository, projectRepo ProjectRepository) UseCase {
+	return &useCase{
+		slackRepo:   slackRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+// SetStatus shows the started task as the Slack status of the workspace of
+// its project. Projects without a Slack integration are skipped.
+func (u *useCase) SetStatus(ctx context.Context, task entity.Task) error {
+	integration, ok, err := u.slackIntegration(ctx, task)
+	if err != nil || !ok {
+		return err
+	}
+
+	status := Status{
+		Text:  task.Name,
+		Emoji: integration.Details["emoji"],
+	}
+
+	if integration.Details["text"] == StatusTextKey && task.Integration.ID != "" {
+		status.Text = task.Integration.ID
+	}
+
+	if status.Emoji == "" {
+		status.Emoji = DefaultStatusEmoji
+	}
+
+	if expiration := integration.Details["expiration"]; expiration != "" {
+		duration, err := time.ParseDuration(expiration)
+		if err != nil {
+			return fmt.Errorf("error while parsing status expiration: %w", err)
+		}
+
+		status.Expiration = time.Now().Add(duration)
+	}
+
+	if err = u.slackRepo.SetStatus(ctx, status, integration); err != nil {
+		return fmt.Errorf("error while setting slack status: %w", err)
+	}
+
+	return nil
+}
+
+// ClearStatus clears the status SetStatus showed for the task, whichever
+// project is selected since.
+func (u *useCase) ClearStatus(ctx context.Context, task entity.Task) error {
+	integration, ok, err := u.slackIntegration(ctx, task)
+	if err != nil || !ok {
+		return err
+	}
+
+	if err = u.slackRepo.SetStatus(ctx, Status{}, integration); err != nil {
+		return fmt.Errorf("error while clearing slack status: %w", err)
+	}
+
+	return nil
+}
+
+func (u *useCase) slackIntegration(ctx context.Context, task entity.Task) (entity.Integration, bool, error) {
+	project, err := u.projectRepo.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return entity.Integration{}, false, fmt.Errorf("error while getting project: %w", err)
+	}
+
+	for _, integration := range project.Integrations {
+		if integration.Type == entity.IntegrationTypeSlack && integration.IsEnabled {
+			return integration, true, nil
+		}
+	}
+
+	return entity.Integration{}, false, nil
+}
@@ -0,0 +1,177 @@
+package slack_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/slack"
+	"github.com/azisuazusa/todo-cli/internal/domain/slack/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseTestSuite struct {
+	suite.Suite
+	slackRepo   *mocks.SlackRepository
+	projectRepo *mocks.ProjectRepository
+	useCase     slack.UseCase
+}
+
+func (t *UseCaseTestSuite) SetupTest() {
+	t.slackRepo = new(mocks.SlackRepository)
+	t.projectRepo = new(mocks.ProjectRepository)
+	t.useCase = slack.New(t.slackRepo, t.projectRepo)
+}
+
+func TestUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseTestSuite))
+}
+
+func (t *UseCaseTestSuite) TestSetStatus() {
+	task := entity.Task{
+		ID:        "task-1",
+		ProjectID: "project-1",
+		Name:      "any-name",
+		Integration: entity.TaskIntegration{
+			ID:   "TODO-1",
+			Type: entity.IntegrationTypeJIRA,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		expectedError error
+		mockFunc      func()
+	}{
+		{
+			name:          "failed to get project",
+			expectedError: errors.New("any-error"),
+			mockFunc: func() {
+				t.projectRepo.On("GetByID", context.Background(), "project-1").Return(entity.Project{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:          "no slack integration",
+			expectedError: nil,
+			mockFunc: func() {
+				t.projectRepo.On("GetByID", context.Background(), "project-1").Return(entity.Project{
+					Integrations: []entity.Integration{
+						{IsEnabled: true, Type: entity.IntegrationTypeJIRA},
+						{IsEnabled: false, Type: entity.IntegrationTypeSlack},
+					},
+				}, nil).Once()
+			},
+		},
+		{
+			name:          "failed to set status",
+			expectedError: errors.New("any-error"),
+			mockFunc: func() {
+				integration := entity.Integration{IsEnabled: true, Type: entity.IntegrationTypeSlack, Details: map[string]string{}}
+				t.projectRepo.On("GetByID", context.Background(), "project-1").Return(entity.Project{
+					Integrations: []entity.Integration{integration},
+				}, nil).Once()
+				t.slackRepo.On("SetStatus", context.Background(), slack.Status{Text: "any-name", Emoji: slack.DefaultStatusEmoji}, integration).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:          "success with task name",
+			expectedError: nil,
+			mockFunc: func() {
+				integration := entity.Integration{IsEnabled: true, Type: entity.IntegrationTypeSlack, Details: map[string]string{
+					"text":  slack.StatusTextName,
+					"emoji": ":tomato:",
+				}}
+				t.projectRepo.On("GetByID", context.Background(), "project-1").Return(entity.Project{
+					Integrations: []entity.Integration{integration},
+				}, nil).Once()
+				t.slackRepo.On("SetStatus", context.Background(), slack.Status{Text: "any-name", Emoji: ":tomato:"}, integration).Return(nil).Once()
+			},
+		},
+		{
+			name:          "success with issue key and expiration",
+			expectedError: nil,
+			mockFunc: func() {
+				integration := entity.Integration{IsEnabled: true, Type: entity.IntegrationTypeSlack, Details: map[string]string{
+					"text":       slack.StatusTextKey,
+					"expiration": "25m",
+				}}
+				t.projectRepo.On("GetByID", context.Background(), "project-1").Return(entity.Project{
+					Integrations: []entity.Integration{integration},
+				}, nil).Once()
+				t.slackRepo.On("SetStatus", context.Background(), mock.MatchedBy(func(status slack.Status) bool {
+					expiresIn := time.Until(status.Expiration)
+					return status.Text == "TODO-1" && status.Emoji == slack.DefaultStatusEmoji && expiresIn > 24*time.Minute && expiresIn <= 25*time.Minute
+				}), integration).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.mockFunc()
+			err := t.useCase.SetStatus(context.Background(), task)
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			t.Equal(test.expectedError, err)
+			t.slackRepo.AssertExpectations(t.T())
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestClearStatus() {
+	task := entity.Task{ID: "task-1", ProjectID: "project-1"}
+
+	tests := []struct {
+		name          string
+		expectedError error
+		mockFunc      func()
+	}{
+		{
+			name:          "no slack integration",
+			expectedError: nil,
+			mockFunc: func() {
+				t.projectRepo.On("GetByID", context.Background(), "project-1").Return(entity.Project{}, nil).Once()
+			},
+		},
+		{
+			name:          "failed to clear status",
+			expectedError: errors.New("any-error"),
+			mockFunc: func() {
+				integration := entity.Integration{IsEnabled: true, Type: entity.IntegrationTypeSlack}
+				t.projectRepo.On("GetByID", context.Background(), "project-1").Return(entity.Project{
+					Integrations: []entity.Integration{integration},
+				}, nil).Once()
+				t.slackRepo.On("SetStatus", context.Background(), slack.Status{}, integration).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:          "success",
+			expectedError: nil,
+			mockFunc: func() {
+				integration := entity.Integration{IsEnabled: true, Type: entity.IntegrationTypeSlack}
+				t.projectRepo.On("GetByID", context.Background(), "project-1").Return(entity.Project{
+					Integrations: []entity.Integration{integration},
+				}, nil).Once()
+				t.slackRepo.On("SetStatus", context.Background(), slack.Status{}, integration).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.mockFunc()
+			err := t.useCase.ClearStatus(context.Background(), task)
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			t.Equal(test.expectedError, err)
+			t.slackRepo.AssertExpectations(t.T())
+		})
+	}
+}
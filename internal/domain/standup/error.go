@@ -0,0 +1,5 @@
+package standup
+
+import "errors"
+
+var ErrProjectNotFound = errors.New("project not found")
@@ -0,0 +1,27 @@
+package standup
+
+import (
+	"context"
+	"io"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+// TemplateRepository renders a standup as text.
+type TemplateRepository interface {
+	Render(ctx context.Context, w io.Writer, standup entity.Standup) error
+}
+
+// WebhookRepository posts a rendered standup, it returns
+// entity.ErrNoStandupWebhook when no webhook is configured for it.
+type WebhookRepository interface {
+	PostStandup(ctx context.Context, text string) error
+}
+
+type ProjectRepository interface {
+	GetAll(ctx context.Context) (entity.Projects, error)
+}
+
+type TaskRepository interface {
+	GetAll(ctx context.Context) (entity.Tasks, error)
+}
@@ -0,0 +1,139 @@
+package standup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+type UseCase interface {
+	Generate(ctx context.Context, options entity.StandupOptions) (string, error)
+	Post(ctx context.Context, text string) error
+}
+
+type useCase struct {
+	templateRepo TemplateRepository
+	webhookRepo  WebhookRepository
+	projectRepo  ProjectRepository
+	taskRepo     TaskRepository
+}
+
+func New(templateRepo TemplateRepository, webhookRepo WebhookRepository, projectRepo ProjectRepository, taskRepo TaskRepository) UseCase {
+	return &useCase{
+		templateRepo: templateRepo,
+		webhookRepo:  webhookRepo,
+		projectRepo:  projectRepo,
+		taskRepo:     taskRepo,
+	}
+}
+
+// Generate renders what was done on the previous working day, what is being
+// worked on and what is blocked.
+func (u *useCase) Generate(ctx context.Context, options entity.StandupOptions) (string, error) {
+	projects, err := u.getProjects(ctx, options.ProjectName)
+	if err != nil {
+		return "", err
+	}
+
+	tasks, err := u.taskRepo.GetAll(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error while getting tasks: %w", err)
+	}
+
+	now := time.Now()
+	date := options.Date
+	if date.IsZero() {
+		date = now
+	}
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	standup := entity.Standup{
+		Date:  day,
+		Since: previousWorkingDay(day),
+	}
+	until := standup.Since.AddDate(0, 0, 1)
+	for _, task := range tasks {
+		project, ok := projects[task.ProjectID]
+		if !ok {
+			continue
+		}
+
+		timeSpent := task.TimeSpentBetween(standup.Since, until, now)
+		isCompleted := !task.CompletedAt.Before(standup.Since) && task.CompletedAt.Before(until)
+		if timeSpent > 0 || isCompleted {
+			standup.Yesterday = append(standup.Yesterday, newStandupTask(project, task, timeSpent))
+		}
+
+		if task.IsStarted {
+			standup.Today = append(standup.Today, newStandupTask(project, task, task.TimeSpentBetween(day, day.AddDate(0, 0, 1), now)))
+		}
+
+		if task.CompletedAt.IsZero() && task.HasTag(entity.BlockedTag) {
+			standup.Blockers = append(standup.Blockers, newStandupTask(project, task, task.Elapsed(now)))
+		}
+	}
+
+	sort.SliceStable(standup.Yesterday, func(i, j int) bool {
+		return standup.Yesterday[i].TimeSpent > standup.Yesterday[j].TimeSpent
+	})
+
+	var buf bytes.Buffer
+	if err = u.templateRepo.Render(ctx, &buf, standup); err != nil {
+		return "", fmt.Errorf("error while rendering standup: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+func (u *useCase) Post(ctx context.Context, text string) error {
+	if err := u.webhookRepo.PostStandup(ctx, text); err != nil {
+		return fmt.Errorf("error while posting standup: %w", err)
+	}
+
+	return nil
+}
+
+// getProjects returns the projects the standup covers by ID, every project
+// when name is empty.
+func (u *useCase) getProjects(ctx context.Context, name string) (map[string]entity.Project, error) {
+	projects, err := u.projectRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting projects: %w", err)
+	}
+
+	projectsByID := map[string]entity.Project{}
+	for _, project := range projects {
+		if name == "" || entity.SameProjectName(project.Name, name) {
+			projectsByID[project.ID] = project
+		}
+	}
+
+	if len(projectsByID) == 0 && name != "" {
+		return nil, fmt.Errorf("%w: %s", ErrProjectNotFound, name)
+	}
+
+	return projectsByID, nil
+}
+
+func newStandupTask(project entity.Project, task entity.Task, timeSpent time.Duration) entity.StandupTask {
+	standupTask := entity.StandupTask{Task: task, Project: project, TimeSpent: timeSpent}
+	if integration, ok := project.Integration(task.Integration.Type); ok {
+		standupTask.IssueURL = integration.IssueURL(task.Integration.ID)
+	}
+
+	return standupTask
+}
+
+// previousWorkingDay is the weekday before day, Friday for a Monday.
+func previousWorkingDay(day time.Time) time.Time {
+	day = day.AddDate(0, 0, -1)
+	for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	return day
+}
@@ -0,0 +1,152 @@
+package standup
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/standup/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseTestSuite struct {
+	suite.Suite
+	templateRepo *mocks.TemplateRepository
+	webhookRepo  *mocks.WebhookRepository
+	projectRepo  *mocks.ProjectRepository
+	taskRepo     *mocks.TaskRepository
+	useCase      UseCase
+}
+
+func (t *UseCaseTestSuite) SetupTest() {
+	t.templateRepo = new(mocks.TemplateRepository)
+	t.webhookRepo = new(mocks.WebhookRepository)
+	t.projectRepo = new(mocks.ProjectRepository)
+	t.taskRepo = new(mocks.TaskRepository)
+	t.useCase = New(t.templateRepo, t.webhookRepo, t.projectRepo, t.taskRepo)
+}
+
+func TestUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseTestSuite))
+}
+
+func (t *UseCaseTestSuite) TestGenerate() {
+	monday := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	friday := monday.AddDate(0, 0, -3)
+	projects := entity.Projects{
+		{ID: "project-1", Name: "Work", Integrations: []entity.Integration{{Type: entity.IntegrationTypeJIRA, Details: map[string]string{"url": "https://any.atlassian.net"}}}},
+		{ID: "project-2", Name: "Home"},
+	}
+	tasks := entity.Tasks{
+		{
+			ID:          "task-1",
+			ProjectID:   "project-1",
+			Name:        "Review",
+			IsStarted:   true,
+			Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA},
+			Histories: []entity.TaskHistory{
+				{StartedAt: friday.Add(9 * time.Hour), StoppedAt: friday.Add(11 * time.Hour)},
+				{StartedAt: monday.Add(9 * time.Hour), StoppedAt: monday.Add(9*time.Hour + 30*time.Minute)},
+			},
+		},
+		{ID: "task-2", ProjectID: "project-1", Name: "Answer comments", CompletedAt: friday.Add(17 * time.Hour)},
+		{ID: "task-3", ProjectID: "project-1", Name: "Weekend", Histories: []entity.TaskHistory{{StartedAt: monday.Add(-10 * time.Hour), StoppedAt: monday.Add(-9 * time.Hour)}}},
+		{ID: "task-4", ProjectID: "project-1", Name: "Deploy", Tags: []string{"Blocked"}},
+		{ID: "task-5", ProjectID: "project-2", Name: "Groceries", Tags: []string{"blocked"}, CompletedAt: friday},
+		{ID: "task-6", ProjectID: "project-2", Name: "Fix the sink", Histories: []entity.TaskHistory{{StartedAt: friday.Add(20 * time.Hour), StoppedAt: friday.Add(21 * time.Hour)}}},
+		{ID: "task-7", ProjectID: "project-1", Name: "Merge", CompletedAt: monday.Add(7 * time.Hour)},
+	}
+
+	tests := []struct {
+		name         string
+		options      entity.StandupOptions
+		expectedText string
+		expectedErr  error
+		mockFunc     func()
+	}{
+		{
+			name:        "project not found",
+			options:     entity.StandupOptions{ProjectName: "Hobby", Date: monday},
+			expectedErr: ErrProjectNotFound,
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(projects, nil).Once()
+			},
+		},
+		{
+			name:        "failed to get tasks",
+			options:     entity.StandupOptions{Date: monday},
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(projects, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(nil, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:         "every project",
+			options:      entity.StandupOptions{Date: monday.Add(8 * time.Hour)},
+			expectedText: "any-standup",
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(projects, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(tasks, nil).Once()
+				t.templateRepo.On("Render", mock.Anything, mock.Anything, mock.MatchedBy(func(standup entity.Standup) bool {
+					return standup.Date.Equal(monday) && standup.Since.Equal(friday) &&
+						len(standup.Yesterday) == 4 &&
+						standup.Yesterday[0].Task.ID == "task-1" && standup.Yesterday[0].TimeSpent == 2*time.Hour &&
+						standup.Yesterday[0].IssueURL == "https://any.atlassian.net/browse/TODO-1" &&
+						standup.Yesterday[1].Task.ID == "task-6" && standup.Yesterday[1].Project.Name == "Home" &&
+						standup.Yesterday[2].Task.ID == "task-2" && standup.Yesterday[3].Task.ID == "task-5" &&
+						len(standup.Today) == 1 && standup.Today[0].TimeSpent == 30*time.Minute &&
+						len(standup.Blockers) == 1 && standup.Blockers[0].Task.ID == "task-4"
+				})).Return(func(ctx context.Context, w io.Writer, standup entity.Standup) error {
+					_, err := io.WriteString(w, "any-standup")
+					return err
+				}).Once()
+			},
+		},
+		{
+			name:         "one project",
+			options:      entity.StandupOptions{ProjectName: "home", Date: monday},
+			expectedText: "any-standup",
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(projects, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(tasks, nil).Once()
+				t.templateRepo.On("Render", mock.Anything, mock.Anything, mock.MatchedBy(func(standup entity.Standup) bool {
+					return len(standup.Yesterday) == 2 && standup.Yesterday[0].Task.ID == "task-6" &&
+						len(standup.Today) == 0 && len(standup.Blockers) == 0
+				})).Return(func(ctx context.Context, w io.Writer, standup entity.Standup) error {
+					_, err := io.WriteString(w, "any-standup")
+					return err
+				}).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			t.SetupTest()
+			test.mockFunc()
+
+			text, err := t.useCase.Generate(context.Background(), test.options)
+
+			if test.expectedErr != nil {
+				t.ErrorContains(err, test.expectedErr.Error())
+			} else {
+				t.NoError(err)
+			}
+			t.Equal(test.expectedText, text)
+			t.templateRepo.AssertExpectations(t.T())
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestPost() {
+	t.webhookRepo.On("PostStandup", mock.Anything, "any-standup").Return(entity.ErrNoStandupWebhook).Once()
+
+	err := t.useCase.Post(context.Background(), "any-standup")
+
+	t.ErrorIs(err, entity.ErrNoStandupWebhook)
+}
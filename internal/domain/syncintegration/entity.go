@@ -4,7 +4,38 @@ type SyncIntegrationType string
 
 const Dropbox SyncIntegrationType = "dropbox"
 
+// Encryption of the synced database, kept in the integration details.
+const (
+	EncryptionDetailKey           = "encryption"
+	EncryptionPassphraseDetailKey = "encryption_passphrase"
+	EncryptionKeyFileDetailKey    = "encryption_key_file"
+
+	EncryptionPassphrase = "passphrase"
+	EncryptionKeyFile    = "key_file"
+)
+
 type SyncIntegration struct {
 	Type    SyncIntegrationType
 	Details map[string]string
 }
+
+// WithEncryption returns a copy of the integration using the given encryption
+// details. Empty details disable encryption.
+func (s SyncIntegration) WithEncryption(encryption map[string]string) SyncIntegration {
+	details := make(map[string]string, len(s.Details)+len(encryption))
+	for key, value := range s.Details {
+		details[key] = value
+	}
+
+	delete(details, EncryptionDetailKey)
+	delete(details, EncryptionPassphraseDetailKey)
+	delete(details, EncryptionKeyFileDetailKey)
+	for _, key := range []string{EncryptionDetailKey, EncryptionPassphraseDetailKey, EncryptionKeyFileDetailKey} {
+		if value := encryption[key]; value != "" {
+			details[key] = value
+		}
+	}
+
+	s.Details = details
+	return s
+}
@@ -3,3 +3,7 @@ package syncintegration
 import "errors"
 
 var ErrSyncIntegrationNotFound = errors.New("sync integration not found")
+var ErrEncryptionKeyRequired = errors.New("synced database is encrypted, set the encryption key with `todo setting sync-encryption`")
+var ErrUnencryptedDatabase = errors.New("synced database is not encrypted, it is encrypted by the next upload from a device with the encryption key set")
+var ErrInvalidEncryptionKey = errors.New("unable to decrypt synced database, the encryption key is wrong")
+var ErrUnsupportedEncryption = errors.New("unsupported sync encryption")
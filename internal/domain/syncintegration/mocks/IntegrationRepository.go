@@ -23,21 +23,33 @@ func (_m *IntegrationRepository) EXPECT() *IntegrationRepository_Expecter {
 }
 
 // Download provides a mock function with given fields: ctx, integration
-func (_m *IntegrationRepository) Download(ctx context.Context, integration syncintegration.SyncIntegration) error {
+func (_m *IntegrationRepository) Download(ctx context.Context, integration syncintegration.SyncIntegration) ([]byte, error) {
 	ret := _m.Called(ctx, integration)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Download")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, syncintegration.SyncIntegration) error); ok {
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, syncintegration.SyncIntegration) ([]byte, error)); ok {
+		return rf(ctx, integration)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, syncintegration.SyncIntegration) []byte); ok {
 		r0 = rf(ctx, integration)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, syncintegration.SyncIntegration) error); ok {
+		r1 = rf(ctx, integration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // IntegrationRepository_Download_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Download'
@@ -59,27 +71,27 @@ func (_c *IntegrationRepository_Download_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *IntegrationRepository_Download_Call) Return(_a0 error) *IntegrationRepository_Download_Call {
-	_c.Call.Return(_a0)
+func (_c *IntegrationRepository_Download_Call) Return(_a0 []byte, _a1 error) *IntegrationRepository_Download_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IntegrationRepository_Download_Call) RunAndReturn(run func(context.Context, syncintegration.SyncIntegration) error) *IntegrationRepository_Download_Call {
+func (_c *IntegrationRepository_Download_Call) RunAndReturn(run func(context.Context, syncintegration.SyncIntegration) ([]byte, error)) *IntegrationRepository_Download_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Upload provides a mock function with given fields: ctx, integration
-func (_m *IntegrationRepository) Upload(ctx context.Context, integration syncintegration.SyncIntegration) error {
-	ret := _m.Called(ctx, integration)
+// Upload provides a mock function with given fields: ctx, integration, content
+func (_m *IntegrationRepository) Upload(ctx context.Context, integration syncintegration.SyncIntegration, content []byte) error {
+	ret := _m.Called(ctx, integration, content)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Upload")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, syncintegration.SyncIntegration) error); ok {
-		r0 = rf(ctx, integration)
+	if rf, ok := ret.Get(0).(func(context.Context, syncintegration.SyncIntegration, []byte) error); ok {
+		r0 = rf(ctx, integration, content)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -95,13 +107,14 @@ type IntegrationRepository_Upload_Call struct {
 // Upload is a helper method to define mock.On call
 //   - ctx context.Context
 //   - integration syncintegration.SyncIntegration
-func (_e *IntegrationRepository_Expecter) Upload(ctx interface{}, integration interface{}) *IntegrationRepository_Upload_Call {
-	return &IntegrationRepository_Upload_Call{Call: _e.mock.On("Upload", ctx, integration)}
+//   - content []byte
+func (_e *IntegrationRepository_Expecter) Upload(ctx interface{}, integration interface{}, content interface{}) *IntegrationRepository_Upload_Call {
+	return &IntegrationRepository_Upload_Call{Call: _e.mock.On("Upload", ctx, integration, content)}
 }
 
-func (_c *IntegrationRepository_Upload_Call) Run(run func(ctx context.Context, integration syncintegration.SyncIntegration)) *IntegrationRepository_Upload_Call {
+func (_c *IntegrationRepository_Upload_Call) Run(run func(ctx context.Context, integration syncintegration.SyncIntegration, content []byte)) *IntegrationRepository_Upload_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(syncintegration.SyncIntegration))
+		run(args[0].(context.Context), args[1].(syncintegration.SyncIntegration), args[2].([]byte))
 	})
 	return _c
 }
@@ -111,7 +124,7 @@ func (_c *IntegrationRepository_Upload_Call) Return(_a0 error) *IntegrationRepos
 	return _c
 }
 
-func (_c *IntegrationRepository_Upload_Call) RunAndReturn(run func(context.Context, syncintegration.SyncIntegration) error) *IntegrationRepository_Upload_Call {
+func (_c *IntegrationRepository_Upload_Call) RunAndReturn(run func(context.Context, syncintegration.SyncIntegration, []byte) error) *IntegrationRepository_Upload_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -68,6 +68,146 @@ func (_c *UseCase_Download_Call) RunAndReturn(run func(context.Context) error) *
 	return _c
 }
 
+// RotateSyncEncryption provides a mock function with given fields: ctx, encryption
+func (_m *UseCase) RotateSyncEncryption(ctx context.Context, encryption map[string]string) error {
+	ret := _m.Called(ctx, encryption)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSyncEncryption")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]string) error); ok {
+		r0 = rf(ctx, encryption)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_RotateSyncEncryption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSyncEncryption'
+type UseCase_RotateSyncEncryption_Call struct {
+	*mock.Call
+}
+
+// RotateSyncEncryption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - encryption map[string]string
+func (_e *UseCase_Expecter) RotateSyncEncryption(ctx interface{}, encryption interface{}) *UseCase_RotateSyncEncryption_Call {
+	return &UseCase_RotateSyncEncryption_Call{Call: _e.mock.On("RotateSyncEncryption", ctx, encryption)}
+}
+
+func (_c *UseCase_RotateSyncEncryption_Call) Run(run func(ctx context.Context, encryption map[string]string)) *UseCase_RotateSyncEncryption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *UseCase_RotateSyncEncryption_Call) Return(_a0 error) *UseCase_RotateSyncEncryption_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_RotateSyncEncryption_Call) RunAndReturn(run func(context.Context, map[string]string) error) *UseCase_RotateSyncEncryption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SecureCredentials provides a mock function with given fields: ctx
+func (_m *UseCase) SecureCredentials(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SecureCredentials")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_SecureCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecureCredentials'
+type UseCase_SecureCredentials_Call struct {
+	*mock.Call
+}
+
+// SecureCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UseCase_Expecter) SecureCredentials(ctx interface{}) *UseCase_SecureCredentials_Call {
+	return &UseCase_SecureCredentials_Call{Call: _e.mock.On("SecureCredentials", ctx)}
+}
+
+func (_c *UseCase_SecureCredentials_Call) Run(run func(ctx context.Context)) *UseCase_SecureCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UseCase_SecureCredentials_Call) Return(_a0 error) *UseCase_SecureCredentials_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_SecureCredentials_Call) RunAndReturn(run func(context.Context) error) *UseCase_SecureCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSyncEncryption provides a mock function with given fields: ctx, encryption
+func (_m *UseCase) SetSyncEncryption(ctx context.Context, encryption map[string]string) error {
+	ret := _m.Called(ctx, encryption)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSyncEncryption")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]string) error); ok {
+		r0 = rf(ctx, encryption)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_SetSyncEncryption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSyncEncryption'
+type UseCase_SetSyncEncryption_Call struct {
+	*mock.Call
+}
+
+// SetSyncEncryption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - encryption map[string]string
+func (_e *UseCase_Expecter) SetSyncEncryption(ctx interface{}, encryption interface{}) *UseCase_SetSyncEncryption_Call {
+	return &UseCase_SetSyncEncryption_Call{Call: _e.mock.On("SetSyncEncryption", ctx, encryption)}
+}
+
+func (_c *UseCase_SetSyncEncryption_Call) Run(run func(ctx context.Context, encryption map[string]string)) *UseCase_SetSyncEncryption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *UseCase_SetSyncEncryption_Call) Return(_a0 error) *UseCase_SetSyncEncryption_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_SetSyncEncryption_Call) RunAndReturn(run func(context.Context, map[string]string) error) *UseCase_SetSyncEncryption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetSyncIntegration provides a mock function with given fields: ctx, integration
 func (_m *UseCase) SetSyncIntegration(ctx context.Context, integration syncintegration.SyncIntegration) error {
 	ret := _m.Called(ctx, integration)
@@ -11,11 +11,32 @@ type SettingRepository interface {
 	GetSyncIntegration(ctx context.Context) (SyncIntegration, error)
 }
 
+type ProjectRepository interface {
+	GetAll(ctx context.Context) (entity.Projects, error)
+	Update(ctx context.Context, project entity.Project) error
+}
+
 type TaskRepository interface {
 	GetTasks(ctx context.Context) (entity.Tasks, error)
 }
 
 type IntegrationRepository interface {
-	Upload(ctx context.Context, integration SyncIntegration) error
-	Download(ctx context.Context, integration SyncIntegration) error
+	Upload(ctx context.Context, integration SyncIntegration, content []byte) error
+	Download(ctx context.Context, integration SyncIntegration) ([]byte, error)
+}
+
+type DatabaseRepository interface {
+	ReadLocal(ctx context.Context) ([]byte, error)
+	WriteRemote(ctx context.Context, content []byte) error
+}
+
+type EncryptionRepository interface {
+	Encrypt(ctx context.Context, integration SyncIntegration, content []byte) ([]byte, error)
+	Decrypt(ctx context.Context, integration SyncIntegration, content []byte) ([]byte, error)
+}
+
+// TransactionRepository runs fn atomically, the repositories given the ctx
+// passed to fn take part in the transaction.
+type TransactionRepository interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
@@ -3,23 +3,36 @@ package syncintegration
 import (
 	"context"
 	"fmt"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 )
 
 type UseCase interface {
 	SetSyncIntegration(ctx context.Context, integration SyncIntegration) error
 	Upload(ctx context.Context) error
 	Download(ctx context.Context) error
+	SecureCredentials(ctx context.Context) error
+	SetSyncEncryption(ctx context.Context, encryption map[string]string) error
+	RotateSyncEncryption(ctx context.Context, encryption map[string]string) error
 }
 
 type useCase struct {
 	settingRepo      SettingRepository
 	integrationRepos map[SyncIntegrationType]IntegrationRepository
+	projectRepo      ProjectRepository
+	databaseRepo     DatabaseRepository
+	encryptionRepo   EncryptionRepository
+	transactionRepo  TransactionRepository
 }
 
-func New(settingRepo SettingRepository, integrationRepo map[SyncIntegrationType]IntegrationRepository) UseCase {
+func New(settingRepo SettingRepository, integrationRepo map[SyncIntegrationType]IntegrationRepository, projectRepo ProjectRepository, databaseRepo DatabaseRepository, encryptionRepo EncryptionRepository, transactionRepo TransactionRepository) UseCase {
 	return &useCase{
 		settingRepo:      settingRepo,
 		integrationRepos: integrationRepo,
+		projectRepo:      projectRepo,
+		databaseRepo:     databaseRepo,
+		encryptionRepo:   encryptionRepo,
+		transactionRepo:  transactionRepo,
 	}
 }
 
@@ -42,7 +55,21 @@ func (u *useCase) Upload(ctx context.Context) error {
 		return nil
 	}
 
-	if err = u.integrationRepos[integration.Type].Upload(ctx, integration); err != nil {
+	if err = u.secureCredentials(ctx, integration); err != nil {
+		return fmt.Errorf("error while securing credentials: %w", err)
+	}
+
+	content, err := u.databaseRepo.ReadLocal(ctx)
+	if err != nil {
+		return fmt.Errorf("error while reading database: %w", err)
+	}
+
+	content, err = u.encryptionRepo.Encrypt(ctx, integration, content)
+	if err != nil {
+		return fmt.Errorf("error while encrypting database: %w", err)
+	}
+
+	if err = u.integrationRepos[integration.Type].Upload(ctx, integration, content); err != nil {
 		return fmt.Errorf("error while uploading: %w", err)
 	}
 
@@ -59,9 +86,117 @@ func (u *useCase) Download(ctx context.Context) error {
 		return nil
 	}
 
-	if err = u.integrationRepos[integration.Type].Download(ctx, integration); err != nil {
+	content, err := u.integrationRepos[integration.Type].Download(ctx, integration)
+	if err != nil {
+		return fmt.Errorf("error while downloading: %w", err)
+	}
+
+	content, err = u.encryptionRepo.Decrypt(ctx, integration, content)
+	if err != nil {
+		return fmt.Errorf("error while decrypting database: %w", err)
+	}
+
+	if err = u.databaseRepo.WriteRemote(ctx, content); err != nil {
+		return fmt.Errorf("error while writing database: %w", err)
+	}
+
+	return nil
+}
+
+func (u *useCase) SetSyncEncryption(ctx context.Context, encryption map[string]string) error {
+	integration, err := u.settingRepo.GetSyncIntegration(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting integration: %w", err)
+	}
+
+	if err = u.settingRepo.SetSyncIntegration(ctx, integration.WithEncryption(encryption)); err != nil {
+		return fmt.Errorf("error while setting integration: %w", err)
+	}
+
+	return nil
+}
+
+// RotateSyncEncryption re-encrypts the synced database with a new key. The
+// remote copy is decrypted with the current key first, so a rotation never
+// loses changes that were not downloaded yet.
+func (u *useCase) RotateSyncEncryption(ctx context.Context, encryption map[string]string) error {
+	integration, err := u.settingRepo.GetSyncIntegration(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting integration: %w", err)
+	}
+
+	content, err := u.integrationRepos[integration.Type].Download(ctx, integration)
+	if err != nil {
 		return fmt.Errorf("error while downloading: %w", err)
 	}
 
+	content, err = u.encryptionRepo.Decrypt(ctx, integration, content)
+	if err != nil {
+		return fmt.Errorf("error while decrypting database: %w", err)
+	}
+
+	rotatedIntegration := integration.WithEncryption(encryption)
+	content, err = u.encryptionRepo.Encrypt(ctx, rotatedIntegration, content)
+	if err != nil {
+		return fmt.Errorf("error while encrypting database: %w", err)
+	}
+
+	if err = u.integrationRepos[integration.Type].Upload(ctx, rotatedIntegration, content); err != nil {
+		return fmt.Errorf("error while uploading: %w", err)
+	}
+
+	if err = u.settingRepo.SetSyncIntegration(ctx, rotatedIntegration); err != nil {
+		return fmt.Errorf("error while setting integration: %w", err)
+	}
+
 	return nil
 }
+
+func (u *useCase) SecureCredentials(ctx context.Context) error {
+	integration, err := u.settingRepo.GetSyncIntegration(ctx)
+	if err != nil && err != ErrSyncIntegrationNotFound {
+		return fmt.Errorf("error while getting integration: %w", err)
+	}
+
+	if err = u.secureCredentials(ctx, integration); err != nil {
+		return fmt.Errorf("error while securing credentials: %w", err)
+	}
+
+	return nil
+}
+
+// secureCredentials saves again every integration still holding plaintext
+// credentials, which moves them into the secret store before the database
+// leaves the machine. The updates run in one transaction so no credential is
+// left behind when one fails.
+func (u *useCase) secureCredentials(ctx context.Context, integration SyncIntegration) error {
+	return u.transactionRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+		projects, err := u.projectRepo.GetAll(ctx)
+		if err != nil {
+			return fmt.Errorf("error while getting projects: %w", err)
+		}
+
+		for _, project := range projects {
+			for _, projectIntegration := range project.Integrations {
+				if !entity.HasPlaintextSecrets(projectIntegration.Details, projectIntegration.SecretKeys()) {
+					continue
+				}
+
+				if err = u.projectRepo.Update(ctx, project); err != nil {
+					return fmt.Errorf("error while updating project: %w", err)
+				}
+				break
+			}
+		}
+
+		if !entity.HasPlaintextSecrets(integration.Details, entity.SecretDetailKeys) {
+			return nil
+		}
+
+		if err = u.settingRepo.SetSyncIntegration(ctx, integration); err != nil {
+			return fmt.Errorf("error while setting sync integration: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -3,10 +3,13 @@ package syncintegration_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 	"github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
 	"github.com/azisuazusa/todo-cli/internal/domain/syncintegration/mocks"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -14,6 +17,10 @@ type UseCaseTestSuite struct {
 	suite.Suite
 	settingRepo      *mocks.SettingRepository
 	integrationRepos map[syncintegration.SyncIntegrationType]*mocks.IntegrationRepository
+	projectRepo      *mocks.ProjectRepository
+	databaseRepo     *mocks.DatabaseRepository
+	encryptionRepo   *mocks.EncryptionRepository
+	transactionRepo  *mocks.TransactionRepository
 	useCase          syncintegration.UseCase
 }
 
@@ -26,7 +33,14 @@ func (t *UseCaseTestSuite) SetupTest() {
 	t.integrationRepos = map[syncintegration.SyncIntegrationType]*mocks.IntegrationRepository{
 		syncintegration.Dropbox: dropboxIntegrationRepo,
 	}
-	t.useCase = syncintegration.New(t.settingRepo, integrationRepos)
+	t.projectRepo = &mocks.ProjectRepository{}
+	t.databaseRepo = &mocks.DatabaseRepository{}
+	t.encryptionRepo = &mocks.EncryptionRepository{}
+	t.transactionRepo = &mocks.TransactionRepository{}
+	t.transactionRepo.On("WithinTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Maybe()
+	t.useCase = syncintegration.New(t.settingRepo, integrationRepos, t.projectRepo, t.databaseRepo, t.encryptionRepo, t.transactionRepo)
 }
 
 func TestUseCaseTestSuite(t *testing.T) {
@@ -91,6 +105,30 @@ func (t *UseCaseTestSuite) TestUpload() {
 				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(syncintegration.SyncIntegration{}, syncintegration.ErrSyncIntegrationNotFound).Once()
 			},
 		},
+		{
+			name:          "failed to secure credentials",
+			expectedError: fmt.Errorf("error while getting projects: %w", errors.New("any-error")),
+			mockFunc: func() {
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(syncintegration.SyncIntegration{
+					Type: syncintegration.Dropbox,
+				}, nil).Once()
+				t.projectRepo.On("GetAll", context.Background()).Return(entity.Projects{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:          "failed to encrypt",
+			expectedError: errors.New("any-error"),
+			mockFunc: func() {
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(syncintegration.SyncIntegration{
+					Type: syncintegration.Dropbox,
+				}, nil).Once()
+				t.projectRepo.On("GetAll", context.Background()).Return(entity.Projects{}, nil).Once()
+				t.databaseRepo.On("ReadLocal", context.Background()).Return([]byte("any-content"), nil).Once()
+				t.encryptionRepo.On("Encrypt", context.Background(), syncintegration.SyncIntegration{
+					Type: syncintegration.Dropbox,
+				}, []byte("any-content")).Return(nil, errors.New("any-error")).Once()
+			},
+		},
 		{
 			name:          "failed to upload",
 			expectedError: errors.New("any-error"),
@@ -98,9 +136,14 @@ func (t *UseCaseTestSuite) TestUpload() {
 				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(syncintegration.SyncIntegration{
 					Type: syncintegration.Dropbox,
 				}, nil).Once()
+				t.projectRepo.On("GetAll", context.Background()).Return(entity.Projects{}, nil).Once()
+				t.databaseRepo.On("ReadLocal", context.Background()).Return([]byte("any-content"), nil).Once()
+				t.encryptionRepo.On("Encrypt", context.Background(), syncintegration.SyncIntegration{
+					Type: syncintegration.Dropbox,
+				}, []byte("any-content")).Return([]byte("any-encrypted-content"), nil).Once()
 				t.integrationRepos[syncintegration.Dropbox].On("Upload", context.Background(), syncintegration.SyncIntegration{
 					Type: syncintegration.Dropbox,
-				}).Return(errors.New("any-error")).Once()
+				}, []byte("any-encrypted-content")).Return(errors.New("any-error")).Once()
 			},
 		},
 		{
@@ -110,9 +153,14 @@ func (t *UseCaseTestSuite) TestUpload() {
 				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(syncintegration.SyncIntegration{
 					Type: syncintegration.Dropbox,
 				}, nil).Once()
+				t.projectRepo.On("GetAll", context.Background()).Return(entity.Projects{}, nil).Once()
+				t.databaseRepo.On("ReadLocal", context.Background()).Return([]byte("any-content"), nil).Once()
+				t.encryptionRepo.On("Encrypt", context.Background(), syncintegration.SyncIntegration{
+					Type: syncintegration.Dropbox,
+				}, []byte("any-content")).Return([]byte("any-encrypted-content"), nil).Once()
 				t.integrationRepos[syncintegration.Dropbox].On("Upload", context.Background(), syncintegration.SyncIntegration{
 					Type: syncintegration.Dropbox,
-				}).Return(nil).Once()
+				}, []byte("any-encrypted-content")).Return(nil).Once()
 			},
 		},
 	}
@@ -159,7 +207,22 @@ func (t *UseCaseTestSuite) TestDownload() {
 				}, nil).Once()
 				t.integrationRepos[syncintegration.Dropbox].On("Download", context.Background(), syncintegration.SyncIntegration{
 					Type: syncintegration.Dropbox,
-				}).Return(errors.New("any-error")).Once()
+				}).Return(nil, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:          "wrong encryption key",
+			expectedError: syncintegration.ErrInvalidEncryptionKey,
+			mockFunc: func() {
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(syncintegration.SyncIntegration{
+					Type: syncintegration.Dropbox,
+				}, nil).Once()
+				t.integrationRepos[syncintegration.Dropbox].On("Download", context.Background(), syncintegration.SyncIntegration{
+					Type: syncintegration.Dropbox,
+				}).Return([]byte("any-encrypted-content"), nil).Once()
+				t.encryptionRepo.On("Decrypt", context.Background(), syncintegration.SyncIntegration{
+					Type: syncintegration.Dropbox,
+				}, []byte("any-encrypted-content")).Return(nil, syncintegration.ErrInvalidEncryptionKey).Once()
 			},
 		},
 		{
@@ -171,7 +234,11 @@ func (t *UseCaseTestSuite) TestDownload() {
 				}, nil).Once()
 				t.integrationRepos[syncintegration.Dropbox].On("Download", context.Background(), syncintegration.SyncIntegration{
 					Type: syncintegration.Dropbox,
-				}).Return(nil).Once()
+				}).Return([]byte("any-encrypted-content"), nil).Once()
+				t.encryptionRepo.On("Decrypt", context.Background(), syncintegration.SyncIntegration{
+					Type: syncintegration.Dropbox,
+				}, []byte("any-encrypted-content")).Return([]byte("any-content"), nil).Once()
+				t.databaseRepo.On("WriteRemote", context.Background(), []byte("any-content")).Return(nil).Once()
 			},
 		},
 	}
@@ -188,3 +255,228 @@ func (t *UseCaseTestSuite) TestDownload() {
 		})
 	}
 }
+
+func (t *UseCaseTestSuite) TestSecureCredentials() {
+	plaintextProject := entity.Project{
+		ID: "project-1",
+		Integrations: []entity.Integration{
+			{
+				Type:    entity.IntegrationTypeJIRA,
+				Details: map[string]string{"token": "any-token"},
+			},
+		},
+	}
+	securedProject := entity.Project{
+		ID: "project-2",
+		Integrations: []entity.Integration{
+			{
+				Type:    entity.IntegrationTypeJIRA,
+				Details: map[string]string{"token": "secret:project/project-2/JIRA/token"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		expectedError error
+		mockFunc      func()
+	}{
+		{
+			name:          "failed to get sync integration",
+			expectedError: errors.New("any-error"),
+			mockFunc: func() {
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(syncintegration.SyncIntegration{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:          "failed to update project",
+			expectedError: fmt.Errorf("error while updating project: %w", errors.New("any-error")),
+			mockFunc: func() {
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(syncintegration.SyncIntegration{}, syncintegration.ErrSyncIntegrationNotFound).Once()
+				t.projectRepo.On("GetAll", context.Background()).Return(entity.Projects{plaintextProject}, nil).Once()
+				t.projectRepo.On("Update", context.Background(), plaintextProject).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:          "failed to set sync integration",
+			expectedError: fmt.Errorf("error while setting sync integration: %w", errors.New("any-error")),
+			mockFunc: func() {
+				integration := syncintegration.SyncIntegration{
+					Type:    syncintegration.Dropbox,
+					Details: map[string]string{"token": "any-token"},
+				}
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(integration, nil).Once()
+				t.projectRepo.On("GetAll", context.Background()).Return(entity.Projects{}, nil).Once()
+				t.settingRepo.On("SetSyncIntegration", context.Background(), integration).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:          "failed to set sync integration after updating projects",
+			expectedError: fmt.Errorf("error while setting sync integration: %w", errors.New("any-error")),
+			mockFunc: func() {
+				integration := syncintegration.SyncIntegration{
+					Type:    syncintegration.Dropbox,
+					Details: map[string]string{"token": "any-token"},
+				}
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(integration, nil).Once()
+				t.projectRepo.On("GetAll", context.Background()).Return(entity.Projects{plaintextProject}, nil).Once()
+				t.projectRepo.On("Update", context.Background(), plaintextProject).Return(nil).Once()
+				t.settingRepo.On("SetSyncIntegration", context.Background(), integration).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:          "success",
+			expectedError: nil,
+			mockFunc: func() {
+				integration := syncintegration.SyncIntegration{
+					Type:    syncintegration.Dropbox,
+					Details: map[string]string{"token": "any-token"},
+				}
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(integration, nil).Once()
+				t.projectRepo.On("GetAll", context.Background()).Return(entity.Projects{plaintextProject, securedProject}, nil).Once()
+				t.projectRepo.On("Update", context.Background(), plaintextProject).Return(nil).Once()
+				t.settingRepo.On("SetSyncIntegration", context.Background(), integration).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.mockFunc()
+			err := t.useCase.SecureCredentials(context.Background())
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			t.Equal(test.expectedError, err)
+			t.projectRepo.AssertExpectations(t.T())
+			t.settingRepo.AssertExpectations(t.T())
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestSetSyncEncryption() {
+	encryption := map[string]string{
+		syncintegration.EncryptionDetailKey:           syncintegration.EncryptionPassphrase,
+		syncintegration.EncryptionPassphraseDetailKey: "any-passphrase",
+	}
+
+	tests := []struct {
+		name          string
+		expectedError error
+		mockFunc      func()
+	}{
+		{
+			name:          "no integration found",
+			expectedError: syncintegration.ErrSyncIntegrationNotFound,
+			mockFunc: func() {
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(syncintegration.SyncIntegration{}, syncintegration.ErrSyncIntegrationNotFound).Once()
+			},
+		},
+		{
+			name:          "success",
+			expectedError: nil,
+			mockFunc: func() {
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(syncintegration.SyncIntegration{
+					Type: syncintegration.Dropbox,
+					Details: map[string]string{
+						"token":                             "any-token",
+						syncintegration.EncryptionDetailKey: syncintegration.EncryptionKeyFile,
+						syncintegration.EncryptionKeyFileDetailKey: "any-key-file",
+					},
+				}, nil).Once()
+				t.settingRepo.On("SetSyncIntegration", context.Background(), syncintegration.SyncIntegration{
+					Type: syncintegration.Dropbox,
+					Details: map[string]string{
+						"token":                             "any-token",
+						syncintegration.EncryptionDetailKey: syncintegration.EncryptionPassphrase,
+						syncintegration.EncryptionPassphraseDetailKey: "any-passphrase",
+					},
+				}).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.mockFunc()
+			err := t.useCase.SetSyncEncryption(context.Background(), encryption)
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			t.Equal(test.expectedError, err)
+			t.settingRepo.AssertExpectations(t.T())
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestRotateSyncEncryption() {
+	integration := syncintegration.SyncIntegration{
+		Type: syncintegration.Dropbox,
+		Details: map[string]string{
+			syncintegration.EncryptionDetailKey:           syncintegration.EncryptionPassphrase,
+			syncintegration.EncryptionPassphraseDetailKey: "old-passphrase",
+		},
+	}
+	rotatedIntegration := syncintegration.SyncIntegration{
+		Type: syncintegration.Dropbox,
+		Details: map[string]string{
+			syncintegration.EncryptionDetailKey:           syncintegration.EncryptionPassphrase,
+			syncintegration.EncryptionPassphraseDetailKey: "new-passphrase",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		expectedError error
+		mockFunc      func()
+	}{
+		{
+			name:          "wrong current key",
+			expectedError: syncintegration.ErrInvalidEncryptionKey,
+			mockFunc: func() {
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(integration, nil).Once()
+				t.integrationRepos[syncintegration.Dropbox].On("Download", context.Background(), integration).Return([]byte("old-content"), nil).Once()
+				t.encryptionRepo.On("Decrypt", context.Background(), integration, []byte("old-content")).Return(nil, syncintegration.ErrInvalidEncryptionKey).Once()
+			},
+		},
+		{
+			name:          "failed to upload",
+			expectedError: errors.New("any-error"),
+			mockFunc: func() {
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(integration, nil).Once()
+				t.integrationRepos[syncintegration.Dropbox].On("Download", context.Background(), integration).Return([]byte("old-content"), nil).Once()
+				t.encryptionRepo.On("Decrypt", context.Background(), integration, []byte("old-content")).Return([]byte("any-content"), nil).Once()
+				t.encryptionRepo.On("Encrypt", context.Background(), rotatedIntegration, []byte("any-content")).Return([]byte("new-content"), nil).Once()
+				t.integrationRepos[syncintegration.Dropbox].On("Upload", context.Background(), rotatedIntegration, []byte("new-content")).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:          "success",
+			expectedError: nil,
+			mockFunc: func() {
+				t.settingRepo.On("GetSyncIntegration", context.Background()).Return(integration, nil).Once()
+				t.integrationRepos[syncintegration.Dropbox].On("Download", context.Background(), integration).Return([]byte("old-content"), nil).Once()
+				t.encryptionRepo.On("Decrypt", context.Background(), integration, []byte("old-content")).Return([]byte("any-content"), nil).Once()
+				t.encryptionRepo.On("Encrypt", context.Background(), rotatedIntegration, []byte("any-content")).Return([]byte("new-content"), nil).Once()
+				t.integrationRepos[syncintegration.Dropbox].On("Upload", context.Background(), rotatedIntegration, []byte("new-content")).Return(nil).Once()
+				t.settingRepo.On("SetSyncIntegration", context.Background(), rotatedIntegration).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.mockFunc()
+			err := t.useCase.RotateSyncEncryption(context.Background(), rotatedIntegration.Details)
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			t.Equal(test.expectedError, err)
+			t.encryptionRepo.AssertExpectations(t.T())
+			t.integrationRepos[syncintegration.Dropbox].AssertExpectations(t.T())
+		})
+	}
+}
@@ -1,5 +1,39 @@
 package task
 
-import "errors"
+import (
+	"errors"
 
-var ErrTaskNotFound = errors.New("task not found")
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+var (
+	ErrTaskNotFound  = errors.New("task not found")
+	ErrAmbiguousTask = errors.New("more than one task matches")
+	ErrEmptyNote     = errors.New("note is empty")
+
+	ErrInvalidSession     = errors.New("session must stop after it starts and not in the future")
+	ErrOverlappingSession = errors.New("session overlaps another session")
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrRunningSession     = errors.New("session is running, stop the task first")
+
+	ErrTaskPaused    = errors.New("task is already paused")
+	ErrTaskNotPaused = errors.New("task is not paused")
+
+	ErrUnsupportedIdleSource = errors.New("unsupported idle source")
+	ErrInvalidSetting        = errors.New("invalid tracking setting")
+)
+
+// PublishError is returned when the task change was saved but notifying the
+// event subscribers failed.
+type PublishError struct {
+	EventType entity.EventType
+	Err       error
+}
+
+func (e *PublishError) Error() string {
+	return "error while publishing " + string(e.EventType) + " event: " + e.Err.Error()
+}
+
+func (e *PublishError) Unwrap() error {
+	return e.Err
+}
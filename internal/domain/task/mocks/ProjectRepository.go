@@ -22,6 +22,63 @@ func (_m *ProjectRepository) EXPECT() *ProjectRepository_Expecter {
 	return &ProjectRepository_Expecter{mock: &_m.Mock}
 }
 
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *ProjectRepository) GetByID(ctx context.Context, id string) (entity.Project, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 entity.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Project, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Project); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type ProjectRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ProjectRepository_Expecter) GetByID(ctx interface{}, id interface{}) *ProjectRepository_GetByID_Call {
+	return &ProjectRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *ProjectRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *ProjectRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ProjectRepository_GetByID_Call) Return(_a0 entity.Project, _a1 error) *ProjectRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (entity.Project, error)) *ProjectRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSelectedProject provides a mock function with given fields: ctx
 func (_m *ProjectRepository) GetSelectedProject(ctx context.Context) (entity.Project, error) {
 	ret := _m.Called(ctx)
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	entity "github.com/azisuazusa/todo-cli/internal/domain/entity"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,17 +22,73 @@ func (_m *SettingRepository) EXPECT() *SettingRepository_Expecter {
 	return &SettingRepository_Expecter{mock: &_m.Mock}
 }
 
-// Sync provides a mock function with given fields: ctx
-func (_m *SettingRepository) Sync(ctx context.Context) error {
+// GetTrackingSetting provides a mock function with given fields: ctx
+func (_m *SettingRepository) GetTrackingSetting(ctx context.Context) (entity.TrackingSetting, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Sync")
+		panic("no return value specified for GetTrackingSetting")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+	var r0 entity.TrackingSetting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.TrackingSetting, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.TrackingSetting); ok {
 		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.TrackingSetting)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SettingRepository_GetTrackingSetting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrackingSetting'
+type SettingRepository_GetTrackingSetting_Call struct {
+	*mock.Call
+}
+
+// GetTrackingSetting is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SettingRepository_Expecter) GetTrackingSetting(ctx interface{}) *SettingRepository_GetTrackingSetting_Call {
+	return &SettingRepository_GetTrackingSetting_Call{Call: _e.mock.On("GetTrackingSetting", ctx)}
+}
+
+func (_c *SettingRepository_GetTrackingSetting_Call) Run(run func(ctx context.Context)) *SettingRepository_GetTrackingSetting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SettingRepository_GetTrackingSetting_Call) Return(_a0 entity.TrackingSetting, _a1 error) *SettingRepository_GetTrackingSetting_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SettingRepository_GetTrackingSetting_Call) RunAndReturn(run func(context.Context) (entity.TrackingSetting, error)) *SettingRepository_GetTrackingSetting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTrackingSetting provides a mock function with given fields: ctx, setting
+func (_m *SettingRepository) SetTrackingSetting(ctx context.Context, setting entity.TrackingSetting) error {
+	ret := _m.Called(ctx, setting)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTrackingSetting")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.TrackingSetting) error); ok {
+		r0 = rf(ctx, setting)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -39,30 +96,31 @@ func (_m *SettingRepository) Sync(ctx context.Context) error {
 	return r0
 }
 
-// SettingRepository_Sync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sync'
-type SettingRepository_Sync_Call struct {
+// SettingRepository_SetTrackingSetting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTrackingSetting'
+type SettingRepository_SetTrackingSetting_Call struct {
 	*mock.Call
 }
 
-// Sync is a helper method to define mock.On call
+// SetTrackingSetting is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *SettingRepository_Expecter) Sync(ctx interface{}) *SettingRepository_Sync_Call {
-	return &SettingRepository_Sync_Call{Call: _e.mock.On("Sync", ctx)}
+//   - setting entity.TrackingSetting
+func (_e *SettingRepository_Expecter) SetTrackingSetting(ctx interface{}, setting interface{}) *SettingRepository_SetTrackingSetting_Call {
+	return &SettingRepository_SetTrackingSetting_Call{Call: _e.mock.On("SetTrackingSetting", ctx, setting)}
 }
 
-func (_c *SettingRepository_Sync_Call) Run(run func(ctx context.Context)) *SettingRepository_Sync_Call {
+func (_c *SettingRepository_SetTrackingSetting_Call) Run(run func(ctx context.Context, setting entity.TrackingSetting)) *SettingRepository_SetTrackingSetting_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(entity.TrackingSetting))
 	})
 	return _c
 }
 
-func (_c *SettingRepository_Sync_Call) Return(_a0 error) *SettingRepository_Sync_Call {
+func (_c *SettingRepository_SetTrackingSetting_Call) Return(_a0 error) *SettingRepository_SetTrackingSetting_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *SettingRepository_Sync_Call) RunAndReturn(run func(context.Context) error) *SettingRepository_Sync_Call {
+func (_c *SettingRepository_SetTrackingSetting_Call) RunAndReturn(run func(context.Context, entity.TrackingSetting) error) *SettingRepository_SetTrackingSetting_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -69,6 +69,64 @@ func (_c *TaskRepository_Delete_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// GetAll provides a mock function with given fields: ctx
+func (_m *TaskRepository) GetAll(ctx context.Context) (entity.Tasks, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 entity.Tasks
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.Tasks, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.Tasks); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entity.Tasks)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type TaskRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TaskRepository_Expecter) GetAll(ctx interface{}) *TaskRepository_GetAll_Call {
+	return &TaskRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *TaskRepository_GetAll_Call) Run(run func(ctx context.Context)) *TaskRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TaskRepository_GetAll_Call) Return(_a0 entity.Tasks, _a1 error) *TaskRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskRepository_GetAll_Call) RunAndReturn(run func(context.Context) (entity.Tasks, error)) *TaskRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: ctx, id
 func (_m *TaskRepository) GetByID(ctx context.Context, id string) (entity.Task, error) {
 	ret := _m.Called(ctx, id)
@@ -182,6 +240,65 @@ func (_c *TaskRepository_GetStartedTask_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetSubTasks provides a mock function with given fields: ctx, parentTaskID
+func (_m *TaskRepository) GetSubTasks(ctx context.Context, parentTaskID string) (entity.Tasks, error) {
+	ret := _m.Called(ctx, parentTaskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubTasks")
+	}
+
+	var r0 entity.Tasks
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Tasks, error)); ok {
+		return rf(ctx, parentTaskID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Tasks); ok {
+		r0 = rf(ctx, parentTaskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entity.Tasks)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, parentTaskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaskRepository_GetSubTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubTasks'
+type TaskRepository_GetSubTasks_Call struct {
+	*mock.Call
+}
+
+// GetSubTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentTaskID string
+func (_e *TaskRepository_Expecter) GetSubTasks(ctx interface{}, parentTaskID interface{}) *TaskRepository_GetSubTasks_Call {
+	return &TaskRepository_GetSubTasks_Call{Call: _e.mock.On("GetSubTasks", ctx, parentTaskID)}
+}
+
+func (_c *TaskRepository_GetSubTasks_Call) Run(run func(ctx context.Context, parentTaskID string)) *TaskRepository_GetSubTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TaskRepository_GetSubTasks_Call) Return(_a0 entity.Tasks, _a1 error) *TaskRepository_GetSubTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskRepository_GetSubTasks_Call) RunAndReturn(run func(context.Context, string) (entity.Tasks, error)) *TaskRepository_GetSubTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUncompleteParentTasks provides a mock function with given fields: ctx, projectID
 func (_m *TaskRepository) GetUncompleteParentTasks(ctx context.Context, projectID string) (entity.Tasks, error) {
 	ret := _m.Called(ctx, projectID)
@@ -348,21 +465,33 @@ func (_c *TaskRepository_Insert_Call) RunAndReturn(run func(context.Context, ent
 }
 
 // SetStartedTask provides a mock function with given fields: ctx, _a1
-func (_m *TaskRepository) SetStartedTask(ctx context.Context, _a1 entity.Task) error {
+func (_m *TaskRepository) SetStartedTask(ctx context.Context, _a1 entity.Task) (entity.Tasks, error) {
 	ret := _m.Called(ctx, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetStartedTask")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, entity.Task) error); ok {
+	var r0 entity.Tasks
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Task) (entity.Tasks, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Task) entity.Tasks); ok {
 		r0 = rf(ctx, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entity.Tasks)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Task) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // TaskRepository_SetStartedTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStartedTask'
@@ -384,12 +513,12 @@ func (_c *TaskRepository_SetStartedTask_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *TaskRepository_SetStartedTask_Call) Return(_a0 error) *TaskRepository_SetStartedTask_Call {
-	_c.Call.Return(_a0)
+func (_c *TaskRepository_SetStartedTask_Call) Return(_a0 entity.Tasks, _a1 error) *TaskRepository_SetStartedTask_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TaskRepository_SetStartedTask_Call) RunAndReturn(run func(context.Context, entity.Task) error) *TaskRepository_SetStartedTask_Call {
+func (_c *TaskRepository_SetStartedTask_Call) RunAndReturn(run func(context.Context, entity.Task) (entity.Tasks, error)) *TaskRepository_SetStartedTask_Call {
 	_c.Call.Return(run)
 	return _c
 }
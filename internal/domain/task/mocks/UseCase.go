@@ -69,74 +69,666 @@ func (_c *UseCase_Add_Call) RunAndReturn(run func(context.Context, entity.Task)
 	return _c
 }
 
+// AddNote provides a mock function with given fields: ctx, taskID, content
+func (_m *UseCase) AddNote(ctx context.Context, taskID string, content string) (entity.TaskNote, error) {
+	ret := _m.Called(ctx, taskID, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddNote")
+	}
+
+	var r0 entity.TaskNote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (entity.TaskNote, error)); ok {
+		return rf(ctx, taskID, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) entity.TaskNote); ok {
+		r0 = rf(ctx, taskID, content)
+	} else {
+		r0 = ret.Get(0).(entity.TaskNote)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, taskID, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UseCase_AddNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddNote'
+type UseCase_AddNote_Call struct {
+	*mock.Call
+}
+
+// AddNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - content string
+func (_e *UseCase_Expecter) AddNote(ctx interface{}, taskID interface{}, content interface{}) *UseCase_AddNote_Call {
+	return &UseCase_AddNote_Call{Call: _e.mock.On("AddNote", ctx, taskID, content)}
+}
+
+func (_c *UseCase_AddNote_Call) Run(run func(ctx context.Context, taskID string, content string)) *UseCase_AddNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UseCase_AddNote_Call) Return(_a0 entity.TaskNote, _a1 error) *UseCase_AddNote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UseCase_AddNote_Call) RunAndReturn(run func(context.Context, string, string) (entity.TaskNote, error)) *UseCase_AddNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddTime provides a mock function with given fields: ctx, taskID, history
+func (_m *UseCase) AddTime(ctx context.Context, taskID string, history entity.TaskHistory) error {
+	ret := _m.Called(ctx, taskID, history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTime")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.TaskHistory) error); ok {
+		r0 = rf(ctx, taskID, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_AddTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTime'
+type UseCase_AddTime_Call struct {
+	*mock.Call
+}
+
+// AddTime is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - history entity.TaskHistory
+func (_e *UseCase_Expecter) AddTime(ctx interface{}, taskID interface{}, history interface{}) *UseCase_AddTime_Call {
+	return &UseCase_AddTime_Call{Call: _e.mock.On("AddTime", ctx, taskID, history)}
+}
+
+func (_c *UseCase_AddTime_Call) Run(run func(ctx context.Context, taskID string, history entity.TaskHistory)) *UseCase_AddTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(entity.TaskHistory))
+	})
+	return _c
+}
+
+func (_c *UseCase_AddTime_Call) Return(_a0 error) *UseCase_AddTime_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_AddTime_Call) RunAndReturn(run func(context.Context, string, entity.TaskHistory) error) *UseCase_AddTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckSession provides a mock function with given fields: ctx
+func (_m *UseCase) CheckSession(ctx context.Context) (entity.ForgottenSession, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckSession")
+	}
+
+	var r0 entity.ForgottenSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.ForgottenSession, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.ForgottenSession); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(entity.ForgottenSession)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UseCase_CheckSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckSession'
+type UseCase_CheckSession_Call struct {
+	*mock.Call
+}
+
+// CheckSession is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UseCase_Expecter) CheckSession(ctx interface{}) *UseCase_CheckSession_Call {
+	return &UseCase_CheckSession_Call{Call: _e.mock.On("CheckSession", ctx)}
+}
+
+func (_c *UseCase_CheckSession_Call) Run(run func(ctx context.Context)) *UseCase_CheckSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UseCase_CheckSession_Call) Return(_a0 entity.ForgottenSession, _a1 error) *UseCase_CheckSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UseCase_CheckSession_Call) RunAndReturn(run func(context.Context) (entity.ForgottenSession, error)) *UseCase_CheckSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Complete provides a mock function with given fields: ctx, id
 func (_m *UseCase) Complete(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Complete")
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type UseCase_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *UseCase_Expecter) Complete(ctx interface{}, id interface{}) *UseCase_Complete_Call {
+	return &UseCase_Complete_Call{Call: _e.mock.On("Complete", ctx, id)}
+}
+
+func (_c *UseCase_Complete_Call) Run(run func(ctx context.Context, id string)) *UseCase_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UseCase_Complete_Call) Return(_a0 error) *UseCase_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_Complete_Call) RunAndReturn(run func(context.Context, string) error) *UseCase_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTime provides a mock function with given fields: ctx, taskID, index
+func (_m *UseCase) DeleteTime(ctx context.Context, taskID string, index int) error {
+	ret := _m.Called(ctx, taskID, index)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTime")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, taskID, index)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_DeleteTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTime'
+type UseCase_DeleteTime_Call struct {
+	*mock.Call
+}
+
+// DeleteTime is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - index int
+func (_e *UseCase_Expecter) DeleteTime(ctx interface{}, taskID interface{}, index interface{}) *UseCase_DeleteTime_Call {
+	return &UseCase_DeleteTime_Call{Call: _e.mock.On("DeleteTime", ctx, taskID, index)}
+}
+
+func (_c *UseCase_DeleteTime_Call) Run(run func(ctx context.Context, taskID string, index int)) *UseCase_DeleteTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *UseCase_DeleteTime_Call) Return(_a0 error) *UseCase_DeleteTime_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_DeleteTime_Call) RunAndReturn(run func(context.Context, string, int) error) *UseCase_DeleteTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Edit provides a mock function with given fields: ctx, _a1
+func (_m *UseCase) Edit(ctx context.Context, _a1 entity.Task) error {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Edit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Task) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_Edit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Edit'
+type UseCase_Edit_Call struct {
+	*mock.Call
+}
+
+// Edit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 entity.Task
+func (_e *UseCase_Expecter) Edit(ctx interface{}, _a1 interface{}) *UseCase_Edit_Call {
+	return &UseCase_Edit_Call{Call: _e.mock.On("Edit", ctx, _a1)}
+}
+
+func (_c *UseCase_Edit_Call) Run(run func(ctx context.Context, _a1 entity.Task)) *UseCase_Edit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.Task))
+	})
+	return _c
+}
+
+func (_c *UseCase_Edit_Call) Return(_a0 error) *UseCase_Edit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_Edit_Call) RunAndReturn(run func(context.Context, entity.Task) error) *UseCase_Edit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditTime provides a mock function with given fields: ctx, taskID, index, history
+func (_m *UseCase) EditTime(ctx context.Context, taskID string, index int, history entity.TaskHistory) error {
+	ret := _m.Called(ctx, taskID, index, history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditTime")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, entity.TaskHistory) error); ok {
+		r0 = rf(ctx, taskID, index, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_EditTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditTime'
+type UseCase_EditTime_Call struct {
+	*mock.Call
+}
+
+// EditTime is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+//   - index int
+//   - history entity.TaskHistory
+func (_e *UseCase_Expecter) EditTime(ctx interface{}, taskID interface{}, index interface{}, history interface{}) *UseCase_EditTime_Call {
+	return &UseCase_EditTime_Call{Call: _e.mock.On("EditTime", ctx, taskID, index, history)}
+}
+
+func (_c *UseCase_EditTime_Call) Run(run func(ctx context.Context, taskID string, index int, history entity.TaskHistory)) *UseCase_EditTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(entity.TaskHistory))
+	})
+	return _c
+}
+
+func (_c *UseCase_EditTime_Call) Return(_a0 error) *UseCase_EditTime_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_EditTime_Call) RunAndReturn(run func(context.Context, string, int, entity.TaskHistory) error) *UseCase_EditTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function with given fields: ctx, ref
+func (_m *UseCase) Find(ctx context.Context, ref string) (entity.Task, error) {
+	ret := _m.Called(ctx, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 entity.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Task, error)); ok {
+		return rf(ctx, ref)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Task); ok {
+		r0 = rf(ctx, ref)
+	} else {
+		r0 = ret.Get(0).(entity.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UseCase_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type UseCase_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ref string
+func (_e *UseCase_Expecter) Find(ctx interface{}, ref interface{}) *UseCase_Find_Call {
+	return &UseCase_Find_Call{Call: _e.mock.On("Find", ctx, ref)}
+}
+
+func (_c *UseCase_Find_Call) Run(run func(ctx context.Context, ref string)) *UseCase_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UseCase_Find_Call) Return(_a0 entity.Task, _a1 error) *UseCase_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UseCase_Find_Call) RunAndReturn(run func(context.Context, string) (entity.Task, error)) *UseCase_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *UseCase) GetByID(ctx context.Context, id string) (entity.Task, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 entity.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Task, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Task); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UseCase_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type UseCase_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *UseCase_Expecter) GetByID(ctx interface{}, id interface{}) *UseCase_GetByID_Call {
+	return &UseCase_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *UseCase_GetByID_Call) Run(run func(ctx context.Context, id string)) *UseCase_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UseCase_GetByID_Call) Return(_a0 entity.Task, _a1 error) *UseCase_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UseCase_GetByID_Call) RunAndReturn(run func(context.Context, string) (entity.Task, error)) *UseCase_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDetail provides a mock function with given fields: ctx, id
+func (_m *UseCase) GetDetail(ctx context.Context, id string) (entity.TaskDetail, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetail")
+	}
+
+	var r0 entity.TaskDetail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.TaskDetail, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.TaskDetail); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(entity.TaskDetail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UseCase_GetDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetail'
+type UseCase_GetDetail_Call struct {
+	*mock.Call
+}
+
+// GetDetail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *UseCase_Expecter) GetDetail(ctx interface{}, id interface{}) *UseCase_GetDetail_Call {
+	return &UseCase_GetDetail_Call{Call: _e.mock.On("GetDetail", ctx, id)}
+}
+
+func (_c *UseCase_GetDetail_Call) Run(run func(ctx context.Context, id string)) *UseCase_GetDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UseCase_GetDetail_Call) Return(_a0 entity.TaskDetail, _a1 error) *UseCase_GetDetail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UseCase_GetDetail_Call) RunAndReturn(run func(context.Context, string) (entity.TaskDetail, error)) *UseCase_GetDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotes provides a mock function with given fields: ctx, taskID
+func (_m *UseCase) GetNotes(ctx context.Context, taskID string) (entity.TaskNotes, error) {
+	ret := _m.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotes")
+	}
+
+	var r0 entity.TaskNotes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.TaskNotes, error)); ok {
+		return rf(ctx, taskID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.TaskNotes); ok {
+		r0 = rf(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entity.TaskNotes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UseCase_GetNotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotes'
+type UseCase_GetNotes_Call struct {
+	*mock.Call
+}
+
+// GetNotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID string
+func (_e *UseCase_Expecter) GetNotes(ctx interface{}, taskID interface{}) *UseCase_GetNotes_Call {
+	return &UseCase_GetNotes_Call{Call: _e.mock.On("GetNotes", ctx, taskID)}
+}
+
+func (_c *UseCase_GetNotes_Call) Run(run func(ctx context.Context, taskID string)) *UseCase_GetNotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UseCase_GetNotes_Call) Return(_a0 entity.TaskNotes, _a1 error) *UseCase_GetNotes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UseCase_GetNotes_Call) RunAndReturn(run func(context.Context, string) (entity.TaskNotes, error)) *UseCase_GetNotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStarted provides a mock function with given fields: ctx
+func (_m *UseCase) GetStarted(ctx context.Context) (entity.Task, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStarted")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	var r0 entity.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.Task, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.Task); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(entity.Task)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// UseCase_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
-type UseCase_Complete_Call struct {
+// UseCase_GetStarted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStarted'
+type UseCase_GetStarted_Call struct {
 	*mock.Call
 }
 
-// Complete is a helper method to define mock.On call
+// GetStarted is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *UseCase_Expecter) Complete(ctx interface{}, id interface{}) *UseCase_Complete_Call {
-	return &UseCase_Complete_Call{Call: _e.mock.On("Complete", ctx, id)}
+func (_e *UseCase_Expecter) GetStarted(ctx interface{}) *UseCase_GetStarted_Call {
+	return &UseCase_GetStarted_Call{Call: _e.mock.On("GetStarted", ctx)}
 }
 
-func (_c *UseCase_Complete_Call) Run(run func(ctx context.Context, id string)) *UseCase_Complete_Call {
+func (_c *UseCase_GetStarted_Call) Run(run func(ctx context.Context)) *UseCase_GetStarted_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *UseCase_Complete_Call) Return(_a0 error) *UseCase_Complete_Call {
-	_c.Call.Return(_a0)
+func (_c *UseCase_GetStarted_Call) Return(_a0 entity.Task, _a1 error) *UseCase_GetStarted_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *UseCase_Complete_Call) RunAndReturn(run func(context.Context, string) error) *UseCase_Complete_Call {
+func (_c *UseCase_GetStarted_Call) RunAndReturn(run func(context.Context) (entity.Task, error)) *UseCase_GetStarted_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: ctx, id
-func (_m *UseCase) GetByID(ctx context.Context, id string) (entity.Task, error) {
-	ret := _m.Called(ctx, id)
+// GetTrackingSetting provides a mock function with given fields: ctx
+func (_m *UseCase) GetTrackingSetting(ctx context.Context) (entity.TrackingSetting, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for GetTrackingSetting")
 	}
 
-	var r0 entity.Task
+	var r0 entity.TrackingSetting
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (entity.Task, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.TrackingSetting, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) entity.Task); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context) entity.TrackingSetting); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(entity.Task)
+		r0 = ret.Get(0).(entity.TrackingSetting)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -144,31 +736,30 @@ func (_m *UseCase) GetByID(ctx context.Context, id string) (entity.Task, error)
 	return r0, r1
 }
 
-// UseCase_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type UseCase_GetByID_Call struct {
+// UseCase_GetTrackingSetting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrackingSetting'
+type UseCase_GetTrackingSetting_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// GetTrackingSetting is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *UseCase_Expecter) GetByID(ctx interface{}, id interface{}) *UseCase_GetByID_Call {
-	return &UseCase_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+func (_e *UseCase_Expecter) GetTrackingSetting(ctx interface{}) *UseCase_GetTrackingSetting_Call {
+	return &UseCase_GetTrackingSetting_Call{Call: _e.mock.On("GetTrackingSetting", ctx)}
 }
 
-func (_c *UseCase_GetByID_Call) Run(run func(ctx context.Context, id string)) *UseCase_GetByID_Call {
+func (_c *UseCase_GetTrackingSetting_Call) Run(run func(ctx context.Context)) *UseCase_GetTrackingSetting_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *UseCase_GetByID_Call) Return(_a0 entity.Task, _a1 error) *UseCase_GetByID_Call {
+func (_c *UseCase_GetTrackingSetting_Call) Return(_a0 entity.TrackingSetting, _a1 error) *UseCase_GetTrackingSetting_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *UseCase_GetByID_Call) RunAndReturn(run func(context.Context, string) (entity.Task, error)) *UseCase_GetByID_Call {
+func (_c *UseCase_GetTrackingSetting_Call) RunAndReturn(run func(context.Context) (entity.TrackingSetting, error)) *UseCase_GetTrackingSetting_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -289,6 +880,53 @@ func (_c *UseCase_GetUncompleteTasks_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// Pause provides a mock function with given fields: ctx, reason
+func (_m *UseCase) Pause(ctx context.Context, reason string) error {
+	ret := _m.Called(ctx, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pause")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_Pause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pause'
+type UseCase_Pause_Call struct {
+	*mock.Call
+}
+
+// Pause is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reason string
+func (_e *UseCase_Expecter) Pause(ctx interface{}, reason interface{}) *UseCase_Pause_Call {
+	return &UseCase_Pause_Call{Call: _e.mock.On("Pause", ctx, reason)}
+}
+
+func (_c *UseCase_Pause_Call) Run(run func(ctx context.Context, reason string)) *UseCase_Pause_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UseCase_Pause_Call) Return(_a0 error) *UseCase_Pause_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_Pause_Call) RunAndReturn(run func(context.Context, string) error) *UseCase_Pause_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Remove provides a mock function with given fields: ctx, id
 func (_m *UseCase) Remove(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -336,6 +974,157 @@ func (_c *UseCase_Remove_Call) RunAndReturn(run func(context.Context, string) er
 	return _c
 }
 
+// Repair provides a mock function with given fields: ctx
+func (_m *UseCase) Repair(ctx context.Context) (entity.Tasks, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Repair")
+	}
+
+	var r0 entity.Tasks
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entity.Tasks, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entity.Tasks); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entity.Tasks)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UseCase_Repair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Repair'
+type UseCase_Repair_Call struct {
+	*mock.Call
+}
+
+// Repair is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UseCase_Expecter) Repair(ctx interface{}) *UseCase_Repair_Call {
+	return &UseCase_Repair_Call{Call: _e.mock.On("Repair", ctx)}
+}
+
+func (_c *UseCase_Repair_Call) Run(run func(ctx context.Context)) *UseCase_Repair_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UseCase_Repair_Call) Return(_a0 entity.Tasks, _a1 error) *UseCase_Repair_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UseCase_Repair_Call) RunAndReturn(run func(context.Context) (entity.Tasks, error)) *UseCase_Repair_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resume provides a mock function with given fields: ctx
+func (_m *UseCase) Resume(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type UseCase_Resume_Call struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UseCase_Expecter) Resume(ctx interface{}) *UseCase_Resume_Call {
+	return &UseCase_Resume_Call{Call: _e.mock.On("Resume", ctx)}
+}
+
+func (_c *UseCase_Resume_Call) Run(run func(ctx context.Context)) *UseCase_Resume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UseCase_Resume_Call) Return(_a0 error) *UseCase_Resume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_Resume_Call) RunAndReturn(run func(context.Context) error) *UseCase_Resume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTrackingSetting provides a mock function with given fields: ctx, setting
+func (_m *UseCase) SetTrackingSetting(ctx context.Context, setting entity.TrackingSetting) error {
+	ret := _m.Called(ctx, setting)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTrackingSetting")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.TrackingSetting) error); ok {
+		r0 = rf(ctx, setting)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_SetTrackingSetting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTrackingSetting'
+type UseCase_SetTrackingSetting_Call struct {
+	*mock.Call
+}
+
+// SetTrackingSetting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - setting entity.TrackingSetting
+func (_e *UseCase_Expecter) SetTrackingSetting(ctx interface{}, setting interface{}) *UseCase_SetTrackingSetting_Call {
+	return &UseCase_SetTrackingSetting_Call{Call: _e.mock.On("SetTrackingSetting", ctx, setting)}
+}
+
+func (_c *UseCase_SetTrackingSetting_Call) Run(run func(ctx context.Context, setting entity.TrackingSetting)) *UseCase_SetTrackingSetting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.TrackingSetting))
+	})
+	return _c
+}
+
+func (_c *UseCase_SetTrackingSetting_Call) Return(_a0 error) *UseCase_SetTrackingSetting_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_SetTrackingSetting_Call) RunAndReturn(run func(context.Context, entity.TrackingSetting) error) *UseCase_SetTrackingSetting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx, id
 func (_m *UseCase) Start(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -429,6 +1218,53 @@ func (_c *UseCase_Stop_Call) RunAndReturn(run func(context.Context) error) *UseC
 	return _c
 }
 
+// TrimSession provides a mock function with given fields: ctx, session
+func (_m *UseCase) TrimSession(ctx context.Context, session entity.ForgottenSession) error {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrimSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.ForgottenSession) error); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseCase_TrimSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrimSession'
+type UseCase_TrimSession_Call struct {
+	*mock.Call
+}
+
+// TrimSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session entity.ForgottenSession
+func (_e *UseCase_Expecter) TrimSession(ctx interface{}, session interface{}) *UseCase_TrimSession_Call {
+	return &UseCase_TrimSession_Call{Call: _e.mock.On("TrimSession", ctx, session)}
+}
+
+func (_c *UseCase_TrimSession_Call) Run(run func(ctx context.Context, session entity.ForgottenSession)) *UseCase_TrimSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.ForgottenSession))
+	})
+	return _c
+}
+
+func (_c *UseCase_TrimSession_Call) Return(_a0 error) *UseCase_TrimSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UseCase_TrimSession_Call) RunAndReturn(run func(context.Context, entity.ForgottenSession) error) *UseCase_TrimSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUseCase(t interface {
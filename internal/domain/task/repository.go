@@ -2,6 +2,7 @@ package task
 
 import (
 	"context"
+	"time"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 )
@@ -14,13 +15,38 @@ type TaskRepository interface {
 	Delete(ctx context.Context, id string) error
 	GetByID(ctx context.Context, id string) (entity.Task, error)
 	GetStartedTask(ctx context.Context) (entity.Task, error)
-	SetStartedTask(ctx context.Context, task entity.Task) error
+	SetStartedTask(ctx context.Context, task entity.Task) (entity.Tasks, error)
+	GetSubTasks(ctx context.Context, parentTaskID string) (entity.Tasks, error)
+	GetAll(ctx context.Context) (entity.Tasks, error)
 }
 
 type SettingRepository interface {
-	Sync(ctx context.Context) error
+	GetTrackingSetting(ctx context.Context) (entity.TrackingSetting, error)
+	SetTrackingSetting(ctx context.Context, setting entity.TrackingSetting) error
+}
+
+// IdleRepository reads when the user was last active, a zero time means it
+// is not known.
+type IdleRepository interface {
+	LastActivity(ctx context.Context, setting entity.TrackingSetting) (time.Time, error)
 }
 
 type ProjectRepository interface {
 	GetSelectedProject(ctx context.Context) (entity.Project, error)
+	GetByID(ctx context.Context, id string) (entity.Project, error)
+}
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event entity.Event) error
+}
+
+type NoteRepository interface {
+	Insert(ctx context.Context, note entity.TaskNote) error
+	GetByTaskID(ctx context.Context, taskID string) (entity.TaskNotes, error)
+}
+
+// TransactionRepository runs fn atomically, the repositories given the ctx
+// passed to fn take part in the transaction.
+type TransactionRepository interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
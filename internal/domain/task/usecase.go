@@ -2,9 +2,14 @@ package task
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/google/uuid"
 )
 
 type UseCase interface {
@@ -12,21 +17,51 @@ type UseCase interface {
 	GetUncompleteParentTasks(ctx context.Context) (entity.Tasks, error)
 	Add(ctx context.Context, task entity.Task) error
 	Start(ctx context.Context, id string) error
+	Repair(ctx context.Context) (entity.Tasks, error)
 	Stop(ctx context.Context) error
+	Pause(ctx context.Context, reason string) error
+	Resume(ctx context.Context) error
 	Remove(ctx context.Context, id string) error
 	Complete(ctx context.Context, id string) error
+	Edit(ctx context.Context, task entity.Task) error
 	GetByID(ctx context.Context, id string) (entity.Task, error)
+	GetStarted(ctx context.Context) (entity.Task, error)
+	Find(ctx context.Context, ref string) (entity.Task, error)
+	AddNote(ctx context.Context, taskID, content string) (entity.TaskNote, error)
+	GetNotes(ctx context.Context, taskID string) (entity.TaskNotes, error)
+	GetDetail(ctx context.Context, id string) (entity.TaskDetail, error)
+	AddTime(ctx context.Context, taskID string, history entity.TaskHistory) error
+	EditTime(ctx context.Context, taskID string, index int, history entity.TaskHistory) error
+	DeleteTime(ctx context.Context, taskID string, index int) error
+	GetTrackingSetting(ctx context.Context) (entity.TrackingSetting, error)
+	SetTrackingSetting(ctx context.Context, setting entity.TrackingSetting) error
+	CheckSession(ctx context.Context) (entity.ForgottenSession, error)
+	TrimSession(ctx context.Context, session entity.ForgottenSession) error
 }
 
+// LongSession is the length above which a session was most likely left
+// running by mistake.
+const LongSession = 8 * time.Hour
+
 type useCase struct {
-	taskRepo    TaskRepository
-	projectRepo ProjectRepository
+	taskRepo        TaskRepository
+	projectRepo     ProjectRepository
+	eventPublisher  EventPublisher
+	noteRepo        NoteRepository
+	settingRepo     SettingRepository
+	idleRepos       map[entity.IdleSourceType]IdleRepository
+	transactionRepo TransactionRepository
 }
 
-func New(taskRepo TaskRepository, projectRepo ProjectRepository) UseCase {
+func New(taskRepo TaskRepository, projectRepo ProjectRepository, eventPublisher EventPublisher, noteRepo NoteRepository, settingRepo SettingRepository, idleRepos map[entity.IdleSourceType]IdleRepository, transactionRepo TransactionRepository) UseCase {
 	return &useCase{
-		taskRepo:    taskRepo,
-		projectRepo: projectRepo,
+		taskRepo:        taskRepo,
+		projectRepo:     projectRepo,
+		eventPublisher:  eventPublisher,
+		noteRepo:        noteRepo,
+		settingRepo:     settingRepo,
+		idleRepos:       idleRepos,
+		transactionRepo: transactionRepo,
 	}
 }
 
@@ -76,13 +111,17 @@ func (u *useCase) Add(ctx context.Context, task entity.Task) error {
 		return fmt.Errorf("error while getting selected project: %w", err)
 	}
 
+	if task.ID == "" {
+		task.ID = uuid.NewString()
+	}
+
 	task.ProjectID = project.ID
 	err = u.taskRepo.Insert(ctx, task)
 	if err != nil {
 		return fmt.Errorf("error while inserting task: %w", err)
 	}
 
-	return nil
+	return u.publish(ctx, entity.EventTypeTaskAdded, task)
 }
 
 func (u *useCase) Start(ctx context.Context, id string) error {
@@ -91,13 +130,67 @@ func (u *useCase) Start(ctx context.Context, id string) error {
 		return fmt.Errorf("error while getting task: %w", err)
 	}
 
+	// Starting the started task begins a new session, the running one is
+	// stopped first so it is not left open
+	var stoppedTasks entity.Tasks
+	if task.IsStarted {
+		task.Stop()
+		stoppedTasks = append(stoppedTasks, task)
+	}
+
 	task.Start()
-	err = u.taskRepo.SetStartedTask(ctx, task)
+	otherTasks, err := u.taskRepo.SetStartedTask(ctx, task)
 	if err != nil {
 		return fmt.Errorf("error while setting started task: %w", err)
 	}
 
-	return nil
+	return u.publishSwitch(ctx, append(stoppedTasks, otherTasks...), task)
+}
+
+// Repair stops every started task but the one started last, for databases
+// where several tasks were started before it was enforced. The stopped
+// tasks are returned.
+func (u *useCase) Repair(ctx context.Context) (entity.Tasks, error) {
+	var stoppedTasks entity.Tasks
+	err := u.transactionRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+		tasks, err := u.taskRepo.GetAll(ctx)
+		if err != nil {
+			return fmt.Errorf("error while getting tasks: %w", err)
+		}
+
+		var lastStarted entity.Task
+		for _, task := range tasks {
+			if !task.IsStarted {
+				continue
+			}
+
+			if !lastStarted.IsStarted || task.SessionStartedAt().After(lastStarted.SessionStartedAt()) {
+				lastStarted = task
+			}
+		}
+
+		if !lastStarted.IsStarted {
+			return nil
+		}
+
+		stoppedTasks, err = u.taskRepo.SetStartedTask(ctx, lastStarted)
+		if err != nil {
+			return fmt.Errorf("error while setting started task: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stoppedTask := range stoppedTasks {
+		if publishErr := u.publish(ctx, entity.EventTypeTaskStopped, stoppedTask); publishErr != nil && err == nil {
+			err = publishErr
+		}
+	}
+
+	return stoppedTasks, err
 }
 
 func (u *useCase) Stop(ctx context.Context) (err error) {
@@ -112,16 +205,61 @@ func (u *useCase) Stop(ctx context.Context) (err error) {
 		return fmt.Errorf("error while updating task: %w", err)
 	}
 
-	return nil
+	return u.publish(ctx, entity.EventTypeTaskStopped, task)
+}
+
+// Pause interrupts the started task without ending its session, the time
+// until Resume is not counted as spent.
+func (u *useCase) Pause(ctx context.Context, reason string) error {
+	task, err := u.taskRepo.GetStartedTask(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting started task: %w", err)
+	}
+
+	if task.IsPaused() {
+		return ErrTaskPaused
+	}
+
+	task.Pause(strings.TrimSpace(reason))
+	err = u.taskRepo.Update(ctx, task)
+	if err != nil {
+		return fmt.Errorf("error while updating task: %w", err)
+	}
+
+	return u.publish(ctx, entity.EventTypeTaskPaused, task)
+}
+
+func (u *useCase) Resume(ctx context.Context) error {
+	task, err := u.taskRepo.GetStartedTask(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting started task: %w", err)
+	}
+
+	if !task.IsPaused() {
+		return ErrTaskNotPaused
+	}
+
+	task.Resume()
+	err = u.taskRepo.Update(ctx, task)
+	if err != nil {
+		return fmt.Errorf("error while updating task: %w", err)
+	}
+
+	return u.publish(ctx, entity.EventTypeTaskResumed, task)
 }
 
 func (u *useCase) Remove(ctx context.Context, id string) error {
-	err := u.taskRepo.Delete(ctx, id)
+	task, err := u.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error while getting task: %w", err)
+	}
+
+	err = u.taskRepo.Delete(ctx, id)
 	if err != nil {
 		return fmt.Errorf("error while deleting task: %w", err)
 	}
 
-	return nil
+	return u.publish(ctx, entity.EventTypeTaskRemoved, task)
 }
 
 func (u *useCase) Complete(ctx context.Context, id string) error {
@@ -136,6 +274,25 @@ func (u *useCase) Complete(ctx context.Context, id string) error {
 		return fmt.Errorf("error while updating task: %w", err)
 	}
 
+	return u.publish(ctx, entity.EventTypeTaskCompleted, task)
+}
+
+// Edit changes the name and description of a task and keeps the rest of it,
+// including its time histories, as stored.
+func (u *useCase) Edit(ctx context.Context, task entity.Task) error {
+	storedTask, err := u.taskRepo.GetByID(ctx, task.ID)
+	if err != nil {
+		return fmt.Errorf("error while getting task: %w", err)
+	}
+
+	storedTask.Name = task.Name
+	storedTask.Description = task.Description
+	storedTask.DueAt = task.DueAt
+	err = u.taskRepo.Update(ctx, storedTask)
+	if err != nil {
+		return fmt.Errorf("error while updating task: %w", err)
+	}
+
 	return nil
 }
 
@@ -147,3 +304,390 @@ func (u *useCase) GetByID(ctx context.Context, id string) (entity.Task, error) {
 
 	return task, nil
 }
+
+func (u *useCase) GetStarted(ctx context.Context) (entity.Task, error) {
+	task, err := u.taskRepo.GetStartedTask(ctx)
+	if err != nil {
+		return entity.Task{}, fmt.Errorf("error while getting started task: %w", err)
+	}
+
+	return task, nil
+}
+
+// Find looks a task up by its ID, its integration key such as a JIRA key, or
+// a unique prefix of its ID. Keys and prefixes are matched against the
+// uncompleted tasks of the selected project.
+func (u *useCase) Find(ctx context.Context, ref string) (entity.Task, error) {
+	tasks, err := u.GetUncompleteTasks(ctx)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	for _, task := range tasks {
+		if task.ID == ref || strings.EqualFold(task.Integration.ID, ref) {
+			return task, nil
+		}
+	}
+
+	task, err := u.taskRepo.GetByID(ctx, ref)
+	if err == nil {
+		return task, nil
+	}
+
+	if !errors.Is(err, ErrTaskNotFound) {
+		return entity.Task{}, fmt.Errorf("error while getting task: %w", err)
+	}
+
+	var matches entity.Tasks
+	for _, task := range tasks {
+		if strings.HasPrefix(task.ID, ref) {
+			matches = append(matches, task)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return entity.Task{}, fmt.Errorf("%w: %s", ErrTaskNotFound, ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return entity.Task{}, fmt.Errorf("%w: %s", ErrAmbiguousTask, ref)
+	}
+}
+
+func (u *useCase) AddNote(ctx context.Context, taskID, content string) (entity.TaskNote, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return entity.TaskNote{}, ErrEmptyNote
+	}
+
+	note := entity.TaskNote{
+		ID:        uuid.NewString(),
+		TaskID:    taskID,
+		Content:   content,
+		CreatedAt: time.Now(),
+	}
+
+	if err := u.noteRepo.Insert(ctx, note); err != nil {
+		return entity.TaskNote{}, fmt.Errorf("error while inserting note: %w", err)
+	}
+
+	return note, nil
+}
+
+func (u *useCase) GetNotes(ctx context.Context, taskID string) (entity.TaskNotes, error) {
+	notes, err := u.noteRepo.GetByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting notes: %w", err)
+	}
+
+	return notes, nil
+}
+
+func (u *useCase) GetDetail(ctx context.Context, id string) (entity.TaskDetail, error) {
+	task, err := u.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return entity.TaskDetail{}, fmt.Errorf("error while getting task: %w", err)
+	}
+
+	detail := entity.TaskDetail{Task: task}
+	detail.Project, err = u.projectRepo.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return entity.TaskDetail{}, fmt.Errorf("error while getting project: %w", err)
+	}
+
+	issueKey := task.Integration.ID
+	integrationType := task.Integration.Type
+	if task.ParentTaskID != "" {
+		parentTask, err := u.taskRepo.GetByID(ctx, task.ParentTaskID)
+		if err != nil {
+			return entity.TaskDetail{}, fmt.Errorf("error while getting parent task: %w", err)
+		}
+
+		detail.Parent = &parentTask
+		if issueKey == "" {
+			issueKey = parentTask.Integration.ID
+			integrationType = parentTask.Integration.Type
+		}
+	}
+
+	detail.SubTasks, err = u.taskRepo.GetSubTasks(ctx, task.ID)
+	if err != nil {
+		return entity.TaskDetail{}, fmt.Errorf("error while getting sub tasks: %w", err)
+	}
+
+	detail.Notes, err = u.noteRepo.GetByTaskID(ctx, task.ID)
+	if err != nil {
+		return entity.TaskDetail{}, fmt.Errorf("error while getting notes: %w", err)
+	}
+
+	if integration, ok := detail.Project.Integration(integrationType); ok {
+		detail.IssueURL = integration.IssueURL(issueKey)
+	}
+
+	return detail, nil
+}
+
+// AddTime records a session that was not tracked with start and stop. The
+// overlap check and the update run in one transaction, like in EditTime.
+func (u *useCase) AddTime(ctx context.Context, taskID string, history entity.TaskHistory) error {
+	return u.transactionRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+		task, err := u.taskRepo.GetByID(ctx, taskID)
+		if err != nil {
+			return fmt.Errorf("error while getting task: %w", err)
+		}
+
+		if history.StoppedAt.IsZero() {
+			return ErrInvalidSession
+		}
+
+		if err = u.validateSession(ctx, task.ID, -1, history); err != nil {
+			return err
+		}
+
+		task.Histories = append(task.Histories, history)
+		sortHistories(task.Histories)
+		if err = u.taskRepo.Update(ctx, task); err != nil {
+			return fmt.Errorf("error while updating task: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// EditTime replaces the session at index. The stop time of a running session
+// can not be set, it stays running.
+func (u *useCase) EditTime(ctx context.Context, taskID string, index int, history entity.TaskHistory) error {
+	return u.transactionRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+		task, err := u.taskRepo.GetByID(ctx, taskID)
+		if err != nil {
+			return fmt.Errorf("error while getting task: %w", err)
+		}
+
+		if index < 0 || index >= len(task.Histories) {
+			return ErrSessionNotFound
+		}
+
+		isRunning := task.Histories[index].StoppedAt.IsZero()
+		if isRunning && !history.StoppedAt.IsZero() {
+			return ErrRunningSession
+		}
+
+		if !isRunning && history.StoppedAt.IsZero() {
+			return ErrInvalidSession
+		}
+
+		if err = u.validateSession(ctx, task.ID, index, history); err != nil {
+			return err
+		}
+
+		// Pauses are left within the session when it is shortened
+		task.Histories[index] = history.ClipPauses()
+		sortHistories(task.Histories)
+		if err = u.taskRepo.Update(ctx, task); err != nil {
+			return fmt.Errorf("error while updating task: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (u *useCase) DeleteTime(ctx context.Context, taskID string, index int) error {
+	return u.transactionRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+		task, err := u.taskRepo.GetByID(ctx, taskID)
+		if err != nil {
+			return fmt.Errorf("error while getting task: %w", err)
+		}
+
+		if index < 0 || index >= len(task.Histories) {
+			return ErrSessionNotFound
+		}
+
+		if task.Histories[index].StoppedAt.IsZero() {
+			return ErrRunningSession
+		}
+
+		task.Histories = append(task.Histories[:index], task.Histories[index+1:]...)
+		if err = u.taskRepo.Update(ctx, task); err != nil {
+			return fmt.Errorf("error while updating task: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// validateSession checks the session against every session of every task,
+// skipping the one at index of the task being edited.
+func (u *useCase) validateSession(ctx context.Context, taskID string, index int, history entity.TaskHistory) error {
+	now := time.Now()
+	if history.StartedAt.After(now) || history.StoppedAt.After(now) {
+		return ErrInvalidSession
+	}
+
+	if !history.StoppedAt.IsZero() && !history.StoppedAt.After(history.StartedAt) {
+		return ErrInvalidSession
+	}
+
+	tasks, err := u.taskRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting tasks: %w", err)
+	}
+
+	for _, task := range tasks {
+		for i, other := range task.Histories {
+			if task.ID == taskID && i == index {
+				continue
+			}
+
+			if history.Overlaps(other, now) {
+				return fmt.Errorf("%w: %s from %s", ErrOverlappingSession, task.Name, other.StartedAt.Local().Format(time.DateTime))
+			}
+		}
+	}
+
+	return nil
+}
+
+// sortHistories keeps the sessions in order, so a running session stays last
+// where Stop expects it.
+func sortHistories(histories []entity.TaskHistory) {
+	sort.SliceStable(histories, func(i, j int) bool {
+		return histories[i].StartedAt.Before(histories[j].StartedAt)
+	})
+}
+
+func (u *useCase) GetTrackingSetting(ctx context.Context) (entity.TrackingSetting, error) {
+	setting, err := u.settingRepo.GetTrackingSetting(ctx)
+	if err != nil {
+		return entity.TrackingSetting{}, fmt.Errorf("error while getting tracking setting: %w", err)
+	}
+
+	return setting, nil
+}
+
+func (u *useCase) SetTrackingSetting(ctx context.Context, setting entity.TrackingSetting) error {
+	if setting.MaxSession < 0 || setting.IdleAfter < 0 {
+		return ErrInvalidSetting
+	}
+
+	if _, ok := u.idleRepos[setting.IdleSource]; setting.IdleSource != entity.IdleSourceNone && !ok {
+		return ErrUnsupportedIdleSource
+	}
+
+	if setting.IdleSource == entity.IdleSourceFile && setting.IdleFile == "" {
+		return ErrInvalidSetting
+	}
+
+	if err := u.settingRepo.SetTrackingSetting(ctx, setting); err != nil {
+		return fmt.Errorf("error while setting tracking setting: %w", err)
+	}
+
+	return nil
+}
+
+// CheckSession looks for a started task whose session is longer than the
+// maximum or continued while the user was idle. It is trimmed right away when
+// the setting says so, otherwise it is up to the caller to ask.
+func (u *useCase) CheckSession(ctx context.Context) (entity.ForgottenSession, error) {
+	task, err := u.taskRepo.GetStartedTask(ctx)
+	if errors.Is(err, ErrTaskNotFound) {
+		return entity.ForgottenSession{}, nil
+	}
+
+	if err != nil {
+		return entity.ForgottenSession{}, fmt.Errorf("error while getting started task: %w", err)
+	}
+
+	if len(task.Histories) == 0 {
+		return entity.ForgottenSession{}, nil
+	}
+
+	setting, err := u.settingRepo.GetTrackingSetting(ctx)
+	if err != nil {
+		return entity.ForgottenSession{}, fmt.Errorf("error while getting tracking setting: %w", err)
+	}
+
+	now := time.Now()
+	startedAt := task.Histories[len(task.Histories)-1].StartedAt
+	var session entity.ForgottenSession
+	if setting.MaxSession > 0 && now.Sub(startedAt) > setting.MaxSession {
+		session = entity.ForgottenSession{Task: task, StopAt: startedAt.Add(setting.MaxSession), Reason: entity.ForgottenReasonMaxSession}
+	}
+
+	if setting.IdleSource != entity.IdleSourceNone {
+		idleRepo, ok := u.idleRepos[setting.IdleSource]
+		if !ok {
+			return session, ErrUnsupportedIdleSource
+		}
+
+		lastActivity, err := idleRepo.LastActivity(ctx, setting)
+		if err != nil {
+			return session, fmt.Errorf("error while getting last activity: %w", err)
+		}
+
+		isIdle := !lastActivity.IsZero() && lastActivity.After(startedAt) && now.Sub(lastActivity) >= setting.IdleAfter
+		if isIdle && (session.IsZero() || lastActivity.Before(session.StopAt)) {
+			session = entity.ForgottenSession{Task: task, StopAt: lastActivity, Reason: entity.ForgottenReasonIdle}
+		}
+	}
+
+	if session.IsZero() || !setting.AutoTrim {
+		return session, nil
+	}
+
+	// A failing hook does not undo the trim
+	err = u.TrimSession(ctx, session)
+	var publishErr *PublishError
+	if err != nil && !errors.As(err, &publishErr) {
+		return session, err
+	}
+
+	session.Trimmed = true
+	return session, err
+}
+
+// TrimSession stops the task of a forgotten session at the time it should
+// have been stopped.
+func (u *useCase) TrimSession(ctx context.Context, session entity.ForgottenSession) error {
+	task, err := u.taskRepo.GetByID(ctx, session.Task.ID)
+	if err != nil {
+		return fmt.Errorf("error while getting task: %w", err)
+	}
+
+	if !task.IsStarted {
+		return ErrTaskNotFound
+	}
+
+	task.StopAt(session.StopAt)
+	if err = u.taskRepo.Update(ctx, task); err != nil {
+		return fmt.Errorf("error while updating task: %w", err)
+	}
+
+	return u.publish(ctx, entity.EventTypeTaskStopped, task)
+}
+
+// publish notifies subscribers once the change is saved, so a failing
+// subscriber never rolls back the task itself.
+func (u *useCase) publish(ctx context.Context, eventType entity.EventType, task entity.Task) error {
+	if err := u.eventPublisher.Publish(ctx, entity.NewTaskEvent(eventType, task)); err != nil {
+		return &PublishError{EventType: eventType, Err: err}
+	}
+
+	return nil
+}
+
+// publishSwitch publishes the stop of every stopped task before the start of
+// startedTask. All events are published, the first error is returned.
+func (u *useCase) publishSwitch(ctx context.Context, stoppedTasks entity.Tasks, startedTask entity.Task) (err error) {
+	for _, stoppedTask := range stoppedTasks {
+		if publishErr := u.publish(ctx, entity.EventTypeTaskStopped, stoppedTask); publishErr != nil && err == nil {
+			err = publishErr
+		}
+	}
+
+	if publishErr := u.publish(ctx, entity.EventTypeTaskStarted, startedTask); publishErr != nil && err == nil {
+		err = publishErr
+	}
+
+	return err
+}
@@ -14,15 +14,36 @@ import (
 
 type UseCaseTestSuite struct {
 	suite.Suite
-	taskRepo    *mocks.TaskRepository
-	projectRepo *mocks.ProjectRepository
-	useCase     UseCase
+	taskRepo        *mocks.TaskRepository
+	projectRepo     *mocks.ProjectRepository
+	eventPublisher  *mocks.EventPublisher
+	noteRepo        *mocks.NoteRepository
+	settingRepo     *mocks.SettingRepository
+	idleRepo        *mocks.IdleRepository
+	transactionRepo *mocks.TransactionRepository
+	useCase         UseCase
 }
 
 func (t *UseCaseTestSuite) SetupTest() {
 	t.taskRepo = &mocks.TaskRepository{}
 	t.projectRepo = &mocks.ProjectRepository{}
-	t.useCase = New(t.taskRepo, t.projectRepo)
+	t.eventPublisher = &mocks.EventPublisher{}
+	t.noteRepo = &mocks.NoteRepository{}
+	t.settingRepo = &mocks.SettingRepository{}
+	t.idleRepo = &mocks.IdleRepository{}
+	t.transactionRepo = &mocks.TransactionRepository{}
+	t.transactionRepo.On("WithinTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Maybe()
+	t.useCase = New(t.taskRepo, t.projectRepo, t.eventPublisher, t.noteRepo, t.settingRepo, map[entity.IdleSourceType]IdleRepository{
+		entity.IdleSourceFile: t.idleRepo,
+	}, t.transactionRepo)
+}
+
+func eventOf(eventType entity.EventType, taskID string) interface{} {
+	return mock.MatchedBy(func(event entity.Event) bool {
+		return event.Type == eventType && event.Task.ID == taskID && !event.OccurredAt.IsZero()
+	})
 }
 
 func TestUseCaseTestSuite(t *testing.T) {
@@ -216,6 +237,7 @@ func (t *UseCaseTestSuite) TestAdd() {
 				}, nil).Once()
 				task.ProjectID = "project-1"
 				t.taskRepo.On("Insert", mock.Anything, task).Return(nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskAdded, "task-1")).Return(nil).Once()
 			},
 		},
 	}
@@ -255,7 +277,19 @@ func (t *UseCaseTestSuite) TestStart() {
 				t.taskRepo.On("GetByID", mock.Anything, taskID).Return(entity.Task{
 					ID: "task-1",
 				}, nil).Once()
-				t.taskRepo.On("SetStartedTask", mock.Anything, mock.Anything).Return(errors.New("failed to start task")).Once()
+				t.taskRepo.On("SetStartedTask", mock.Anything, mock.Anything).Return(nil, errors.New("failed to start task")).Once()
+			},
+		},
+		{
+			name:        "failed to publish event",
+			taskID:      "task-1",
+			expectedErr: errors.New("failed to publish event"),
+			mockFunc: func(taskID string) {
+				t.taskRepo.On("GetByID", mock.Anything, taskID).Return(entity.Task{
+					ID: "task-1",
+				}, nil).Once()
+				t.taskRepo.On("SetStartedTask", mock.Anything, mock.Anything).Return(nil, nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskStarted, "task-1")).Return(errors.New("failed to publish event")).Once()
 			},
 		},
 		{
@@ -266,7 +300,41 @@ func (t *UseCaseTestSuite) TestStart() {
 				t.taskRepo.On("GetByID", mock.Anything, taskID).Return(entity.Task{
 					ID: "task-1",
 				}, nil).Once()
-				t.taskRepo.On("SetStartedTask", mock.Anything, mock.Anything).Return(nil).Once()
+				t.taskRepo.On("SetStartedTask", mock.Anything, mock.Anything).Return(nil, nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskStarted, "task-1")).Return(nil).Once()
+			},
+		},
+		{
+			name:        "stops the running task",
+			taskID:      "task-1",
+			expectedErr: errors.New("failed to publish event"),
+			mockFunc: func(taskID string) {
+				t.taskRepo.On("GetByID", mock.Anything, taskID).Return(entity.Task{
+					ID: "task-1",
+				}, nil).Once()
+				t.taskRepo.On("SetStartedTask", mock.Anything, mock.MatchedBy(func(task entity.Task) bool {
+					return task.ID == "task-1" && task.IsStarted
+				})).Return(entity.Tasks{{ID: "task-2"}}, nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskStopped, "task-2")).Return(errors.New("failed to publish event")).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskStarted, "task-1")).Return(nil).Once()
+			},
+		},
+		{
+			name:        "restarts the started task",
+			taskID:      "task-1",
+			expectedErr: nil,
+			mockFunc: func(taskID string) {
+				t.taskRepo.On("GetByID", mock.Anything, taskID).Return(entity.Task{
+					ID:        "task-1",
+					IsStarted: true,
+					Histories: []entity.TaskHistory{{StartedAt: time.Now().Add(-time.Hour)}},
+				}, nil).Once()
+				t.taskRepo.On("SetStartedTask", mock.Anything, mock.MatchedBy(func(task entity.Task) bool {
+					return task.IsStarted && len(task.Histories) == 2 &&
+						!task.Histories[0].StoppedAt.IsZero() && task.Histories[1].StoppedAt.IsZero()
+				})).Return(nil, nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskStopped, "task-1")).Return(nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskStarted, "task-1")).Return(nil).Once()
 			},
 		},
 	}
@@ -283,6 +351,70 @@ func (t *UseCaseTestSuite) TestStart() {
 	}
 }
 
+func (t *UseCaseTestSuite) TestRepair() {
+	at := func(hour int) []entity.TaskHistory {
+		return []entity.TaskHistory{{StartedAt: time.Date(2024, 1, 1, hour, 0, 0, 0, time.UTC)}}
+	}
+
+	tests := []struct {
+		name           string
+		expectedResult entity.Tasks
+		expectedErr    error
+		mockFunc       func()
+	}{
+		{
+			name:        "failed to get tasks",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.taskRepo.On("GetAll", mock.Anything).Return(nil, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name: "no started task",
+			mockFunc: func() {
+				t.taskRepo.On("GetAll", mock.Anything).Return(entity.Tasks{{ID: "task-1"}}, nil).Once()
+			},
+		},
+		{
+			name:        "failed to set started task",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.taskRepo.On("GetAll", mock.Anything).Return(entity.Tasks{{ID: "task-1", IsStarted: true, Histories: at(1)}}, nil).Once()
+				t.taskRepo.On("SetStartedTask", mock.Anything, mock.Anything).Return(nil, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:           "keeps the task started last",
+			expectedResult: entity.Tasks{{ID: "task-1"}, {ID: "task-3"}},
+			mockFunc: func() {
+				t.taskRepo.On("GetAll", mock.Anything).Return(entity.Tasks{
+					{ID: "task-1", IsStarted: true, Histories: at(1)},
+					{ID: "task-2", IsStarted: true, Histories: at(3)},
+					{ID: "task-3", IsStarted: true, Histories: at(2)},
+					{ID: "task-4", Histories: at(4)},
+				}, nil).Once()
+				t.taskRepo.On("SetStartedTask", mock.Anything, entity.Task{ID: "task-2", IsStarted: true, Histories: at(3)}).Return(entity.Tasks{{ID: "task-1"}, {ID: "task-3"}}, nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskStopped, "task-1")).Return(nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskStopped, "task-3")).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc()
+
+			res, err := t.useCase.Repair(context.Background())
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			t.Equal(tt.expectedErr, err)
+			t.Equal(tt.expectedResult, res)
+		})
+	}
+}
+
 func (t *UseCaseTestSuite) TestStop() {
 	tests := []struct {
 		name        string
@@ -324,6 +456,7 @@ func (t *UseCaseTestSuite) TestStop() {
 					},
 				}, nil).Once()
 				t.taskRepo.On("Update", mock.Anything, mock.Anything).Return(nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskStopped, "task-1")).Return(nil).Once()
 			},
 		},
 	}
@@ -341,6 +474,121 @@ func (t *UseCaseTestSuite) TestStop() {
 	}
 }
 
+func (t *UseCaseTestSuite) TestPause() {
+	startedAt := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "failed to get started task",
+			expectedErr: ErrTaskNotFound,
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{}, ErrTaskNotFound).Once()
+			},
+		},
+		{
+			name:        "already paused",
+			expectedErr: ErrTaskPaused,
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{
+					ID:        "task-1",
+					IsStarted: true,
+					Histories: []entity.TaskHistory{{StartedAt: startedAt, Pauses: []entity.TaskPause{{StartedAt: startedAt.Add(time.Hour)}}}},
+				}, nil).Once()
+			},
+		},
+		{
+			name:        "success",
+			expectedErr: nil,
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{
+					ID:        "task-1",
+					IsStarted: true,
+					Histories: []entity.TaskHistory{{StartedAt: startedAt}},
+				}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, mock.MatchedBy(func(task entity.Task) bool {
+					return task.IsPaused() && task.Histories[0].Pauses[0].Reason == "lunch"
+				})).Return(nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskPaused, "task-1")).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc()
+
+			err := t.useCase.Pause(context.Background(), " lunch ")
+			if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
+				err = unwrappedErr
+			}
+			t.Equal(tt.expectedErr, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestResume() {
+	startedAt := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "not paused",
+			expectedErr: ErrTaskNotPaused,
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{
+					ID:        "task-1",
+					IsStarted: true,
+					Histories: []entity.TaskHistory{{StartedAt: startedAt}},
+				}, nil).Once()
+			},
+		},
+		{
+			name:        "failed to update task",
+			expectedErr: errors.New("failed to update task"),
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{
+					ID:        "task-1",
+					IsStarted: true,
+					Histories: []entity.TaskHistory{{StartedAt: startedAt, Pauses: []entity.TaskPause{{StartedAt: startedAt.Add(time.Hour)}}}},
+				}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, mock.Anything).Return(errors.New("failed to update task")).Once()
+			},
+		},
+		{
+			name:        "success",
+			expectedErr: nil,
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{
+					ID:        "task-1",
+					IsStarted: true,
+					Histories: []entity.TaskHistory{{StartedAt: startedAt, Pauses: []entity.TaskPause{{StartedAt: startedAt.Add(time.Hour)}}}},
+				}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, mock.MatchedBy(func(task entity.Task) bool {
+					return task.IsStarted && !task.IsPaused()
+				})).Return(nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskResumed, "task-1")).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc()
+
+			err := t.useCase.Resume(context.Background())
+			if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
+				err = unwrappedErr
+			}
+			t.Equal(tt.expectedErr, err)
+		})
+	}
+}
+
 func (t *UseCaseTestSuite) TestRemove() {
 	tests := []struct {
 		name        string
@@ -348,11 +596,22 @@ func (t *UseCaseTestSuite) TestRemove() {
 		expectedErr error
 		mockFunc    func(taskID string)
 	}{
+		{
+			name:        "failed to get task by ID",
+			taskID:      "task-1",
+			expectedErr: errors.New("failed to get task by ID"),
+			mockFunc: func(taskID string) {
+				t.taskRepo.On("GetByID", mock.Anything, taskID).Return(entity.Task{}, errors.New("failed to get task by ID")).Once()
+			},
+		},
 		{
 			name:        "failed to remove task",
 			taskID:      "task-1",
 			expectedErr: errors.New("failed to remove task"),
 			mockFunc: func(taskID string) {
+				t.taskRepo.On("GetByID", mock.Anything, taskID).Return(entity.Task{
+					ID: "task-1",
+				}, nil).Once()
 				t.taskRepo.On("Delete", mock.Anything, taskID).Return(errors.New("failed to remove task")).Once()
 			},
 		},
@@ -361,7 +620,11 @@ func (t *UseCaseTestSuite) TestRemove() {
 			taskID:      "task-1",
 			expectedErr: nil,
 			mockFunc: func(taskID string) {
+				t.taskRepo.On("GetByID", mock.Anything, taskID).Return(entity.Task{
+					ID: "task-1",
+				}, nil).Once()
 				t.taskRepo.On("Delete", mock.Anything, taskID).Return(nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskRemoved, "task-1")).Return(nil).Once()
 			},
 		},
 	}
@@ -413,6 +676,7 @@ func (t *UseCaseTestSuite) TestComplete() {
 					ID: "task-1",
 				}, nil).Once()
 				t.taskRepo.On("Update", mock.Anything, mock.Anything).Return(nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskCompleted, "task-1")).Return(nil).Once()
 			},
 		},
 	}
@@ -429,6 +693,64 @@ func (t *UseCaseTestSuite) TestComplete() {
 	}
 }
 
+func (t *UseCaseTestSuite) TestEdit() {
+	tests := []struct {
+		name        string
+		task        entity.Task
+		expectedErr error
+		mockFunc    func(task entity.Task)
+	}{
+		{
+			name:        "failed to get task by ID",
+			task:        entity.Task{ID: "task-1", Name: "new-name"},
+			expectedErr: errors.New("failed to get task by ID"),
+			mockFunc: func(task entity.Task) {
+				t.taskRepo.On("GetByID", mock.Anything, task.ID).Return(entity.Task{}, errors.New("failed to get task by ID")).Once()
+			},
+		},
+		{
+			name:        "failed to update task",
+			task:        entity.Task{ID: "task-1", Name: "new-name"},
+			expectedErr: errors.New("failed to update task"),
+			mockFunc: func(task entity.Task) {
+				t.taskRepo.On("GetByID", mock.Anything, task.ID).Return(entity.Task{ID: "task-1", Name: "old-name"}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, mock.Anything).Return(errors.New("failed to update task")).Once()
+			},
+		},
+		{
+			name:        "success",
+			task:        entity.Task{ID: "task-1", Name: "new-name", Description: "new-description"},
+			expectedErr: nil,
+			mockFunc: func(task entity.Task) {
+				t.taskRepo.On("GetByID", mock.Anything, task.ID).Return(entity.Task{
+					ID:        "task-1",
+					Name:      "old-name",
+					IsStarted: true,
+					Histories: []entity.TaskHistory{{StartedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}},
+				}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, entity.Task{
+					ID:          "task-1",
+					Name:        "new-name",
+					Description: "new-description",
+					IsStarted:   true,
+					Histories:   []entity.TaskHistory{{StartedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}},
+				}).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc(tt.task)
+			err := t.useCase.Edit(context.Background(), tt.task)
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+			t.Equal(tt.expectedErr, err)
+		})
+	}
+}
+
 func (t *UseCaseTestSuite) TestGetByID() {
 	tests := []struct {
 		name        string
@@ -469,3 +791,710 @@ func (t *UseCaseTestSuite) TestGetByID() {
 		})
 	}
 }
+
+func (t *UseCaseTestSuite) TestGetStarted() {
+	tests := []struct {
+		name        string
+		expected    entity.Task
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "no started task",
+			expected:    entity.Task{},
+			expectedErr: ErrTaskNotFound,
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{}, ErrTaskNotFound).Once()
+			},
+		},
+		{
+			name:        "success",
+			expected:    entity.Task{ID: "task-1", IsStarted: true},
+			expectedErr: nil,
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{ID: "task-1", IsStarted: true}, nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc()
+			task, err := t.useCase.GetStarted(context.Background())
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+			t.Equal(tt.expected, task)
+			t.Equal(tt.expectedErr, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestFind() {
+	tasks := entity.Tasks{
+		{ID: "0b5f3c2a-1", Integration: entity.TaskIntegration{ID: "TODO-1"}},
+		{ID: "0b5f3c2a-2", ParentTaskID: "0b5f3c2a-1"},
+		{ID: "7c1d2e3f-3"},
+	}
+	mockTasks := func() {
+		t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{ID: "project-1"}, nil).Once()
+		t.taskRepo.On("GetUncompleteParentTasks", mock.Anything, "project-1").Return(entity.Tasks{tasks[0], tasks[2]}, nil).Once()
+		t.taskRepo.On("GetUncompleteSubTask", mock.Anything, "project-1").Return(map[string]entity.Tasks{"0b5f3c2a-1": {tasks[1]}}, nil).Once()
+	}
+
+	tests := []struct {
+		name        string
+		ref         string
+		expected    entity.Task
+		expectedErr error
+		mockFunc    func(ref string)
+	}{
+		{
+			name:        "failed to get tasks",
+			ref:         "TODO-1",
+			expectedErr: entity.ErrNoProjectSelected,
+			mockFunc: func(ref string) {
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(entity.Project{}, entity.ErrNoProjectSelected).Once()
+			},
+		},
+		{
+			name:     "by integration key",
+			ref:      "todo-1",
+			expected: tasks[0],
+			mockFunc: func(ref string) {
+				mockTasks()
+			},
+		},
+		{
+			name:     "by completed task ID",
+			ref:      "task-9",
+			expected: entity.Task{ID: "task-9"},
+			mockFunc: func(ref string) {
+				mockTasks()
+				t.taskRepo.On("GetByID", mock.Anything, ref).Return(entity.Task{ID: "task-9"}, nil).Once()
+			},
+		},
+		{
+			name:        "failed to get task by ID",
+			ref:         "task-9",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func(ref string) {
+				mockTasks()
+				t.taskRepo.On("GetByID", mock.Anything, ref).Return(entity.Task{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:     "by ID prefix",
+			ref:      "7c1d",
+			expected: tasks[2],
+			mockFunc: func(ref string) {
+				mockTasks()
+				t.taskRepo.On("GetByID", mock.Anything, ref).Return(entity.Task{}, ErrTaskNotFound).Once()
+			},
+		},
+		{
+			name:        "ambiguous ID prefix",
+			ref:         "0b5f",
+			expectedErr: ErrAmbiguousTask,
+			mockFunc: func(ref string) {
+				mockTasks()
+				t.taskRepo.On("GetByID", mock.Anything, ref).Return(entity.Task{}, ErrTaskNotFound).Once()
+			},
+		},
+		{
+			name:        "not found",
+			ref:         "ffff",
+			expectedErr: ErrTaskNotFound,
+			mockFunc: func(ref string) {
+				mockTasks()
+				t.taskRepo.On("GetByID", mock.Anything, ref).Return(entity.Task{}, ErrTaskNotFound).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc(tt.ref)
+			task, err := t.useCase.Find(context.Background(), tt.ref)
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+			t.Equal(tt.expected, task)
+			t.Equal(tt.expectedErr, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestAddNote() {
+	tests := []struct {
+		name        string
+		content     string
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "empty note",
+			content:     "  ",
+			expectedErr: ErrEmptyNote,
+			mockFunc:    func() {},
+		},
+		{
+			name:        "failed to insert note",
+			content:     "any-content",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.noteRepo.On("Insert", mock.Anything, mock.Anything).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:    "success",
+			content: " any-content\n",
+			mockFunc: func() {
+				t.noteRepo.On("Insert", mock.Anything, mock.MatchedBy(func(note entity.TaskNote) bool {
+					return note.ID != "" && note.TaskID == "task-1" && note.Content == "any-content" && !note.CreatedAt.IsZero()
+				})).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc()
+			note, err := t.useCase.AddNote(context.Background(), "task-1", tt.content)
+			if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
+				err = unwrappedErr
+			}
+			t.Equal(tt.expectedErr, err)
+			if err == nil {
+				t.Equal("any-content", note.Content)
+			}
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestGetNotes() {
+	t.noteRepo.On("GetByTaskID", mock.Anything, "task-1").Return(entity.TaskNotes{{ID: "note-1"}}, nil).Once()
+
+	notes, err := t.useCase.GetNotes(context.Background(), "task-1")
+
+	t.NoError(err)
+	t.Equal(entity.TaskNotes{{ID: "note-1"}}, notes)
+}
+
+func (t *UseCaseTestSuite) TestGetDetail() {
+	project := entity.Project{
+		ID: "project-1",
+		Integrations: []entity.Integration{
+			{Type: entity.IntegrationTypeJIRA, Details: map[string]string{"url": "https://any.atlassian.net/"}},
+		},
+	}
+	parentTask := entity.Task{ID: "10001", ProjectID: "project-1", Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA}}
+	subTask := entity.Task{ID: "task-2", ProjectID: "project-1", ParentTaskID: "10001"}
+
+	tests := []struct {
+		name        string
+		taskID      string
+		expected    entity.TaskDetail
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "failed to get task",
+			taskID:      "task-2",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-2").Return(entity.Task{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:        "failed to get project",
+			taskID:      "task-2",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-2").Return(subTask, nil).Once()
+				t.projectRepo.On("GetByID", mock.Anything, "project-1").Return(entity.Project{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:        "failed to get notes",
+			taskID:      "10001",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "10001").Return(parentTask, nil).Once()
+				t.projectRepo.On("GetByID", mock.Anything, "project-1").Return(project, nil).Once()
+				t.taskRepo.On("GetSubTasks", mock.Anything, "10001").Return(entity.Tasks{subTask}, nil).Once()
+				t.noteRepo.On("GetByTaskID", mock.Anything, "10001").Return(nil, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:   "jira task",
+			taskID: "10001",
+			expected: entity.TaskDetail{
+				Task:     parentTask,
+				Project:  project,
+				SubTasks: entity.Tasks{subTask},
+				Notes:    entity.TaskNotes{{ID: "note-1"}},
+				IssueURL: "https://any.atlassian.net/browse/TODO-1",
+			},
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "10001").Return(parentTask, nil).Once()
+				t.projectRepo.On("GetByID", mock.Anything, "project-1").Return(project, nil).Once()
+				t.taskRepo.On("GetSubTasks", mock.Anything, "10001").Return(entity.Tasks{subTask}, nil).Once()
+				t.noteRepo.On("GetByTaskID", mock.Anything, "10001").Return(entity.TaskNotes{{ID: "note-1"}}, nil).Once()
+			},
+		},
+		{
+			name:   "subtask of jira task",
+			taskID: "task-2",
+			expected: entity.TaskDetail{
+				Task:     subTask,
+				Project:  project,
+				Parent:   &parentTask,
+				IssueURL: "https://any.atlassian.net/browse/TODO-1",
+			},
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-2").Return(subTask, nil).Once()
+				t.projectRepo.On("GetByID", mock.Anything, "project-1").Return(project, nil).Once()
+				t.taskRepo.On("GetByID", mock.Anything, "10001").Return(parentTask, nil).Once()
+				t.taskRepo.On("GetSubTasks", mock.Anything, "task-2").Return(nil, nil).Once()
+				t.noteRepo.On("GetByTaskID", mock.Anything, "task-2").Return(nil, nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc()
+			detail, err := t.useCase.GetDetail(context.Background(), tt.taskID)
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+			t.Equal(tt.expected, detail)
+			t.Equal(tt.expectedErr, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestAddTime() {
+	startedAt := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	session := entity.TaskHistory{StartedAt: startedAt, StoppedAt: startedAt.Add(time.Hour)}
+	earlier := entity.TaskHistory{StartedAt: startedAt.Add(-3 * time.Hour), StoppedAt: startedAt.Add(-2 * time.Hour)}
+	tests := []struct {
+		name        string
+		history     entity.TaskHistory
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "failed to get task by ID",
+			history:     session,
+			expectedErr: errors.New("failed to get task by ID"),
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{}, errors.New("failed to get task by ID")).Once()
+			},
+		},
+		{
+			name:        "stop before start",
+			history:     entity.TaskHistory{StartedAt: session.StoppedAt, StoppedAt: session.StartedAt},
+			expectedErr: ErrInvalidSession,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1"}, nil).Once()
+			},
+		},
+		{
+			name:        "without stop",
+			history:     entity.TaskHistory{StartedAt: startedAt},
+			expectedErr: ErrInvalidSession,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1"}, nil).Once()
+			},
+		},
+		{
+			name:        "in the future",
+			history:     entity.TaskHistory{StartedAt: time.Now().Add(time.Hour), StoppedAt: time.Now().Add(2 * time.Hour)},
+			expectedErr: ErrInvalidSession,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1"}, nil).Once()
+			},
+		},
+		{
+			name:        "overlaps another task",
+			history:     session,
+			expectedErr: ErrOverlappingSession,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1"}, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(entity.Tasks{
+					{ID: "task-2", Histories: []entity.TaskHistory{{StartedAt: startedAt.Add(30 * time.Minute), StoppedAt: startedAt.Add(2 * time.Hour)}}},
+				}, nil).Once()
+			},
+		},
+		{
+			name:        "failed to update task",
+			history:     session,
+			expectedErr: errors.New("failed to update task"),
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1"}, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(entity.Tasks{{ID: "task-1"}}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, mock.Anything).Return(errors.New("failed to update task")).Once()
+			},
+		},
+		{
+			name:        "success",
+			history:     earlier,
+			expectedErr: nil,
+			mockFunc: func() {
+				stored := entity.Task{ID: "task-1", Histories: []entity.TaskHistory{session}}
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(stored, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(entity.Tasks{stored}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, entity.Task{ID: "task-1", Histories: []entity.TaskHistory{earlier, session}}).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc()
+			err := t.useCase.AddTime(context.Background(), "task-1", tt.history)
+			if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
+				err = unwrappedErr
+			}
+			t.Equal(tt.expectedErr, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestEditTime() {
+	startedAt := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	first := entity.TaskHistory{StartedAt: startedAt, StoppedAt: startedAt.Add(time.Hour)}
+	running := entity.TaskHistory{StartedAt: startedAt.Add(2 * time.Hour)}
+	// EditTime changes the histories in place, so every call gets its own copy
+	stored := func() entity.Task {
+		return entity.Task{ID: "task-1", IsStarted: true, Histories: []entity.TaskHistory{first, running}}
+	}
+	tests := []struct {
+		name        string
+		index       int
+		history     entity.TaskHistory
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "session not found",
+			index:       2,
+			history:     first,
+			expectedErr: ErrSessionNotFound,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(stored(), nil).Once()
+			},
+		},
+		{
+			name:        "stop running session",
+			index:       1,
+			history:     entity.TaskHistory{StartedAt: running.StartedAt, StoppedAt: running.StartedAt.Add(time.Hour)},
+			expectedErr: ErrRunningSession,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(stored(), nil).Once()
+			},
+		},
+		{
+			name:        "overlaps the running session",
+			index:       0,
+			history:     entity.TaskHistory{StartedAt: startedAt, StoppedAt: startedAt.Add(3 * time.Hour)},
+			expectedErr: ErrOverlappingSession,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(stored(), nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(entity.Tasks{stored()}, nil).Once()
+			},
+		},
+		{
+			name:        "success",
+			index:       0,
+			history:     entity.TaskHistory{StartedAt: startedAt, StoppedAt: startedAt.Add(90 * time.Minute)},
+			expectedErr: nil,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(stored(), nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(entity.Tasks{stored()}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, entity.Task{
+					ID:        "task-1",
+					IsStarted: true,
+					Histories: []entity.TaskHistory{{StartedAt: startedAt, StoppedAt: startedAt.Add(90 * time.Minute)}, running},
+				}).Return(nil).Once()
+			},
+		},
+		{
+			name:  "shortened session with a pause",
+			index: 0,
+			history: entity.TaskHistory{
+				StartedAt: startedAt.Add(15 * time.Minute),
+				StoppedAt: startedAt.Add(45 * time.Minute),
+				Pauses: []entity.TaskPause{
+					{StartedAt: startedAt.Add(5 * time.Minute), StoppedAt: startedAt.Add(10 * time.Minute)},
+					{StartedAt: startedAt.Add(30 * time.Minute), StoppedAt: startedAt.Add(50 * time.Minute)},
+				},
+			},
+			expectedErr: nil,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(stored(), nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(entity.Tasks{stored()}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, entity.Task{
+					ID:        "task-1",
+					IsStarted: true,
+					Histories: []entity.TaskHistory{
+						{
+							StartedAt: startedAt.Add(15 * time.Minute),
+							StoppedAt: startedAt.Add(45 * time.Minute),
+							Pauses:    []entity.TaskPause{{StartedAt: startedAt.Add(30 * time.Minute), StoppedAt: startedAt.Add(45 * time.Minute)}},
+						},
+						running,
+					},
+				}).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc()
+			err := t.useCase.EditTime(context.Background(), "task-1", tt.index, tt.history)
+			if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
+				err = unwrappedErr
+			}
+			t.Equal(tt.expectedErr, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestDeleteTime() {
+	startedAt := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	first := entity.TaskHistory{StartedAt: startedAt, StoppedAt: startedAt.Add(time.Hour)}
+	running := entity.TaskHistory{StartedAt: startedAt.Add(2 * time.Hour)}
+	tests := []struct {
+		name        string
+		index       int
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "session not found",
+			index:       -1,
+			expectedErr: ErrSessionNotFound,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1", Histories: []entity.TaskHistory{first, running}}, nil).Once()
+			},
+		},
+		{
+			name:        "running session",
+			index:       1,
+			expectedErr: ErrRunningSession,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1", Histories: []entity.TaskHistory{first, running}}, nil).Once()
+			},
+		},
+		{
+			name:        "success",
+			index:       0,
+			expectedErr: nil,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1", Histories: []entity.TaskHistory{first, running}}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, entity.Task{ID: "task-1", Histories: []entity.TaskHistory{running}}).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc()
+			err := t.useCase.DeleteTime(context.Background(), "task-1", tt.index)
+			if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
+				err = unwrappedErr
+			}
+			t.Equal(tt.expectedErr, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestSetTrackingSetting() {
+	tests := []struct {
+		name        string
+		setting     entity.TrackingSetting
+		expectedErr error
+		mockFunc    func(setting entity.TrackingSetting)
+	}{
+		{
+			name:        "unsupported idle source",
+			setting:     entity.TrackingSetting{IdleSource: entity.IdleSourceX11},
+			expectedErr: ErrUnsupportedIdleSource,
+			mockFunc:    func(setting entity.TrackingSetting) {},
+		},
+		{
+			name:        "file source without file",
+			setting:     entity.TrackingSetting{IdleSource: entity.IdleSourceFile},
+			expectedErr: ErrInvalidSetting,
+			mockFunc:    func(setting entity.TrackingSetting) {},
+		},
+		{
+			name:        "failed to set tracking setting",
+			setting:     entity.TrackingSetting{MaxSession: time.Hour},
+			expectedErr: errors.New("failed to set tracking setting"),
+			mockFunc: func(setting entity.TrackingSetting) {
+				t.settingRepo.On("SetTrackingSetting", mock.Anything, setting).Return(errors.New("failed to set tracking setting")).Once()
+			},
+		},
+		{
+			name:        "success",
+			setting:     entity.TrackingSetting{MaxSession: time.Hour, IdleSource: entity.IdleSourceFile, IdleFile: "/tmp/activity"},
+			expectedErr: nil,
+			mockFunc: func(setting entity.TrackingSetting) {
+				t.settingRepo.On("SetTrackingSetting", mock.Anything, setting).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc(tt.setting)
+			err := t.useCase.SetTrackingSetting(context.Background(), tt.setting)
+			if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
+				err = unwrappedErr
+			}
+			t.Equal(tt.expectedErr, err)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestCheckSession() {
+	startedAt := time.Now().Add(-60 * time.Hour)
+	startedTask := func() entity.Task {
+		return entity.Task{ID: "task-1", IsStarted: true, Histories: []entity.TaskHistory{{StartedAt: startedAt}}}
+	}
+	lastActivity := startedAt.Add(2 * time.Hour)
+	tests := []struct {
+		name        string
+		expectedRes entity.ForgottenSession
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name: "no started task",
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(entity.Task{}, ErrTaskNotFound).Once()
+			},
+		},
+		{
+			name:        "failed to get tracking setting",
+			expectedErr: errors.New("failed to get tracking setting"),
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(startedTask(), nil).Once()
+				t.settingRepo.On("GetTrackingSetting", mock.Anything).Return(entity.TrackingSetting{}, errors.New("failed to get tracking setting")).Once()
+			},
+		},
+		{
+			name: "within the limit",
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(startedTask(), nil).Once()
+				t.settingRepo.On("GetTrackingSetting", mock.Anything).Return(entity.TrackingSetting{MaxSession: 100 * time.Hour}, nil).Once()
+			},
+		},
+		{
+			name:        "longer than the limit",
+			expectedRes: entity.ForgottenSession{Task: startedTask(), StopAt: startedAt.Add(12 * time.Hour), Reason: entity.ForgottenReasonMaxSession},
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(startedTask(), nil).Once()
+				t.settingRepo.On("GetTrackingSetting", mock.Anything).Return(entity.DefaultTrackingSetting(), nil).Once()
+			},
+		},
+		{
+			name:        "idle before the limit",
+			expectedRes: entity.ForgottenSession{Task: startedTask(), StopAt: lastActivity, Reason: entity.ForgottenReasonIdle},
+			mockFunc: func() {
+				setting := entity.TrackingSetting{MaxSession: 12 * time.Hour, IdleSource: entity.IdleSourceFile, IdleAfter: 15 * time.Minute}
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(startedTask(), nil).Once()
+				t.settingRepo.On("GetTrackingSetting", mock.Anything).Return(setting, nil).Once()
+				t.idleRepo.On("LastActivity", mock.Anything, setting).Return(lastActivity, nil).Once()
+			},
+		},
+		{
+			name:        "failed to get last activity",
+			expectedRes: entity.ForgottenSession{Task: startedTask(), StopAt: startedAt.Add(12 * time.Hour), Reason: entity.ForgottenReasonMaxSession},
+			expectedErr: errors.New("failed to get last activity"),
+			mockFunc: func() {
+				setting := entity.TrackingSetting{MaxSession: 12 * time.Hour, IdleSource: entity.IdleSourceFile}
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(startedTask(), nil).Once()
+				t.settingRepo.On("GetTrackingSetting", mock.Anything).Return(setting, nil).Once()
+				t.idleRepo.On("LastActivity", mock.Anything, setting).Return(time.Time{}, errors.New("failed to get last activity")).Once()
+			},
+		},
+		{
+			name:        "trimmed automatically",
+			expectedRes: entity.ForgottenSession{Task: startedTask(), StopAt: startedAt.Add(time.Hour), Reason: entity.ForgottenReasonMaxSession, Trimmed: true},
+			mockFunc: func() {
+				t.taskRepo.On("GetStartedTask", mock.Anything).Return(startedTask(), nil).Once()
+				t.settingRepo.On("GetTrackingSetting", mock.Anything).Return(entity.TrackingSetting{MaxSession: time.Hour, AutoTrim: true}, nil).Once()
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(startedTask(), nil).Once()
+				t.taskRepo.On("Update", mock.Anything, entity.Task{
+					ID:        "task-1",
+					Histories: []entity.TaskHistory{{StartedAt: startedAt, StoppedAt: startedAt.Add(time.Hour)}},
+				}).Return(nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskStopped, "task-1")).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc()
+			res, err := t.useCase.CheckSession(context.Background())
+			if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
+				err = unwrappedErr
+			}
+			t.Equal(tt.expectedErr, err)
+			t.Equal(tt.expectedRes, res)
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestTrimSession() {
+	startedAt := time.Date(2024, 1, 5, 17, 0, 0, 0, time.UTC)
+	session := entity.ForgottenSession{Task: entity.Task{ID: "task-1"}, StopAt: startedAt.Add(time.Hour)}
+	tests := []struct {
+		name        string
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "task is not started anymore",
+			expectedErr: ErrTaskNotFound,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1"}, nil).Once()
+			},
+		},
+		{
+			name:        "failed to update task",
+			expectedErr: errors.New("failed to update task"),
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1", IsStarted: true, Histories: []entity.TaskHistory{{StartedAt: startedAt}}}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, mock.Anything).Return(errors.New("failed to update task")).Once()
+			},
+		},
+		{
+			name:        "success",
+			expectedErr: nil,
+			mockFunc: func() {
+				t.taskRepo.On("GetByID", mock.Anything, "task-1").Return(entity.Task{ID: "task-1", IsStarted: true, Histories: []entity.TaskHistory{{StartedAt: startedAt}}}, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, entity.Task{ID: "task-1", Histories: []entity.TaskHistory{{StartedAt: startedAt, StoppedAt: startedAt.Add(time.Hour)}}}).Return(nil).Once()
+				t.eventPublisher.On("Publish", mock.Anything, eventOf(entity.EventTypeTaskStopped, "task-1")).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func() {
+			tt.mockFunc()
+			err := t.useCase.TrimSession(context.Background(), session)
+			if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
+				err = unwrappedErr
+			}
+			t.Equal(tt.expectedErr, err)
+		})
+	}
+}
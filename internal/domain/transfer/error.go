@@ -0,0 +1,5 @@
+package transfer
+
+import "errors"
+
+var ErrUnsupportedFormat = errors.New("unsupported format")
@@ -0,0 +1,44 @@
+package transfer
+
+import (
+	"context"
+	"io"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+// FormatRepository reads and writes projects and tasks in the format of
+// another application.
+type FormatRepository interface {
+	Decode(ctx context.Context, r io.Reader) (entity.Transfer, error)
+	Encode(ctx context.Context, w io.Writer, transfer entity.Transfer) error
+}
+
+type ProjectRepository interface {
+	GetAll(ctx context.Context) (entity.Projects, error)
+	GetSelectedProject(ctx context.Context) (entity.Project, error)
+	Insert(ctx context.Context, project entity.Project) error
+	Update(ctx context.Context, project entity.Project) error
+}
+
+type TaskRepository interface {
+	GetAll(ctx context.Context) (entity.Tasks, error)
+	Insert(ctx context.Context, task entity.Task) error
+	Update(ctx context.Context, task entity.Task) error
+}
+
+type NoteRepository interface {
+	GetAll(ctx context.Context) (entity.TaskNotes, error)
+	Insert(ctx context.Context, note entity.TaskNote) error
+}
+
+type SettingRepository interface {
+	GetTrackingSetting(ctx context.Context) (entity.TrackingSetting, error)
+	SetTrackingSetting(ctx context.Context, setting entity.TrackingSetting) error
+}
+
+// TransactionRepository runs fn atomically, the repositories given the ctx
+// passed to fn take part in the transaction.
+type TransactionRepository interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
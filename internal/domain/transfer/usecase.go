@@ -0,0 +1,571 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type UseCase interface {
+	Import(ctx context.Context, format entity.TransferFormat, r io.Reader, options entity.ImportOptions) (entity.ImportResult, error)
+	Export(ctx context.Context, format entity.TransferFormat, w io.Writer) error
+}
+
+type useCase struct {
+	formatRepos     map[entity.TransferFormat]FormatRepository
+	projectRepo     ProjectRepository
+	taskRepo        TaskRepository
+	noteRepo        NoteRepository
+	settingRepo     SettingRepository
+	transactionRepo TransactionRepository
+}
+
+func New(formatRepos map[entity.TransferFormat]FormatRepository, projectRepo ProjectRepository, taskRepo TaskRepository, noteRepo NoteRepository, settingRepo SettingRepository, transactionRepo TransactionRepository) UseCase {
+	return &useCase{
+		formatRepos:     formatRepos,
+		projectRepo:     projectRepo,
+		taskRepo:        taskRepo,
+		noteRepo:        noteRepo,
+		settingRepo:     settingRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// errDryRun rolls back the transaction of a dry run.
+var errDryRun = errors.New("dry run")
+
+// Import adds the projects and tasks read from r. Projects are matched with
+// the existing ones by ID, then by name, and tasks by ID. What is both stored
+// and imported is updated with what the format carries, unless the stored
+// version is kept, and reported as a conflict when they differ. Imported
+// sessions and notes are added to the stored ones. Imported tasks are never
+// started, so a running session is left out.
+func (u *useCase) Import(ctx context.Context, format entity.TransferFormat, r io.Reader, options entity.ImportOptions) (entity.ImportResult, error) {
+	formatRepo, ok := u.formatRepos[format]
+	if !ok {
+		return entity.ImportResult{}, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+
+	transfer, err := formatRepo.Decode(ctx, r)
+	if err != nil {
+		return entity.ImportResult{}, fmt.Errorf("error while reading %s: %w", format, err)
+	}
+
+	var result entity.ImportResult
+	err = u.transactionRepo.WithinTransaction(ctx, func(ctx context.Context) error {
+		result = entity.ImportResult{}
+		projectIDs, err := u.importProjects(ctx, transfer.Projects, options, &result)
+		if err != nil {
+			return err
+		}
+
+		if err = u.importTasks(ctx, transfer.Tasks, projectIDs, options, &result); err != nil {
+			return err
+		}
+
+		if err = u.importNotes(ctx, transfer.Tasks, transfer.Notes, &result); err != nil {
+			return err
+		}
+
+		if err = u.importTrackingSetting(ctx, transfer.TrackingSetting, options, &result); err != nil {
+			return err
+		}
+
+		if options.DryRun {
+			return errDryRun
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return entity.ImportResult{}, err
+	}
+
+	return result, nil
+}
+
+// importProjects returns the stored ID of every imported project, by its ID or
+// by its name when it has none. Only projects matched by ID are updated, the
+// others carry nothing but their name. The selected project stays selected.
+func (u *useCase) importProjects(ctx context.Context, projects entity.Projects, options entity.ImportOptions, result *entity.ImportResult) (map[string]string, error) {
+	storedProjects, err := u.projectRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting projects: %w", err)
+	}
+
+	hasSelected := false
+	for _, project := range storedProjects {
+		hasSelected = hasSelected || project.IsSelected
+	}
+
+	projectIDs := map[string]string{}
+	for _, project := range projects {
+		ref := project.ID
+		if ref == "" {
+			ref = project.Name
+		}
+
+		if storedProject, ok := findProject(storedProjects, project); ok {
+			projectIDs[ref] = storedProject.ID
+			if storedProject.ID != project.ID {
+				continue
+			}
+
+			project = mergeProject(storedProject, project)
+			fields := projectChanges(storedProject, project)
+			if len(fields) == 0 {
+				continue
+			}
+
+			result.Conflicts = append(result.Conflicts, entity.ImportConflict{Kind: entity.ImportConflictProject, ID: project.ID, Name: storedProject.Name, Fields: fields})
+			if options.KeepLocal {
+				continue
+			}
+
+			if err = u.projectRepo.Update(ctx, project); err != nil {
+				return nil, fmt.Errorf("error while updating project: %w", err)
+			}
+
+			result.UpdatedProjects++
+			continue
+		}
+
+		if project.ID == "" {
+			project.ID = uuid.NewString()
+		}
+
+		// A restored project is selected again when none is
+		project.IsSelected = project.IsSelected && !hasSelected
+		hasSelected = hasSelected || project.IsSelected
+		if err = u.projectRepo.Insert(ctx, project); err != nil {
+			return nil, fmt.Errorf("error while inserting project: %w", err)
+		}
+
+		storedProjects = append(storedProjects, project)
+		projectIDs[ref] = project.ID
+		result.AddedProjects++
+	}
+
+	return projectIDs, nil
+}
+
+func findProject(projects entity.Projects, project entity.Project) (entity.Project, bool) {
+	for _, storedProject := range projects {
+		if project.ID != "" && storedProject.ID == project.ID {
+			return storedProject, true
+		}
+	}
+
+	for _, storedProject := range projects {
+		if entity.SameProjectName(storedProject.Name, project.Name) {
+			return storedProject, true
+		}
+	}
+
+	return entity.Project{}, false
+}
+
+// mergeProject updates the stored project with the imported one. Credentials
+// are never imported, the stored ones of an integration are kept.
+func mergeProject(storedProject, importedProject entity.Project) entity.Project {
+	importedProject.IsSelected = storedProject.IsSelected
+	integrations := make([]entity.Integration, 0, len(importedProject.Integrations))
+	for _, integration := range importedProject.Integrations {
+		if storedIntegration, ok := storedProject.Integration(integration.Type); ok {
+			details := make(map[string]string, len(integration.Details))
+			for key, value := range integration.Details {
+				details[key] = value
+			}
+
+			for _, key := range storedIntegration.SecretKeys() {
+				if value := storedIntegration.Details[key]; value != "" && details[key] == "" {
+					details[key] = value
+				}
+			}
+
+			integration.Details = details
+		}
+
+		integrations = append(integrations, integration)
+	}
+
+	importedProject.Integrations = integrations
+	return importedProject
+}
+
+func projectChanges(storedProject, project entity.Project) []string {
+	return changedFields([]fieldChange{
+		{"name", storedProject.Name != project.Name},
+		{"description", storedProject.Description != project.Description},
+		{"integrations", !sameIntegrations(storedProject.Integrations, project.Integrations)},
+	})
+}
+
+func sameIntegrations(integrations, others []entity.Integration) bool {
+	if len(integrations) != len(others) {
+		return false
+	}
+
+	for i, integration := range integrations {
+		other := others[i]
+		if integration.Type != other.Type || integration.IsEnabled != other.IsEnabled || len(integration.Details) != len(other.Details) {
+			return false
+		}
+
+		for key, value := range integration.Details {
+			if otherValue, ok := other.Details[key]; !ok || otherValue != value {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+type fieldChange struct {
+	field   string
+	changed bool
+}
+
+func changedFields(changes []fieldChange) []string {
+	var fields []string
+	for _, change := range changes {
+		if change.changed {
+			fields = append(fields, change.field)
+		}
+	}
+
+	return fields
+}
+
+func (u *useCase) importTasks(ctx context.Context, tasks entity.Tasks, projectIDs map[string]string, options entity.ImportOptions, result *entity.ImportResult) error {
+	storedTasks, err := u.taskRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting tasks: %w", err)
+	}
+
+	tasksByID := map[string]entity.Task{}
+	for _, task := range storedTasks {
+		tasksByID[task.ID] = task
+	}
+
+	// Parents are imported first, so subtasks can refer to them
+	tasks = append(entity.Tasks{}, tasks...)
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].ParentTaskID == "" && tasks[j].ParentTaskID != ""
+	})
+
+	importedIDs := map[string]bool{}
+	for _, task := range tasks {
+		importedIDs[task.ID] = true
+	}
+
+	var selectedProjectID string
+	for _, task := range tasks {
+		if task.ParentTaskID != "" && !importedIDs[task.ParentTaskID] {
+			if _, ok := tasksByID[task.ParentTaskID]; !ok {
+				task.ParentTaskID = ""
+			}
+		}
+
+		switch projectID, ok := projectIDs[task.ProjectID]; {
+		case ok:
+			task.ProjectID = projectID
+		case task.ProjectID == "" && selectedProjectID == "":
+			selectedProject, err := u.projectRepo.GetSelectedProject(ctx)
+			if err != nil {
+				return fmt.Errorf("error while getting selected project: %w", err)
+			}
+
+			selectedProjectID = selectedProject.ID
+			task.ProjectID = selectedProjectID
+		case task.ProjectID == "":
+			task.ProjectID = selectedProjectID
+		}
+
+		// A subtask belongs to the project of its parent
+		if parentTask, ok := tasksByID[task.ParentTaskID]; ok {
+			task.ProjectID = parentTask.ProjectID
+		}
+
+		task.IsStarted = false
+		task.Histories = finishedHistories(task.Histories)
+		if storedTask, ok := tasksByID[task.ID]; ok {
+			task = mergeTask(storedTask, task)
+			addsSessions := len(task.Histories) > len(storedTask.Histories)
+			fields := taskChanges(storedTask, task)
+			if len(fields) > 0 {
+				result.Conflicts = append(result.Conflicts, entity.ImportConflict{Kind: entity.ImportConflictTask, ID: task.ID, Name: storedTask.Name, Fields: fields})
+			}
+
+			if len(fields) > 0 && options.KeepLocal {
+				storedTask.Histories = task.Histories
+				task = storedTask
+			}
+
+			if !addsSessions && (len(fields) == 0 || options.KeepLocal) {
+				continue
+			}
+
+			if err = u.taskRepo.Update(ctx, task); err != nil {
+				return fmt.Errorf("error while updating task: %w", err)
+			}
+
+			tasksByID[task.ID] = task
+			result.UpdatedTasks++
+			continue
+		}
+
+		if task.ID == "" {
+			task.ID = uuid.NewString()
+		}
+
+		if err = u.taskRepo.Insert(ctx, task); err != nil {
+			return fmt.Errorf("error while inserting task: %w", err)
+		}
+
+		tasksByID[task.ID] = task
+		result.AddedTasks++
+	}
+
+	return nil
+}
+
+func finishedHistories(histories []entity.TaskHistory) []entity.TaskHistory {
+	var finished []entity.TaskHistory
+	for _, history := range histories {
+		if !history.StoppedAt.IsZero() {
+			finished = append(finished, history)
+		}
+	}
+
+	return finished
+}
+
+// mergeTask updates the stored task with the imported one, what the format
+// does not carry is kept.
+func mergeTask(storedTask, importedTask entity.Task) entity.Task {
+	importedTask.IsStarted = storedTask.IsStarted
+	if importedTask.Description == "" {
+		importedTask.Description = storedTask.Description
+	}
+
+	// Formats such as todo.txt only carry the day a task was completed
+	if !importedTask.CompletedAt.IsZero() && sameDay(storedTask.CompletedAt, importedTask.CompletedAt) {
+		importedTask.CompletedAt = storedTask.CompletedAt
+	}
+
+	importedTask.Histories = mergeHistories(storedTask.Histories, importedTask.Histories)
+	if importedTask.Integration.Type == "" {
+		importedTask.Integration = storedTask.Integration
+	}
+
+	return importedTask
+}
+
+func sameDay(t, other time.Time) bool {
+	year, month, day := t.Local().Date()
+	otherYear, otherMonth, otherDay := other.Local().Date()
+	return year == otherYear && month == otherMonth && day == otherDay
+}
+
+// taskChanges names the fields of the stored task the merged one changes,
+// added sessions are not a change.
+func taskChanges(storedTask, task entity.Task) []string {
+	return changedFields([]fieldChange{
+		{"name", storedTask.Name != task.Name},
+		{"description", storedTask.Description != task.Description},
+		{"project", storedTask.ProjectID != task.ProjectID},
+		{"parent", storedTask.ParentTaskID != task.ParentTaskID},
+		{"completed_at", !storedTask.CompletedAt.Equal(task.CompletedAt)},
+		{"due_at", !storedTask.DueAt.Equal(task.DueAt)},
+		{"priority", storedTask.Priority != task.Priority},
+		{"tags", !slices.Equal(storedTask.Tags, task.Tags)},
+		{"integration", storedTask.Integration != task.Integration},
+	})
+}
+
+// mergeHistories adds the imported sessions which are not stored yet, a session
+// is known by when it started.
+func mergeHistories(storedHistories, importedHistories []entity.TaskHistory) []entity.TaskHistory {
+	histories := append([]entity.TaskHistory{}, storedHistories...)
+	for _, importedHistory := range importedHistories {
+		found := false
+		for _, history := range storedHistories {
+			if history.StartedAt.Equal(importedHistory.StartedAt) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			histories = append(histories, importedHistory)
+		}
+	}
+
+	if len(histories) == len(storedHistories) {
+		return storedHistories
+	}
+
+	sort.SliceStable(histories, func(i, j int) bool {
+		return histories[i].StartedAt.Before(histories[j].StartedAt)
+	})
+
+	return histories
+}
+
+// importNotes adds the notes of the imported tasks, unless a note with the
+// same ID, or with the same content written at the same time, is stored.
+func (u *useCase) importNotes(ctx context.Context, tasks entity.Tasks, notes entity.TaskNotes, result *entity.ImportResult) error {
+	if len(notes) == 0 {
+		return nil
+	}
+
+	storedNotes, err := u.noteRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting notes: %w", err)
+	}
+
+	importedIDs := map[string]bool{}
+	for _, task := range tasks {
+		if task.ID != "" {
+			importedIDs[task.ID] = true
+		}
+	}
+
+	for _, note := range notes {
+		if !importedIDs[note.TaskID] || hasNote(storedNotes, note) {
+			continue
+		}
+
+		if note.ID == "" {
+			note.ID = uuid.NewString()
+		}
+
+		if err = u.noteRepo.Insert(ctx, note); err != nil {
+			return fmt.Errorf("error while inserting note: %w", err)
+		}
+
+		storedNotes = append(storedNotes, note)
+		result.AddedNotes++
+	}
+
+	return nil
+}
+
+func hasNote(notes entity.TaskNotes, note entity.TaskNote) bool {
+	for _, storedNote := range notes {
+		if note.ID != "" && storedNote.ID == note.ID {
+			return true
+		}
+
+		if storedNote.TaskID == note.TaskID && storedNote.Content == note.Content && storedNote.CreatedAt.Equal(note.CreatedAt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (u *useCase) importTrackingSetting(ctx context.Context, setting *entity.TrackingSetting, options entity.ImportOptions, result *entity.ImportResult) error {
+	if setting == nil {
+		return nil
+	}
+
+	storedSetting, err := u.settingRepo.GetTrackingSetting(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting tracking setting: %w", err)
+	}
+
+	if storedSetting == *setting {
+		return nil
+	}
+
+	fields := changedFields([]fieldChange{
+		{"max_session", storedSetting.MaxSession != setting.MaxSession},
+		{"auto_trim", storedSetting.AutoTrim != setting.AutoTrim},
+		{"idle_source", storedSetting.IdleSource != setting.IdleSource},
+		{"idle_file", storedSetting.IdleFile != setting.IdleFile},
+		{"idle_after", storedSetting.IdleAfter != setting.IdleAfter},
+	})
+	result.Conflicts = append(result.Conflicts, entity.ImportConflict{Kind: entity.ImportConflictSetting, Name: "tracking", Fields: fields})
+	if options.KeepLocal {
+		return nil
+	}
+
+	if err = u.settingRepo.SetTrackingSetting(ctx, *setting); err != nil {
+		return fmt.Errorf("error while setting tracking setting: %w", err)
+	}
+
+	result.UpdatedSettings++
+	return nil
+}
+
+// Export gives the format every project, without its credentials, task, note
+// and setting, for it to write what it can.
+func (u *useCase) Export(ctx context.Context, format entity.TransferFormat, w io.Writer) error {
+	formatRepo, ok := u.formatRepos[format]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+
+	projects, err := u.projectRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting projects: %w", err)
+	}
+
+	tasks, err := u.taskRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting tasks: %w", err)
+	}
+
+	notes, err := u.noteRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting notes: %w", err)
+	}
+
+	trackingSetting, err := u.settingRepo.GetTrackingSetting(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting tracking setting: %w", err)
+	}
+
+	transfer := entity.Transfer{Projects: withoutSecrets(projects), Tasks: tasks, Notes: notes, TrackingSetting: &trackingSetting}
+	if err = formatRepo.Encode(ctx, w, transfer); err != nil {
+		return fmt.Errorf("error while writing %s: %w", format, err)
+	}
+
+	return nil
+}
+
+func withoutSecrets(projects entity.Projects) entity.Projects {
+	result := make(entity.Projects, 0, len(projects))
+	for _, project := range projects {
+		if len(project.Integrations) > 0 {
+			integrations := make([]entity.Integration, 0, len(project.Integrations))
+			for _, integration := range project.Integrations {
+				details := make(map[string]string, len(integration.Details))
+				for key, value := range integration.Details {
+					if !slices.Contains(integration.SecretKeys(), key) {
+						details[key] = value
+					}
+				}
+
+				integration.Details = details
+				integrations = append(integrations, integration)
+			}
+
+			project.Integrations = integrations
+		}
+
+		result = append(result, project)
+	}
+
+	return result
+}
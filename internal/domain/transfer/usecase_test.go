@@ -0,0 +1,328 @@
+package transfer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/transfer/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseTestSuite struct {
+	suite.Suite
+	formatRepo      *mocks.FormatRepository
+	projectRepo     *mocks.ProjectRepository
+	taskRepo        *mocks.TaskRepository
+	noteRepo        *mocks.NoteRepository
+	settingRepo     *mocks.SettingRepository
+	transactionRepo *mocks.TransactionRepository
+	useCase         UseCase
+}
+
+func (t *UseCaseTestSuite) SetupTest() {
+	t.formatRepo = new(mocks.FormatRepository)
+	t.projectRepo = new(mocks.ProjectRepository)
+	t.taskRepo = new(mocks.TaskRepository)
+	t.noteRepo = new(mocks.NoteRepository)
+	t.settingRepo = new(mocks.SettingRepository)
+	t.transactionRepo = new(mocks.TransactionRepository)
+	t.transactionRepo.On("WithinTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Maybe()
+	t.useCase = New(map[entity.TransferFormat]FormatRepository{
+		entity.TransferFormatTodoTxt: t.formatRepo,
+		entity.TransferFormatJSON:    t.formatRepo,
+	}, t.projectRepo, t.taskRepo, t.noteRepo, t.settingRepo, t.transactionRepo)
+}
+
+func TestUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(UseCaseTestSuite))
+}
+
+func (t *UseCaseTestSuite) TestImport() {
+	history := entity.TaskHistory{StartedAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), StoppedAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)}
+	laterHistory := entity.TaskHistory{StartedAt: time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), StoppedAt: time.Date(2024, 1, 2, 9, 30, 0, 0, time.UTC)}
+	storedProjects := entity.Projects{
+		{ID: "project-1", Name: "Home Improvement", IsSelected: true},
+		{ID: "project-2", Name: "Work", Integrations: []entity.Integration{{Type: entity.IntegrationTypeJIRA, Details: map[string]string{"url": "https://old.atlassian.net", "token": "secret:jira"}}}},
+	}
+	storedTasks := entity.Tasks{
+		{ID: "task-1", ProjectID: "project-2", Name: "Old name", Description: "kept", IsStarted: true, Histories: []entity.TaskHistory{history}, Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA}},
+	}
+
+	tests := []struct {
+		name           string
+		format         entity.TransferFormat
+		options        entity.ImportOptions
+		transfer       entity.Transfer
+		expectedResult entity.ImportResult
+		expectedErr    error
+		mockFunc       func()
+	}{
+		{
+			name:        "unsupported format",
+			format:      "any-format",
+			expectedErr: ErrUnsupportedFormat,
+			mockFunc:    func() {},
+		},
+		{
+			name:        "failed to decode",
+			format:      entity.TransferFormatTodoTxt,
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.formatRepo.On("Decode", mock.Anything, mock.Anything).Return(entity.Transfer{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:   "failed to insert project",
+			format: entity.TransferFormatTodoTxt,
+			transfer: entity.Transfer{
+				Projects: entity.Projects{{Name: "Garden"}},
+			},
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(storedProjects, nil).Once()
+				t.projectRepo.On("Insert", mock.Anything, mock.Anything).Return(errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:   "with sessions and notes",
+			format: entity.TransferFormatTodoTxt,
+			transfer: entity.Transfer{
+				Projects: entity.Projects{{Name: "Work"}},
+				Tasks: entity.Tasks{
+					{ID: "task-1", ProjectID: "Work", Name: "Old name", Histories: []entity.TaskHistory{history, laterHistory}},
+				},
+				Notes: entity.TaskNotes{
+					{TaskID: "task-1", Content: "stored", CreatedAt: history.StartedAt},
+					{TaskID: "task-1", Content: "new", CreatedAt: history.StoppedAt},
+					{TaskID: "unknown", Content: "orphan", CreatedAt: history.StoppedAt},
+				},
+			},
+			expectedResult: entity.ImportResult{UpdatedTasks: 1, AddedNotes: 1},
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(storedProjects, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(storedTasks, nil).Once()
+				t.taskRepo.On("Update", mock.Anything, mock.MatchedBy(func(task entity.Task) bool {
+					return task.ProjectID == "project-2" && len(task.Histories) == 2 && task.Histories[1].StartedAt.Equal(laterHistory.StartedAt)
+				})).Return(nil).Once()
+				t.noteRepo.On("GetAll", mock.Anything).Return(entity.TaskNotes{{ID: "note-1", TaskID: "task-1", Content: "stored", CreatedAt: history.StartedAt}}, nil).Once()
+				t.noteRepo.On("Insert", mock.Anything, mock.MatchedBy(func(note entity.TaskNote) bool {
+					return note.ID != "" && note.TaskID == "task-1" && note.Content == "new"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:    "restore dry run",
+			format:  entity.TransferFormatJSON,
+			options: entity.ImportOptions{DryRun: true},
+			transfer: entity.Transfer{
+				Projects: entity.Projects{
+					{ID: "project-2", Name: "Work", Integrations: []entity.Integration{{Type: entity.IntegrationTypeJIRA, Details: map[string]string{"url": "https://new.atlassian.net"}}}},
+					{ID: "project-3", Name: "Garden", IsSelected: true},
+				},
+				Tasks: entity.Tasks{
+					{ID: "task-1", ProjectID: "project-2", Name: "Old name", Description: "kept", IsStarted: true, Histories: []entity.TaskHistory{history, {StartedAt: laterHistory.StartedAt}}, Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA}},
+				},
+				TrackingSetting: &entity.TrackingSetting{MaxSession: time.Hour, IdleAfter: 15 * time.Minute},
+			},
+			expectedResult: entity.ImportResult{
+				AddedProjects:   1,
+				UpdatedProjects: 1,
+				UpdatedSettings: 1,
+				Conflicts: []entity.ImportConflict{
+					{Kind: entity.ImportConflictProject, ID: "project-2", Name: "Work", Fields: []string{"integrations"}},
+					{Kind: entity.ImportConflictSetting, Name: "tracking", Fields: []string{"max_session"}},
+				},
+			},
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(storedProjects, nil).Once()
+				t.projectRepo.On("Update", mock.Anything, entity.Project{
+					ID:           "project-2",
+					Name:         "Work",
+					Integrations: []entity.Integration{{Type: entity.IntegrationTypeJIRA, Details: map[string]string{"url": "https://new.atlassian.net", "token": "secret:jira"}}},
+				}).Return(nil).Once()
+				t.projectRepo.On("Insert", mock.Anything, entity.Project{ID: "project-3", Name: "Garden"}).Return(nil).Once()
+				// The running session is left out, nothing changes
+				t.taskRepo.On("GetAll", mock.Anything).Return(storedTasks, nil).Once()
+				t.settingRepo.On("GetTrackingSetting", mock.Anything).Return(entity.DefaultTrackingSetting(), nil).Once()
+				t.settingRepo.On("SetTrackingSetting", mock.Anything, entity.TrackingSetting{MaxSession: time.Hour, IdleAfter: 15 * time.Minute}).Return(nil).Once()
+			},
+		},
+		{
+			name:    "keep local",
+			format:  entity.TransferFormatJSON,
+			options: entity.ImportOptions{KeepLocal: true},
+			transfer: entity.Transfer{
+				Projects: entity.Projects{{ID: "project-2", Name: "Renamed"}},
+				Tasks: entity.Tasks{
+					{ID: "task-1", ProjectID: "project-2", Name: "New name", Histories: []entity.TaskHistory{laterHistory}},
+				},
+				TrackingSetting: &entity.TrackingSetting{AutoTrim: true},
+			},
+			expectedResult: entity.ImportResult{
+				UpdatedTasks: 1,
+				Conflicts: []entity.ImportConflict{
+					{Kind: entity.ImportConflictProject, ID: "project-2", Name: "Work", Fields: []string{"name", "integrations"}},
+					{Kind: entity.ImportConflictTask, ID: "task-1", Name: "Old name", Fields: []string{"name"}},
+					{Kind: entity.ImportConflictSetting, Name: "tracking", Fields: []string{"max_session", "auto_trim", "idle_after"}},
+				},
+			},
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(storedProjects, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(storedTasks, nil).Once()
+				// Only the new session is added
+				t.taskRepo.On("Update", mock.Anything, mock.MatchedBy(func(task entity.Task) bool {
+					return task.Name == "Old name" && len(task.Histories) == 2
+				})).Return(nil).Once()
+				t.settingRepo.On("GetTrackingSetting", mock.Anything).Return(entity.DefaultTrackingSetting(), nil).Once()
+			},
+		},
+		{
+			name:   "success",
+			format: entity.TransferFormatTodoTxt,
+			transfer: entity.Transfer{
+				Projects: entity.Projects{{Name: "home_improvement"}, {Name: "Garden"}, {Name: "Work"}},
+				Tasks: entity.Tasks{
+					{ID: "task-3", ProjectID: "Garden", Name: "Weed", ParentTaskID: "task-2"},
+					{ID: "task-2", ProjectID: "Garden", Name: "Garden work", Priority: "A", Tags: []string{"outside"}},
+					{ProjectID: "home_improvement", Name: "Paint", ParentTaskID: "unknown"},
+					{Name: "No project"},
+					{ID: "task-1", ProjectID: "Work", Name: "New name", CompletedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			expectedResult: entity.ImportResult{
+				AddedProjects: 1,
+				AddedTasks:    4,
+				UpdatedTasks:  1,
+				Conflicts:     []entity.ImportConflict{{Kind: entity.ImportConflictTask, ID: "task-1", Name: "Old name", Fields: []string{"name", "completed_at"}}},
+			},
+			mockFunc: func() {
+				var gardenID string
+				t.projectRepo.On("GetAll", mock.Anything).Return(storedProjects, nil).Once()
+				t.projectRepo.On("Insert", mock.Anything, mock.MatchedBy(func(project entity.Project) bool {
+					gardenID = project.ID
+					return project.Name == "Garden" && project.ID != ""
+				})).Return(nil).Once()
+				t.projectRepo.On("GetSelectedProject", mock.Anything).Return(storedProjects[0], nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(storedTasks, nil).Once()
+				t.taskRepo.On("Insert", mock.Anything, mock.MatchedBy(func(task entity.Task) bool {
+					return task.ID == "task-2" && task.ProjectID == gardenID && task.Priority == "A"
+				})).Return(nil).Once()
+				t.taskRepo.On("Insert", mock.Anything, mock.MatchedBy(func(task entity.Task) bool {
+					return task.ID != "" && task.Name == "Paint" && task.ProjectID == "project-1" && task.ParentTaskID == ""
+				})).Return(nil).Once()
+				t.taskRepo.On("Insert", mock.Anything, mock.MatchedBy(func(task entity.Task) bool {
+					return task.Name == "No project" && task.ProjectID == "project-1"
+				})).Return(nil).Once()
+				t.taskRepo.On("Update", mock.Anything, entity.Task{
+					ID:          "task-1",
+					ProjectID:   "project-2",
+					Name:        "New name",
+					Description: "kept",
+					IsStarted:   true,
+					CompletedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					Histories:   []entity.TaskHistory{history},
+					Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA},
+				}).Return(nil).Once()
+				t.taskRepo.On("Insert", mock.Anything, mock.MatchedBy(func(task entity.Task) bool {
+					return task.ID == "task-3" && task.ParentTaskID == "task-2" && task.ProjectID == gardenID
+				})).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			t.SetupTest()
+			test.mockFunc()
+			t.formatRepo.On("Decode", mock.Anything, mock.Anything).Return(test.transfer, nil).Maybe()
+
+			result, err := t.useCase.Import(context.Background(), test.format, strings.NewReader(""), test.options)
+
+			if test.expectedErr != nil {
+				t.ErrorContains(err, test.expectedErr.Error())
+			} else {
+				t.NoError(err)
+			}
+			t.Equal(test.expectedResult, result)
+			t.taskRepo.AssertExpectations(t.T())
+			t.projectRepo.AssertExpectations(t.T())
+			t.noteRepo.AssertExpectations(t.T())
+			t.settingRepo.AssertExpectations(t.T())
+		})
+	}
+}
+
+func (t *UseCaseTestSuite) TestExport() {
+	projects := entity.Projects{{ID: "project-1", Name: "Work", Integrations: []entity.Integration{
+		{Type: entity.IntegrationTypeJIRA, Details: map[string]string{"url": "https://any.atlassian.net", "token": "secret:jira"}},
+		{Type: "acme", Details: map[string]string{"file": "issues.json", "api_key": "secret:acme"}, SecretFields: []string{"api_key"}},
+	}}}
+	tasks := entity.Tasks{{ID: "task-1", ProjectID: "project-1", Name: "any-task"}}
+	trackingSetting := entity.DefaultTrackingSetting()
+	notes := entity.TaskNotes{{ID: "note-1", TaskID: "task-1", Content: "any-note"}}
+	tests := []struct {
+		name        string
+		format      entity.TransferFormat
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "unsupported format",
+			format:      "any-format",
+			expectedErr: ErrUnsupportedFormat,
+			mockFunc:    func() {},
+		},
+		{
+			name:        "failed to get tasks",
+			format:      entity.TransferFormatTodoTxt,
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(projects, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(nil, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:   "success",
+			format: entity.TransferFormatTodoTxt,
+			mockFunc: func() {
+				t.projectRepo.On("GetAll", mock.Anything).Return(projects, nil).Once()
+				t.taskRepo.On("GetAll", mock.Anything).Return(tasks, nil).Once()
+				t.noteRepo.On("GetAll", mock.Anything).Return(notes, nil).Once()
+				t.settingRepo.On("GetTrackingSetting", mock.Anything).Return(trackingSetting, nil).Once()
+				t.formatRepo.On("Encode", mock.Anything, mock.Anything, entity.Transfer{
+					Projects: entity.Projects{{ID: "project-1", Name: "Work", Integrations: []entity.Integration{
+						{Type: entity.IntegrationTypeJIRA, Details: map[string]string{"url": "https://any.atlassian.net"}},
+						{Type: "acme", Details: map[string]string{"file": "issues.json"}, SecretFields: []string{"api_key"}},
+					}}},
+					Tasks:           tasks,
+					Notes:           notes,
+					TrackingSetting: &trackingSetting,
+				}).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			t.SetupTest()
+			test.mockFunc()
+
+			err := t.useCase.Export(context.Background(), test.format, &bytes.Buffer{})
+
+			if test.expectedErr != nil {
+				t.ErrorContains(err, test.expectedErr.Error())
+			} else {
+				t.NoError(err)
+			}
+			t.formatRepo.AssertExpectations(t.T())
+		})
+	}
+}
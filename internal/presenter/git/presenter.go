@@ -0,0 +1,76 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/git"
+)
+
+type Presenter struct {
+	gitUseCase git.UseCase
+}
+
+func New(gitUseCase git.UseCase) *Presenter {
+	return &Presenter{
+		gitUseCase: gitUseCase,
+	}
+}
+
+func (p *Presenter) InstallHooks(ctx context.Context, force bool) error {
+	executable, err := os.Executable()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	paths, err := p.gitUseCase.InstallHooks(ctx, executable, force)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	for _, path := range paths {
+		fmt.Printf("Hook installed: %s\n", path)
+	}
+
+	return nil
+}
+
+// PrepareCommitMsg is called by the prepare-commit-msg hook with the path of
+// the message file and the source of the message.
+func (p *Presenter) PrepareCommitMsg(ctx context.Context, path, source string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	message, err := p.gitUseCase.PrepareCommitMessage(ctx, string(content), source)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if message == string(content) {
+		return nil
+	}
+
+	if err = os.WriteFile(path, []byte(message), 0644); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+// PostCommit is called by the post-commit hook.
+func (p *Presenter) PostCommit(ctx context.Context) error {
+	if err := p.gitUseCase.RecordCommit(ctx); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gitMocks "github.com/azisuazusa/todo-cli/internal/domain/git/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type PresenterTestSuite struct {
+	suite.Suite
+	gitUseCase *gitMocks.UseCase
+	presenter  *Presenter
+}
+
+func (t *PresenterTestSuite) SetupTest() {
+	t.gitUseCase = new(gitMocks.UseCase)
+	t.presenter = New(t.gitUseCase)
+}
+
+func TestPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(PresenterTestSuite))
+}
+
+func (t *PresenterTestSuite) TestPrepareCommitMsg() {
+	path := filepath.Join(t.T().TempDir(), "COMMIT_EDITMSG")
+	t.Require().NoError(os.WriteFile(path, []byte("any-message\n"), 0644))
+	t.gitUseCase.On("PrepareCommitMessage", context.Background(), "any-message\n", "message").Return("[TODO-1] any-message\n", nil).Once()
+
+	err := t.presenter.PrepareCommitMsg(context.Background(), path, "message")
+
+	t.NoError(err)
+	content, _ := os.ReadFile(path)
+	t.Equal("[TODO-1] any-message\n", string(content))
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration"
 	"github.com/azisuazusa/todo-cli/internal/domain/project"
 	"github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
 	"github.com/azisuazusa/todo-cli/internal/domain/task"
@@ -18,13 +20,15 @@ type Presenter struct {
 	projectUseCase         project.UseCase
 	syncintegrationUseCase syncintegration.UseCase
 	taskUseCase            task.UseCase
+	integrationUseCase     integration.UseCase
 }
 
-func New(projectUseCase project.UseCase, settingUseCase syncintegration.UseCase, taskUseCase task.UseCase) *Presenter {
+func New(projectUseCase project.UseCase, settingUseCase syncintegration.UseCase, taskUseCase task.UseCase, integrationUseCase integration.UseCase) *Presenter {
 	return &Presenter{
 		projectUseCase:         projectUseCase,
 		syncintegrationUseCase: settingUseCase,
 		taskUseCase:            taskUseCase,
+		integrationUseCase:     integrationUseCase,
 	}
 }
 
@@ -157,7 +161,10 @@ func (p *Presenter) Select(ctx context.Context) error {
 	}
 
 	// Makesure there is no task running before change project
-	if err = p.taskUseCase.Stop(ctx); err != nil && errors.Unwrap(err) != task.ErrTaskNotFound {
+	var publishErr *task.PublishError
+	if err = p.taskUseCase.Stop(ctx); errors.As(err, &publishErr) {
+		fmt.Printf("Hook error: %v\n", err)
+	} else if err != nil && errors.Unwrap(err) != task.ErrTaskNotFound {
 		fmt.Printf("Error: %v\n", err)
 		return err
 	}
@@ -189,50 +196,57 @@ func (p *Presenter) SyncTasks(ctx context.Context) error {
 }
 
 func (p *Presenter) AddIntegration(ctx context.Context) error {
+	definitions := p.integrationUseCase.GetDefinitions(ctx)
 	prompt := promptui.Select{
 		Label: "Select integration",
-		Items: []string{string(entity.IntegrationTypeJIRA)},
+		Items: definitions,
+		Templates: &promptui.SelectTemplates{
+			Active:   `▸ {{ .Type | cyan }}`,
+			Inactive: `  {{ .Type }}`,
+			Selected: `{{ "✔" | green }} {{ .Type | cyan }}`,
+			Details:  `{{ "Description:" }} {{ .Description }}`,
+		},
 	}
 
-	_, name, err := prompt.Run()
+	i, _, err := prompt.Run()
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		return err
 	}
 
-	integration := entity.Integration{
-		IsEnabled: true,
-		Type:      entity.IntegrationType(name),
-	}
-
-	if integration.Type == entity.IntegrationTypeJIRA {
-		details, errPrompt := p.jiraPrompt(ctx)
-		if errPrompt != nil {
-			err = errPrompt
-			fmt.Printf("Error: %v\n", err)
-			return err
+	definition := definitions[i]
+	details := map[string]string{}
+	for _, field := range definition.Fields {
+		if !field.IsShown(details) {
+			continue
 		}
 
-		integration.Details = details
-		if err = p.projectUseCase.AddIntegration(ctx, integration); err != nil {
+		value, errAsk := askIntegrationField(field)
+		if errAsk != nil {
+			err = errAsk
 			fmt.Printf("Error: %v\n", err)
 			return err
 		}
+
+		details[field.Key] = value
 	}
 
-	if integration.Type == entity.IntegrationTypeGitHub {
-		details, errPrompt := p.githubPrompt(ctx)
-		if errPrompt != nil {
-			err = errPrompt
-			fmt.Printf("Error: %v\n", err)
-			return err
-		}
+	details, err = p.integrationUseCase.Authorize(ctx, definition.Type, details, askIntegrationField)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
 
-		integration.Details = details
-		if err = p.projectUseCase.AddIntegration(ctx, integration); err != nil {
-			fmt.Printf("Error: %v\n", err)
-			return err
-		}
+	integration := entity.Integration{
+		IsEnabled:    true,
+		Type:         definition.Type,
+		Details:      details,
+		SecretFields: definition.SecretFields(),
+	}
+
+	if err = p.projectUseCase.AddIntegration(ctx, integration); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
 	}
 
 	if err = p.syncintegrationUseCase.Upload(ctx); err != nil {
@@ -245,59 +259,43 @@ func (p *Presenter) AddIntegration(ctx context.Context) error {
 	return nil
 }
 
-func (p *Presenter) jiraPrompt(ctx context.Context) (map[string]string, error) {
-	prompt := promptui.Prompt{
-		Label: "JIRA URL",
-	}
-
-	url, err := prompt.Run()
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		return nil, err
+// askIntegrationField prompts the field the way its type asks for.
+func askIntegrationField(field entity.IntegrationField) (string, error) {
+	if field.Help != "" {
+		fmt.Println(field.Help)
 	}
 
-	prompt = promptui.Prompt{
-		Label: "JIRA Username",
-	}
+	if field.Type == entity.IntegrationFieldSelect {
+		prompt := promptui.Select{
+			Label: field.Label,
+			Items: field.Options,
+		}
 
-	username, err := prompt.Run()
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		return nil, err
+		_, value, err := prompt.Run()
+		return value, err
 	}
 
-	prompt = promptui.Prompt{
-		Label: "JIRA Token",
+	prompt := promptui.Prompt{
+		Label:   field.Label,
+		Default: field.Default,
 	}
 
-	token, err := prompt.Run()
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		return nil, err
+	if field.Type == entity.IntegrationFieldSecret {
+		prompt.Mask = '*'
 	}
 
-	prompt = promptui.Prompt{
-		Label:   "JIRA JQL",
-		Default: "assignee = currentUser() AND resolution = Unresolved",
-	}
+	if field.Type == entity.IntegrationFieldDuration {
+		prompt.Validate = func(input string) error {
+			if input == "" {
+				return nil
+			}
 
-	jql, err := prompt.Run()
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		return nil, err
+			_, err := time.ParseDuration(input)
+			return err
+		}
 	}
 
-	return map[string]string{
-		"url":      url,
-		"username": username,
-		"token":    token,
-		"jql":      jql,
-	}, nil
-}
-
-func (p *Presenter) githubPrompt(ctx context.Context) (map[string]string, error) {
-	// TODO: implement github integration
-	return nil, nil
+	return prompt.Run()
 }
 
 func (p *Presenter) RemoveIntegration(ctx context.Context) (err error) {
@@ -7,6 +7,7 @@ import (
 	"os"
 	"testing"
 
+	integrationMocks "github.com/azisuazusa/todo-cli/internal/domain/integration/mocks"
 	projectMocks "github.com/azisuazusa/todo-cli/internal/domain/project/mocks"
 	synintegrationMocks "github.com/azisuazusa/todo-cli/internal/domain/syncintegration/mocks"
 	taskMocks "github.com/azisuazusa/todo-cli/internal/domain/task/mocks"
@@ -18,6 +19,7 @@ type PresenterTestSuite struct {
 	projectUseCase         *projectMocks.UseCase
 	syncintegrationUseCase *synintegrationMocks.UseCase
 	taskUseCase            *taskMocks.UseCase
+	integrationUseCase     *integrationMocks.UseCase
 	presenter              *Presenter
 }
 
@@ -25,7 +27,8 @@ func (t *PresenterTestSuite) SetupTest() {
 	t.projectUseCase = new(projectMocks.UseCase)
 	t.syncintegrationUseCase = new(synintegrationMocks.UseCase)
 	t.taskUseCase = new(taskMocks.UseCase)
-	t.presenter = New(t.projectUseCase, t.syncintegrationUseCase, t.taskUseCase)
+	t.integrationUseCase = new(integrationMocks.UseCase)
+	t.presenter = New(t.projectUseCase, t.syncintegrationUseCase, t.taskUseCase, t.integrationUseCase)
 }
 
 func TestPresenterTestSuite(t *testing.T) {
@@ -0,0 +1,58 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/report"
+)
+
+type Presenter struct {
+	reportUseCase report.UseCase
+}
+
+func New(reportUseCase report.UseCase) *Presenter {
+	return &Presenter{
+		reportUseCase: reportUseCase,
+	}
+}
+
+// Export reports on the week containing the given date, e.g. 2024-01-08, or on
+// the current week when it is empty. It writes to the output file, or to the
+// standard output when it is empty.
+func (p *Presenter) Export(ctx context.Context, format entity.ReportFormat, projectName, week, output string) error {
+	options := entity.ReportOptions{ProjectName: projectName}
+	if week != "" {
+		date, err := time.ParseInLocation("2006-01-02", week, time.Local)
+		if err != nil {
+			err = fmt.Errorf("invalid week %q, expected a date such as 2024-01-08", week)
+			fmt.Printf("Error: %v\n", err)
+			return err
+		}
+
+		options.Week = date
+	}
+
+	var w io.Writer = os.Stdout
+	if output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return err
+		}
+		defer file.Close()
+
+		w = file
+	}
+
+	if err := p.reportUseCase.Export(ctx, format, options, w); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package report
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	reportMocks "github.com/azisuazusa/todo-cli/internal/domain/report/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PresenterTestSuite struct {
+	suite.Suite
+	reportUseCase *reportMocks.UseCase
+	presenter     *Presenter
+}
+
+func (t *PresenterTestSuite) SetupTest() {
+	t.reportUseCase = new(reportMocks.UseCase)
+	t.presenter = New(t.reportUseCase)
+}
+
+func TestPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(PresenterTestSuite))
+}
+
+func (t *PresenterTestSuite) TestExport() {
+	path := filepath.Join(t.T().TempDir(), "report.md")
+	options := entity.ReportOptions{ProjectName: "Work", Week: time.Date(2024, 1, 8, 0, 0, 0, 0, time.Local)}
+	t.reportUseCase.On("Export", context.Background(), entity.ReportFormatMarkdown, options, mock.Anything).Return(func(ctx context.Context, format entity.ReportFormat, options entity.ReportOptions, w io.Writer) error {
+		_, err := io.WriteString(w, "# Work\n")
+		return err
+	}).Once()
+
+	err := t.presenter.Export(context.Background(), entity.ReportFormatMarkdown, "Work", "2024-01-08", path)
+
+	t.NoError(err)
+	content, _ := os.ReadFile(path)
+	t.Equal("# Work\n", string(content))
+}
+
+func (t *PresenterTestSuite) TestExportInvalidWeek() {
+	err := t.presenter.Export(context.Background(), entity.ReportFormatMarkdown, "", "last week", "")
+
+	t.ErrorContains(err, `invalid week "last week"`)
+	t.reportUseCase.AssertNotCalled(t.T(), "Export", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -60,7 +60,6 @@ func (p *Presenter) SetSyncIntegration(ctx context.Context) error {
 		integration.Details["refresh_token"] = token.RefreshToken
 		integration.Details["token_type"] = token.TokenType
 		integration.Details["expires_in"] = fmt.Sprintf("%d", token.ExpiresIn)
-		fmt.Println("refresh_token: ", token.RefreshToken)
 	}
 
 	if err = p.settingUseCase.SetSyncIntegration(ctx, integration); err != nil {
@@ -162,6 +161,111 @@ func (p *Presenter) Sync(ctx context.Context) error {
 
 }
 
+func (p *Presenter) SetSyncEncryption(ctx context.Context) error {
+	encryption, err := encryptionPrompt()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if err = p.settingUseCase.SetSyncEncryption(ctx, encryption); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	fmt.Println("Sync encryption set successfully!")
+	return nil
+}
+
+func (p *Presenter) RotateSyncEncryption(ctx context.Context) error {
+	encryption, err := encryptionPrompt()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if err = p.settingUseCase.RotateSyncEncryption(ctx, encryption); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	fmt.Println("Sync encryption key rotated successfully! Set the new key on your other devices with `todo setting sync-encryption`.")
+	return nil
+}
+
+func encryptionPrompt() (map[string]string, error) {
+	prompt := promptui.Select{
+		Label: "Encryption",
+		Items: []string{"Passphrase", "Key file", "Disabled"},
+	}
+
+	_, result, err := prompt.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	if result == "Disabled" {
+		return map[string]string{}, nil
+	}
+
+	if result == "Key file" {
+		keyFilePrompt := promptui.Prompt{
+			Label: "Key file path",
+		}
+
+		keyFile, err := keyFilePrompt.Run()
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			syncintegration.EncryptionDetailKey:        syncintegration.EncryptionKeyFile,
+			syncintegration.EncryptionKeyFileDetailKey: keyFile,
+		}, nil
+	}
+
+	passphrasePrompt := promptui.Prompt{
+		Label: "Passphrase",
+		Mask:  '*',
+	}
+
+	passphrase, err := passphrasePrompt.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	confirmPrompt := promptui.Prompt{
+		Label: "Confirm passphrase",
+		Mask:  '*',
+		Validate: func(input string) error {
+			if input != passphrase {
+				return errors.New("passphrases do not match")
+			}
+
+			return nil
+		},
+	}
+
+	if _, err = confirmPrompt.Run(); err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		syncintegration.EncryptionDetailKey:           syncintegration.EncryptionPassphrase,
+		syncintegration.EncryptionPassphraseDetailKey: passphrase,
+	}, nil
+}
+
+func (p *Presenter) SecureCredentials(ctx context.Context) error {
+	if err := p.settingUseCase.SecureCredentials(ctx); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	fmt.Println("Credentials moved to the secret store!")
+	return nil
+}
+
 func calculateChecksum(file []byte) (string, error) {
 	hash := sha256.New()
 	if _, err := io.Copy(hash, bytes.NewReader(file)); err != nil {
@@ -0,0 +1,55 @@
+package standup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/standup"
+)
+
+type Presenter struct {
+	standupUseCase standup.UseCase
+}
+
+func New(standupUseCase standup.UseCase) *Presenter {
+	return &Presenter{
+		standupUseCase: standupUseCase,
+	}
+}
+
+// Standup prints the standup of the given date, e.g. 2024-01-08, or of today
+// when it is empty, and posts it to the standup webhooks when post is set.
+func (p *Presenter) Standup(ctx context.Context, projectName, date string, post bool) error {
+	options := entity.StandupOptions{ProjectName: projectName}
+	if date != "" {
+		day, err := time.ParseInLocation("2006-01-02", date, time.Local)
+		if err != nil {
+			err = fmt.Errorf("invalid date %q, expected a date such as 2024-01-08", date)
+			fmt.Printf("Error: %v\n", err)
+			return err
+		}
+
+		options.Date = day
+	}
+
+	text, err := p.standupUseCase.Generate(ctx, options)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	fmt.Print(text)
+	if !post {
+		return nil
+	}
+
+	if err = p.standupUseCase.Post(ctx, text); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	fmt.Println("Standup posted.")
+	return nil
+}
@@ -0,0 +1,54 @@
+package standup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	standupMocks "github.com/azisuazusa/todo-cli/internal/domain/standup/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PresenterTestSuite struct {
+	suite.Suite
+	standupUseCase *standupMocks.UseCase
+	presenter      *Presenter
+}
+
+func (t *PresenterTestSuite) SetupTest() {
+	t.standupUseCase = new(standupMocks.UseCase)
+	t.presenter = New(t.standupUseCase)
+}
+
+func TestPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(PresenterTestSuite))
+}
+
+func (t *PresenterTestSuite) TestStandup() {
+	options := entity.StandupOptions{ProjectName: "Work", Date: time.Date(2024, 1, 8, 0, 0, 0, 0, time.Local)}
+	t.standupUseCase.On("Generate", context.Background(), options).Return("any-standup", nil).Once()
+
+	err := t.presenter.Standup(context.Background(), "Work", "2024-01-08", false)
+
+	t.NoError(err)
+	t.standupUseCase.AssertNotCalled(t.T(), "Post", mock.Anything, mock.Anything)
+}
+
+func (t *PresenterTestSuite) TestStandupPost() {
+	t.standupUseCase.On("Generate", context.Background(), entity.StandupOptions{}).Return("any-standup", nil).Once()
+	t.standupUseCase.On("Post", context.Background(), "any-standup").Return(errors.New("any-error")).Once()
+
+	err := t.presenter.Standup(context.Background(), "", "", true)
+
+	t.EqualError(err, "any-error")
+}
+
+func (t *PresenterTestSuite) TestStandupInvalidDate() {
+	err := t.presenter.Standup(context.Background(), "", "yesterday", false)
+
+	t.ErrorContains(err, `invalid date "yesterday"`)
+	t.standupUseCase.AssertNotCalled(t.T(), "Generate", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,171 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	FormatPlain    = "plain"
+	FormatJSON     = "json"
+	FormatTmux     = "tmux"
+	FormatI3bar    = "i3bar"
+	FormatWaybar   = "waybar"
+	FormatStarship = "starship"
+)
+
+var Formats = []string{FormatPlain, FormatJSON, FormatTmux, FormatI3bar, FormatWaybar, FormatStarship}
+
+var formatters = map[string]func(view StatusView) (string, error){
+	FormatPlain:    formatPlain,
+	FormatJSON:     formatJSON,
+	FormatTmux:     formatTmux,
+	FormatI3bar:    formatI3bar,
+	FormatWaybar:   formatWaybar,
+	FormatStarship: formatStarship,
+}
+
+func formatPlain(view StatusView) (string, error) {
+	if !view.IsStarted {
+		return "", nil
+	}
+
+	return fmt.Sprintf("%s %s%s", view.Label, formatClock(view.Elapsed), pomodoroSuffix(view)), nil
+}
+
+func formatJSON(view StatusView) (string, error) {
+	model := JSONModel{
+		IsStarted:      view.IsStarted,
+		ID:             view.ID,
+		Name:           view.Name,
+		IntegrationID:  view.IntegrationID,
+		ElapsedSeconds: int64(view.Elapsed.Seconds()),
+		IsPaused:       view.IsPaused,
+		PauseReason:    view.PauseReason,
+		Interruptions:  view.Interruptions,
+	}
+
+	if view.IsStarted && view.Pomodoro > 0 {
+		remaining := int64(view.PomodoroRemaining.Seconds())
+		model.PomodoroRemainingSeconds = &remaining
+	}
+
+	return marshal(model)
+}
+
+// formatTmux escapes # so a task name can not inject tmux styles.
+func formatTmux(view StatusView) (string, error) {
+	if !view.IsStarted {
+		return "", nil
+	}
+
+	color := "yellow"
+	if view.IsPaused {
+		color = "blue"
+	} else if view.IsPomodoroDone() {
+		color = "red"
+	}
+
+	text := fmt.Sprintf("%s %s%s", view.Label, formatClock(view.Elapsed), pomodoroSuffix(view))
+	return fmt.Sprintf("#[fg=%s]%s#[default]", color, strings.ReplaceAll(text, "#", "##")), nil
+}
+
+func formatI3bar(view StatusView) (string, error) {
+	model := I3barModel{Name: "todo"}
+	if view.IsStarted {
+		model.FullText = fmt.Sprintf("%s %s%s", view.Label, formatClock(view.Elapsed), pomodoroSuffix(view))
+		model.ShortText = formatClock(view.Elapsed)
+		model.Color = "#ffcc00"
+		if view.IsPaused {
+			model.Color = "#8be9fd"
+		} else if view.IsPomodoroDone() {
+			model.Color = "#ff5555"
+			model.Urgent = true
+		}
+	}
+
+	return marshal(model)
+}
+
+func formatWaybar(view StatusView) (string, error) {
+	model := WaybarModel{Class: "idle"}
+	if view.IsStarted {
+		model.Text = fmt.Sprintf("%s %s", view.Label, formatClock(view.Elapsed))
+		model.Tooltip = view.Name + pomodoroSuffix(view)
+		model.Class = "started"
+		if view.Pomodoro > 0 {
+			model.Percentage = int(100 - view.PomodoroRemaining*100/view.Pomodoro)
+		}
+		if view.IsPaused {
+			model.Class = "paused"
+		} else if view.IsPomodoroDone() {
+			model.Class = "pomodoro-done"
+		}
+	}
+
+	return marshal(model)
+}
+
+// formatStarship keeps the output short enough for a prompt segment.
+func formatStarship(view StatusView) (string, error) {
+	if !view.IsStarted {
+		return "", nil
+	}
+
+	if view.IsPaused {
+		return fmt.Sprintf("%s paused", view.Label), nil
+	}
+
+	if view.Pomodoro > 0 {
+		return fmt.Sprintf("%s %s", view.Label, formatClock(view.PomodoroRemaining)), nil
+	}
+
+	return fmt.Sprintf("%s %s", view.Label, formatClock(view.Elapsed)), nil
+}
+
+func pomodoroSuffix(view StatusView) string {
+	if view.IsPaused {
+		if view.PauseReason != "" {
+			return fmt.Sprintf(" (paused: %s)", view.PauseReason)
+		}
+
+		return " (paused)"
+	}
+
+	if view.Pomodoro == 0 {
+		return ""
+	}
+
+	var interruptions string
+	if view.Interruptions == 1 {
+		interruptions = ", 1 interruption"
+	} else if view.Interruptions > 1 {
+		interruptions = fmt.Sprintf(", %d interruptions", view.Interruptions)
+	}
+
+	if view.IsPomodoroDone() {
+		return fmt.Sprintf(" (pomodoro done%s)", interruptions)
+	}
+
+	return fmt.Sprintf(" (pomodoro %s left%s)", formatClock(view.PomodoroRemaining), interruptions)
+}
+
+func formatClock(d time.Duration) string {
+	d = d.Round(time.Second)
+	if d < time.Hour {
+		return fmt.Sprintf("%02d:%02d", int(d.Minutes()), int(d.Seconds())%60)
+	}
+
+	return fmt.Sprintf("%d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+}
+
+func marshal(model any) (string, error) {
+	content, err := json.Marshal(model)
+	if err != nil {
+		return "", fmt.Errorf("error while encoding status: %w", err)
+	}
+
+	return string(content), nil
+}
@@ -0,0 +1,65 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/task"
+)
+
+// STATUS_BUDGET bounds the database lookup, status bars and prompts call this
+// command all the time and must never hang on it.
+const STATUS_BUDGET = 20 * time.Millisecond
+
+var ErrUnsupportedFormat = errors.New("unsupported status format")
+
+type Presenter struct {
+	taskUseCase task.UseCase
+	now         func() time.Time
+}
+
+func New(taskUseCase task.UseCase) *Presenter {
+	return &Presenter{
+		taskUseCase: taskUseCase,
+		now:         time.Now,
+	}
+}
+
+// Status prints the started task in the given format. It only reads the local
+// database, and a lookup running over STATUS_BUDGET is shown as idle.
+func (p *Presenter) Status(ctx context.Context, format string, pomodoro time.Duration) error {
+	render, ok := formatters[format]
+	if !ok {
+		err := fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, STATUS_BUDGET)
+	defer cancel()
+
+	startedTask, err := p.taskUseCase.GetStarted(ctx)
+	if err != nil && !errors.Is(err, task.ErrTaskNotFound) && ctx.Err() == nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if err != nil {
+		startedTask = entity.Task{}
+	}
+
+	output, err := render(CreateStatusView(startedTask, p.now(), pomodoro))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if output != "" {
+		fmt.Println(output)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/task"
+	taskMocks "github.com/azisuazusa/todo-cli/internal/domain/task/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PresenterTestSuite struct {
+	suite.Suite
+	taskUseCase *taskMocks.UseCase
+	presenter   *Presenter
+}
+
+func (t *PresenterTestSuite) SetupTest() {
+	t.taskUseCase = new(taskMocks.UseCase)
+	t.presenter = New(t.taskUseCase)
+	t.presenter.now = func() time.Time {
+		return time.Date(2020, 1, 1, 1, 10, 0, 0, time.UTC)
+	}
+}
+
+func TestPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(PresenterTestSuite))
+}
+
+func (t *PresenterTestSuite) TestStatus() {
+	startedTask := entity.Task{
+		ID:        "task-1",
+		Name:      "Fix #1 login",
+		IsStarted: true,
+		Integration: entity.TaskIntegration{
+			ID:   "TODO-1",
+			Type: entity.IntegrationTypeJIRA,
+		},
+		Histories: []entity.TaskHistory{
+			{
+				StartedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				StoppedAt: time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC),
+			},
+			{
+				StartedAt: time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	interruptedTask := startedTask
+	interruptedTask.Histories = []entity.TaskHistory{
+		startedTask.Histories[0],
+		{
+			StartedAt: time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC),
+			Pauses:    []entity.TaskPause{{StartedAt: time.Date(2020, 1, 1, 1, 2, 0, 0, time.UTC), StoppedAt: time.Date(2020, 1, 1, 1, 5, 0, 0, time.UTC)}},
+		},
+	}
+	pausedTask := startedTask
+	pausedTask.Histories = []entity.TaskHistory{
+		startedTask.Histories[0],
+		{
+			StartedAt: time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC),
+			Pauses:    []entity.TaskPause{{StartedAt: time.Date(2020, 1, 1, 1, 5, 0, 0, time.UTC), Reason: "lunch"}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		format      string
+		pomodoro    time.Duration
+		expectedRes string
+		expectedErr error
+		mockFunc    func()
+	}{
+		{
+			name:        "unsupported format",
+			format:      "any-format",
+			expectedRes: "Error: unsupported status format: any-format\n",
+			expectedErr: ErrUnsupportedFormat,
+			mockFunc:    func() {},
+		},
+		{
+			name:        "failed to get started task",
+			format:      FormatPlain,
+			expectedRes: "Error: any-error\n",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(entity.Task{}, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:        "no started task",
+			format:      FormatPlain,
+			expectedRes: "",
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(entity.Task{}, task.ErrTaskNotFound).Once()
+			},
+		},
+		{
+			name:        "no started task in json",
+			format:      FormatJSON,
+			expectedRes: "{\"is_started\":false,\"elapsed_seconds\":0}\n",
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(entity.Task{}, task.ErrTaskNotFound).Once()
+			},
+		},
+		{
+			name:        "plain",
+			format:      FormatPlain,
+			pomodoro:    25 * time.Minute,
+			expectedRes: "TODO-1 Fix #1 login 1:10:00 (pomodoro 15:00 left)\n",
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(startedTask, nil).Once()
+			},
+		},
+		{
+			name:        "plain with interruptions",
+			format:      FormatPlain,
+			pomodoro:    25 * time.Minute,
+			expectedRes: "TODO-1 Fix #1 login 1:07:00 (pomodoro 18:00 left, 1 interruption)\n",
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(interruptedTask, nil).Once()
+			},
+		},
+		{
+			name:        "paused",
+			format:      FormatWaybar,
+			pomodoro:    20 * time.Minute,
+			expectedRes: "{\"text\":\"TODO-1 Fix #1 login 1:05:00\",\"tooltip\":\"Fix #1 login (paused: lunch)\",\"class\":\"paused\",\"percentage\":25}\n",
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(pausedTask, nil).Once()
+			},
+		},
+		{
+			name:        "paused in json",
+			format:      FormatJSON,
+			expectedRes: "{\"is_started\":true,\"id\":\"task-1\",\"name\":\"Fix #1 login\",\"integration_id\":\"TODO-1\",\"elapsed_seconds\":3900,\"is_paused\":true,\"pause_reason\":\"lunch\",\"interruptions\":1}\n",
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(pausedTask, nil).Once()
+			},
+		},
+		{
+			name:        "json",
+			format:      FormatJSON,
+			pomodoro:    25 * time.Minute,
+			expectedRes: "{\"is_started\":true,\"id\":\"task-1\",\"name\":\"Fix #1 login\",\"integration_id\":\"TODO-1\",\"elapsed_seconds\":4200,\"pomodoro_remaining_seconds\":900}\n",
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(startedTask, nil).Once()
+			},
+		},
+		{
+			name:        "tmux",
+			format:      FormatTmux,
+			pomodoro:    5 * time.Minute,
+			expectedRes: "#[fg=red]TODO-1 Fix ##1 login 1:10:00 (pomodoro done)#[default]\n",
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(startedTask, nil).Once()
+			},
+		},
+		{
+			name:        "i3bar",
+			format:      FormatI3bar,
+			expectedRes: "{\"name\":\"todo\",\"full_text\":\"TODO-1 Fix #1 login 1:10:00\",\"short_text\":\"1:10:00\",\"color\":\"#ffcc00\"}\n",
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(startedTask, nil).Once()
+			},
+		},
+		{
+			name:        "waybar",
+			format:      FormatWaybar,
+			pomodoro:    20 * time.Minute,
+			expectedRes: "{\"text\":\"TODO-1 Fix #1 login 1:10:00\",\"tooltip\":\"Fix #1 login (pomodoro 10:00 left)\",\"class\":\"started\",\"percentage\":50}\n",
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(startedTask, nil).Once()
+			},
+		},
+		{
+			name:        "starship",
+			format:      FormatStarship,
+			pomodoro:    25 * time.Minute,
+			expectedRes: "TODO-1 Fix #1 login 15:00\n",
+			mockFunc: func() {
+				t.taskUseCase.On("GetStarted", mock.Anything).Return(startedTask, nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.mockFunc()
+
+			originalStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			err := t.presenter.Status(context.Background(), test.format, test.pomodoro)
+
+			w.Close()
+			os.Stdout = originalStdout
+			output, _ := io.ReadAll(r)
+
+			t.Equal(test.expectedRes, string(output))
+			if test.expectedErr == ErrUnsupportedFormat {
+				t.ErrorIs(err, ErrUnsupportedFormat)
+				return
+			}
+			t.Equal(test.expectedErr, err)
+		})
+	}
+}
+
+func (t *PresenterTestSuite) TestStatusOverBudget() {
+	t.taskUseCase.On("GetStarted", mock.Anything).Return(func(ctx context.Context) (entity.Task, error) {
+		<-ctx.Done()
+		return entity.Task{}, ctx.Err()
+	}).Once()
+
+	start := time.Now()
+	err := t.presenter.Status(context.Background(), FormatPlain, 0)
+
+	t.NoError(err)
+	t.Less(time.Since(start), STATUS_BUDGET+10*time.Millisecond)
+}
@@ -0,0 +1,106 @@
+package status
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+const maxNameLength = 30
+
+type StatusView struct {
+	IsStarted         bool
+	ID                string
+	Name              string
+	IntegrationID     string
+	Label             string
+	Elapsed           time.Duration
+	Pomodoro          time.Duration
+	PomodoroRemaining time.Duration
+	IsPaused          bool
+	PauseReason       string
+	Interruptions     int
+}
+
+// CreateStatusView describes the started task at now. An empty task is shown
+// as idle, and a zero pomodoro turns the pomodoro countdown off. Pauses hold
+// the countdown and count as interruptions of the pomodoro.
+func CreateStatusView(t entity.Task, now time.Time, pomodoro time.Duration) StatusView {
+	if !t.IsStarted {
+		return StatusView{}
+	}
+
+	name := []rune(t.Name)
+	if len(name) > maxNameLength {
+		name = append(name[:maxNameLength-1], '…')
+	}
+
+	label := string(name)
+	if t.Integration.ID != "" {
+		label = fmt.Sprintf("%s %s", t.Integration.ID, label)
+	}
+
+	var remaining time.Duration
+	if pomodoro > 0 {
+		remaining = pomodoro - t.CurrentSession(now)
+		if remaining < 0 {
+			remaining = 0
+		}
+	}
+
+	view := StatusView{
+		IsStarted:         true,
+		ID:                t.ID,
+		Name:              t.Name,
+		IntegrationID:     t.Integration.ID,
+		Label:             label,
+		Elapsed:           t.Elapsed(now),
+		Pomodoro:          pomodoro,
+		PomodoroRemaining: remaining,
+		IsPaused:          t.IsPaused(),
+	}
+
+	if len(t.Histories) > 0 {
+		pauses := t.Histories[len(t.Histories)-1].Pauses
+		view.Interruptions = len(pauses)
+		if view.IsPaused {
+			view.PauseReason = pauses[len(pauses)-1].Reason
+		}
+	}
+
+	return view
+}
+
+func (s StatusView) IsPomodoroDone() bool {
+	return s.Pomodoro > 0 && s.PomodoroRemaining == 0
+}
+
+type JSONModel struct {
+	IsStarted                bool   `json:"is_started"`
+	ID                       string `json:"id,omitempty"`
+	Name                     string `json:"name,omitempty"`
+	IntegrationID            string `json:"integration_id,omitempty"`
+	ElapsedSeconds           int64  `json:"elapsed_seconds"`
+	PomodoroRemainingSeconds *int64 `json:"pomodoro_remaining_seconds,omitempty"`
+	IsPaused                 bool   `json:"is_paused,omitempty"`
+	PauseReason              string `json:"pause_reason,omitempty"`
+	Interruptions            int    `json:"interruptions,omitempty"`
+}
+
+// I3barModel is a block of the i3bar protocol.
+type I3barModel struct {
+	Name      string `json:"name"`
+	FullText  string `json:"full_text"`
+	ShortText string `json:"short_text,omitempty"`
+	Color     string `json:"color,omitempty"`
+	Urgent    bool   `json:"urgent,omitempty"`
+}
+
+// WaybarModel is the output of a waybar custom module with return-type json.
+type WaybarModel struct {
+	Text       string `json:"text"`
+	Tooltip    string `json:"tooltip"`
+	Class      string `json:"class"`
+	Percentage int    `json:"percentage"`
+}
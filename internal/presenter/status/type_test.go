@@ -0,0 +1,38 @@
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateStatusView(t *testing.T) {
+	paramTask := entity.Task{
+		ID:        "task-1",
+		Name:      "A very long task name that does not fit in a status bar",
+		IsStarted: true,
+		Histories: []entity.TaskHistory{
+			{
+				StartedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	expected := StatusView{
+		IsStarted:         true,
+		ID:                "task-1",
+		Name:              "A very long task name that does not fit in a status bar",
+		Label:             "A very long task name that do…",
+		Elapsed:           30 * time.Minute,
+		Pomodoro:          25 * time.Minute,
+		PomodoroRemaining: 0,
+	}
+
+	res := CreateStatusView(paramTask, time.Date(2020, 1, 1, 0, 30, 0, 0, time.UTC), 25*time.Minute)
+
+	assert.Equal(t, expected, res)
+	assert.True(t, res.IsPomodoroDone())
+	assert.Equal(t, StatusView{}, CreateStatusView(entity.Task{}, time.Now(), 25*time.Minute))
+}
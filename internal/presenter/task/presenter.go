@@ -2,30 +2,40 @@ package task
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration"
 	"github.com/azisuazusa/todo-cli/internal/domain/jira"
+	"github.com/azisuazusa/todo-cli/internal/domain/slack"
 	"github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
 	"github.com/azisuazusa/todo-cli/internal/domain/task"
 	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/manifoldco/promptui"
+	"golang.org/x/term"
 )
 
 type Presenter struct {
-	taskUseCase    task.UseCase
-	settingUseCase syncintegration.UseCase
-	jiraUseCase    jira.UseCase
+	taskUseCase        task.UseCase
+	settingUseCase     syncintegration.UseCase
+	jiraUseCase        jira.UseCase
+	slackUseCase       slack.UseCase
+	integrationUseCase integration.UseCase
 }
 
-func New(taskUseCase task.UseCase, settingUseCase syncintegration.UseCase, jiraUseCase jira.UseCase) *Presenter {
+func New(taskUseCase task.UseCase, settingUseCase syncintegration.UseCase, jiraUseCase jira.UseCase, slackUseCase slack.UseCase, integrationUseCase integration.UseCase) *Presenter {
 	return &Presenter{
-		taskUseCase:    taskUseCase,
-		settingUseCase: settingUseCase,
-		jiraUseCase:    jiraUseCase,
+		taskUseCase:        taskUseCase,
+		settingUseCase:     settingUseCase,
+		jiraUseCase:        jiraUseCase,
+		slackUseCase:       slackUseCase,
+		integrationUseCase: integrationUseCase,
 	}
 }
 
@@ -44,7 +54,9 @@ func (p *Presenter) GetUncompleteTasks(ctx context.Context) error {
 	taskNumber := 1
 	for _, task := range tasks {
 		isStarted := ""
-		if task.IsStarted {
+		if task.IsPaused() {
+			isStarted = "Paused"
+		} else if task.IsStarted {
 			isStarted = "Started"
 		}
 
@@ -89,12 +101,28 @@ func (p *Presenter) Add(ctx context.Context) error {
 		return err
 	}
 
+	prompt = promptui.Prompt{
+		Label: "Due date (2006-01-02, empty for none)",
+		Validate: func(input string) error {
+			_, err := parseDueDate(input, time.Now())
+			return err
+		},
+	}
+
+	due, err := prompt.Run()
+	if err != nil {
+		fmt.Printf("Prompt failed %v\n", err)
+		return err
+	}
+
+	dueAt, _ := parseDueDate(due, time.Now())
 	task := entity.Task{
 		Name:        name,
 		Description: description,
+		DueAt:       dueAt,
 	}
 
-	if err = p.taskUseCase.Add(ctx, task); err != nil {
+	if err = p.taskUseCase.Add(ctx, task); err != nil && !reportPublishError(err) {
 		fmt.Printf("Error: %v\n", err)
 		return err
 	}
@@ -167,7 +195,7 @@ func (p *Presenter) AddSubTask(ctx context.Context) error {
 		ParentTaskID: parentTasks[parentTaskIndex].ID,
 	}
 
-	if err = p.taskUseCase.Add(ctx, task); err != nil {
+	if err = p.taskUseCase.Add(ctx, task); err != nil && !reportPublishError(err) {
 		fmt.Printf("Error: %v\n", err)
 		return err
 	}
@@ -214,13 +242,8 @@ func (p *Presenter) Start(ctx context.Context) error {
 		return err
 	}
 
-	// Makesure that no other task is running
-	if err = p.taskUseCase.Stop(ctx); err != nil && errors.Unwrap(err) != task.ErrTaskNotFound {
-		fmt.Printf("Error: %v\n", err)
-		return err
-	}
-
-	if err = p.taskUseCase.Start(ctx, tasks[taskIndex].ID); err != nil {
+	// Start stops the running task in the same transaction
+	if err = p.taskUseCase.Start(ctx, tasks[taskIndex].ID); err != nil && !reportPublishError(err) {
 		fmt.Printf("Error: %v\n", err)
 		return err
 	}
@@ -230,6 +253,18 @@ func (p *Presenter) Start(ctx context.Context) error {
 		return err
 	}
 
+	if err := p.slackUseCase.SetStatus(ctx, tasks[taskIndex]); err != nil {
+		fmt.Printf("Slack error: %v\n", err)
+	}
+
+	definition, _ := p.integrationUseCase.GetDefinition(ctx, tasks[taskIndex].Integration.Type)
+	if definition.Capabilities.Start {
+		if err := p.integrationUseCase.Start(ctx, tasks[taskIndex]); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return err
+		}
+	}
+
 	fmt.Println("Task started successfully")
 
 	return nil
@@ -267,7 +302,7 @@ func (p *Presenter) Complete(ctx context.Context) error {
 		return err
 	}
 
-	if err = p.taskUseCase.Complete(ctx, task[taskIndex].ID); err != nil {
+	if err = p.taskUseCase.Complete(ctx, task[taskIndex].ID); err != nil && !reportPublishError(err) {
 		fmt.Printf("Error: %v\n", err)
 		return err
 	}
@@ -277,6 +312,12 @@ func (p *Presenter) Complete(ctx context.Context) error {
 		return err
 	}
 
+	if task[taskIndex].IsStarted {
+		if err := p.slackUseCase.ClearStatus(ctx, task[taskIndex]); err != nil {
+			fmt.Printf("Slack error: %v\n", err)
+		}
+	}
+
 	currentTask := task[taskIndex]
 	integrationType := currentTask.Integration.Type
 	if currentTask.ParentTaskID != "" {
@@ -288,8 +329,19 @@ func (p *Presenter) Complete(ctx context.Context) error {
 		integrationType = parentTask.Integration.Type
 	}
 
-	if integrationType == entity.IntegrationTypeJIRA {
-		if err := p.addWorklogJIRA(ctx, task[taskIndex].ID); err != nil {
+	// Local tasks have no integration and so no capabilities
+	definition, _ := p.integrationUseCase.GetDefinition(ctx, integrationType)
+	if definition.Capabilities.Worklog {
+		if err := p.addWorklog(ctx, currentTask.ID); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return err
+		}
+	}
+
+	// Subtasks synced from an integration are transitioned on their own
+	definition, _ = p.integrationUseCase.GetDefinition(ctx, currentTask.Integration.Type)
+	if definition.Capabilities.Transition {
+		if err := p.integrationUseCase.Transition(ctx, currentTask); err != nil {
 			fmt.Printf("Error: %v\n", err)
 			return err
 		}
@@ -300,7 +352,7 @@ func (p *Presenter) Complete(ctx context.Context) error {
 	return nil
 }
 
-func (p *Presenter) addWorklogJIRA(ctx context.Context, taskID string) error {
+func (p *Presenter) addWorklog(ctx context.Context, taskID string) error {
 	task, err := p.taskUseCase.GetByID(ctx, taskID)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
@@ -324,7 +376,7 @@ func (p *Presenter) addWorklogJIRA(ctx context.Context, taskID string) error {
 		return err
 	}
 
-	if err := p.jiraUseCase.AddWorklog(ctx, task, timeSpent); err != nil {
+	if err := p.integrationUseCase.AddWorklog(ctx, task, timeSpent); err != nil {
 		fmt.Printf("Error: %v\n", err)
 		return err
 	}
@@ -364,7 +416,7 @@ func (p *Presenter) Remove(ctx context.Context) error {
 		return err
 	}
 
-	if err = p.taskUseCase.Remove(ctx, tasks[taskIndex].ID); err != nil {
+	if err = p.taskUseCase.Remove(ctx, tasks[taskIndex].ID); err != nil && !reportPublishError(err) {
 		fmt.Printf("Error: %v\n", err)
 		return err
 	}
@@ -380,8 +432,17 @@ func (p *Presenter) Remove(ctx context.Context) error {
 }
 
 func (p *Presenter) Stop(ctx context.Context) error {
+	// A forgotten session is stopped when it should have been instead
+	if trimmed, err := p.checkSession(ctx); err != nil || trimmed {
+		return err
+	}
+
+	// The started task is only needed to clear its Slack status and to warn
+	// about a forgotten timer
+	startedTask, _ := p.taskUseCase.GetStarted(ctx)
+
 	err := p.taskUseCase.Stop(ctx)
-	if err != nil {
+	if err != nil && !reportPublishError(err) {
 		fmt.Printf("Error: %v\n", err)
 		return err
 	}
@@ -391,7 +452,565 @@ func (p *Presenter) Stop(ctx context.Context) error {
 		return err
 	}
 
+	if err := p.slackUseCase.ClearStatus(ctx, startedTask); err != nil {
+		fmt.Printf("Slack error: %v\n", err)
+	}
+
 	fmt.Println("Task stopped successfully")
 
+	if session := startedTask.CurrentSession(time.Now()); session > task.LongSession {
+		fmt.Printf("Warning: the session ran for %s, fix it with `todo time edit %s %d` if the timer was left running\n", session.Round(time.Minute), startedTask.ID, len(startedTask.Histories))
+	}
+
+	return nil
+}
+
+// Repair stops all started tasks but the last one, for databases where two
+// invocations started a task at the same time.
+func (p *Presenter) Repair(ctx context.Context) error {
+	stoppedTasks, err := p.taskUseCase.Repair(ctx)
+	if err != nil && !reportPublishError(err) {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if len(stoppedTasks) == 0 {
+		fmt.Println("Nothing to repair")
+		return nil
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		fmt.Printf("Upload error: %v\n", err)
+		return err
+	}
+
+	for _, stoppedTask := range stoppedTasks {
+		fmt.Printf("Stopped %s\n", stoppedTask.Name)
+	}
+
+	return nil
+}
+
+// Pause interrupts the started task, e.g. for a meeting, without ending its
+// session.
+func (p *Presenter) Pause(ctx context.Context, reason string) error {
+	if err := p.taskUseCase.Pause(ctx, reason); err != nil && !reportPublishError(err) {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		fmt.Printf("Upload error: %v\n", err)
+		return err
+	}
+
+	fmt.Println("Task paused successfully")
+
+	return nil
+}
+
+func (p *Presenter) Resume(ctx context.Context) error {
+	if err := p.taskUseCase.Resume(ctx); err != nil && !reportPublishError(err) {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		fmt.Printf("Upload error: %v\n", err)
+		return err
+	}
+
+	fmt.Println("Task resumed successfully")
+
+	return nil
+}
+
+// AddTime records a session of the task referenced by ref that was not
+// tracked with start and stop.
+func (p *Presenter) AddTime(ctx context.Context, ref, from, to string, duration time.Duration) error {
+	selectedTask, err := p.findTask(ctx, ref)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	startedAt, stoppedAt, err := sessionRange(from, to, duration, time.Now())
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	history := entity.TaskHistory{StartedAt: startedAt, StoppedAt: stoppedAt}
+	if err := p.taskUseCase.AddTime(ctx, selectedTask.ID, history); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		fmt.Printf("Upload error: %v\n", err)
+		return err
+	}
+
+	fmt.Printf("Added %s to %s\n", history.Duration(time.Now()).Round(time.Second), selectedTask.Name)
+	warnLongSession(history)
+
+	return nil
+}
+
+// EditTime changes the session numbered as in `todo show`. Flags that are not
+// given keep their value, a duration moves the stop time.
+func (p *Presenter) EditTime(ctx context.Context, ref string, session int, from, to string, duration time.Duration) error {
+	selectedTask, err := p.findTask(ctx, ref)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if session < 1 || session > len(selectedTask.Histories) {
+		fmt.Printf("Error: %v\n", task.ErrSessionNotFound)
+		return task.ErrSessionNotFound
+	}
+
+	now := time.Now()
+	history := selectedTask.Histories[session-1]
+	if from != "" {
+		if history.StartedAt, err = parseTime(from, now); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return err
+		}
+	}
+
+	if to != "" {
+		if history.StoppedAt, err = parseTime(to, now); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return err
+		}
+	}
+
+	if duration > 0 {
+		history.StoppedAt = history.StartedAt.Add(duration)
+	}
+
+	if err := p.taskUseCase.EditTime(ctx, selectedTask.ID, session-1, history); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		fmt.Printf("Upload error: %v\n", err)
+		return err
+	}
+
+	fmt.Println("Session edited successfully")
+	warnLongSession(history)
+
 	return nil
 }
+
+// DeleteTime removes the session numbered as in `todo show`.
+func (p *Presenter) DeleteTime(ctx context.Context, ref string, session int) error {
+	selectedTask, err := p.findTask(ctx, ref)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if err := p.taskUseCase.DeleteTime(ctx, selectedTask.ID, session-1); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		fmt.Printf("Upload error: %v\n", err)
+		return err
+	}
+
+	fmt.Println("Session deleted successfully")
+
+	return nil
+}
+
+// CheckSession offers to trim the started task when it was left running,
+// before a command works with it. Failures are only warnings so the command
+// still runs.
+func (p *Presenter) CheckSession(ctx context.Context) error {
+	_, err := p.checkSession(ctx)
+	return err
+}
+
+// TrimForgotten stops a forgotten session without asking, for cron jobs and
+// status bars.
+func (p *Presenter) TrimForgotten(ctx context.Context) error {
+	session, err := p.taskUseCase.CheckSession(ctx)
+	if err != nil && !reportPublishError(err) {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if session.IsZero() {
+		return nil
+	}
+
+	if !session.Trimmed {
+		if err := p.taskUseCase.TrimSession(ctx, session); err != nil && !reportPublishError(err) {
+			fmt.Printf("Error: %v\n", err)
+			return err
+		}
+	}
+
+	p.afterTrim(ctx, session)
+	return nil
+}
+
+func (p *Presenter) checkSession(ctx context.Context) (bool, error) {
+	session, err := p.taskUseCase.CheckSession(ctx)
+	if err != nil && !reportPublishError(err) {
+		fmt.Printf("Warning: %v\n", err)
+	}
+
+	if session.IsZero() {
+		return false, nil
+	}
+
+	if session.Trimmed {
+		p.afterTrim(ctx, session)
+		return true, nil
+	}
+
+	fmt.Println(describeSession(session))
+	if !isInteractive() {
+		fmt.Println("Run `todo time check` to stop it there")
+		return false, nil
+	}
+
+	prompt := promptui.Prompt{
+		Label:     fmt.Sprintf("Stop it at %s", session.StopAt.Local().Format(time.DateTime)),
+		IsConfirm: true,
+	}
+
+	if _, err := prompt.Run(); err != nil {
+		return false, nil
+	}
+
+	if err := p.taskUseCase.TrimSession(ctx, session); err != nil && !reportPublishError(err) {
+		fmt.Printf("Error: %v\n", err)
+		return false, err
+	}
+
+	p.afterTrim(ctx, session)
+	return true, nil
+}
+
+// afterTrim only warns when the upload or the Slack status fail, the session
+// is already stopped locally and the command it runs before should still run.
+func (p *Presenter) afterTrim(ctx context.Context, session entity.ForgottenSession) {
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		fmt.Printf("Upload error: %v\n", err)
+	}
+
+	if err := p.slackUseCase.ClearStatus(ctx, session.Task); err != nil {
+		fmt.Printf("Slack error: %v\n", err)
+	}
+
+	fmt.Printf("Stopped %s at %s\n", session.Task.Name, session.StopAt.Local().Format(time.DateTime))
+}
+
+func describeSession(session entity.ForgottenSession) string {
+	startedAt := session.Task.Histories[len(session.Task.Histories)-1].StartedAt
+	if session.Reason == entity.ForgottenReasonIdle {
+		return fmt.Sprintf("%s is running since %s, but you were idle since %s", session.Task.Name, startedAt.Local().Format(time.DateTime), session.StopAt.Local().Format(time.DateTime))
+	}
+
+	return fmt.Sprintf("%s is running since %s, longer than the %s limit", session.Task.Name, startedAt.Local().Format(time.DateTime), session.StopAt.Sub(startedAt))
+}
+
+// isInteractive tells whether stdin is a terminal that can answer a prompt.
+func isInteractive() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// SetTrackingSetting changes the flags that are given and prints the
+// resulting setting.
+func (p *Presenter) SetTrackingSetting(ctx context.Context, flags TrackingFlags) error {
+	setting, err := p.taskUseCase.GetTrackingSetting(ctx)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if flags.apply(&setting) {
+		if err := p.taskUseCase.SetTrackingSetting(ctx, setting); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return err
+		}
+
+		if err := p.settingUseCase.Upload(ctx); err != nil {
+			fmt.Printf("Upload error: %v\n", err)
+			return err
+		}
+	}
+
+	idleSource := string(setting.IdleSource)
+	if setting.IdleSource == entity.IdleSourceNone {
+		idleSource = "none"
+	}
+
+	fmt.Printf("Max session:  %s\n", setting.MaxSession)
+	fmt.Printf("Auto trim:    %t\n", setting.AutoTrim)
+	fmt.Printf("Idle source:  %s\n", idleSource)
+	if setting.IdleSource == entity.IdleSourceFile {
+		fmt.Printf("Idle file:    %s\n", setting.IdleFile)
+	}
+	fmt.Printf("Idle after:   %s\n", setting.IdleAfter)
+
+	return nil
+}
+
+func warnLongSession(history entity.TaskHistory) {
+	if duration := history.Duration(time.Now()); duration > task.LongSession {
+		fmt.Printf("Warning: the session is %s long\n", duration.Round(time.Minute))
+	}
+}
+
+// AddNote appends the note given as `[task] text` arguments and optionally
+// posts it as a JIRA comment. The first argument is the task when it
+// resolves to one, otherwise it is part of the note of the started task.
+func (p *Presenter) AddNote(ctx context.Context, args []string, pushToJIRA bool) error {
+	selectedTask, content, err := p.findNoteTask(ctx, args)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if strings.TrimSpace(content) == "" {
+		prompt := promptui.Prompt{
+			Label: "Note",
+		}
+
+		content, err = prompt.Run()
+		if err != nil {
+			fmt.Printf("Prompt failed %v\n", err)
+			return err
+		}
+	}
+
+	note, err := p.taskUseCase.AddNote(ctx, selectedTask.ID, content)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		fmt.Printf("Upload error: %v\n", err)
+		return err
+	}
+
+	if pushToJIRA {
+		if err := p.jiraUseCase.AddComment(ctx, selectedTask, note.Content); err != nil {
+			fmt.Printf("JIRA error: %v\n", err)
+			return err
+		}
+	}
+
+	fmt.Println("Note added successfully")
+
+	return nil
+}
+
+// Show prints everything about the task referenced by ref, or the started
+// task when ref is empty.
+func (p *Presenter) Show(ctx context.Context, ref string, asJSON bool) error {
+	selectedTask, err := p.findTask(ctx, ref)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	detail, err := p.taskUseCase.GetDetail(ctx, selectedTask.ID)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	now := time.Now()
+	if asJSON {
+		content, err := json.MarshalIndent(CreateTaskDetailModel(detail, now), "", "  ")
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return err
+		}
+
+		fmt.Println(string(content))
+		return nil
+	}
+
+	name := detail.Task.Name
+	if detail.Task.Integration.ID != "" {
+		name = fmt.Sprintf("%s (%s)", name, detail.Task.Integration.ID)
+	}
+
+	fmt.Printf("Name:        %s\n", name)
+	fmt.Printf("Project:     %s\n", detail.Project.Name)
+	fmt.Printf("Status:      %s\n", taskStatus(detail.Task))
+	if !detail.Task.DueAt.IsZero() {
+		fmt.Printf("Due:         %s\n", detail.Task.DueAt.Local().Format(time.DateTime))
+	}
+	if detail.Task.Priority != "" {
+		fmt.Printf("Priority:    %s\n", detail.Task.Priority)
+	}
+	if len(detail.Task.Tags) > 0 {
+		fmt.Printf("Tags:        %s\n", strings.Join(detail.Task.Tags, ", "))
+	}
+	if detail.Parent != nil {
+		fmt.Printf("Parent:      %s\n", detail.Parent.Name)
+	}
+	if detail.IssueURL != "" {
+		fmt.Printf("Link:        %s\n", detail.IssueURL)
+	}
+	fmt.Printf("Time spent:  %s\n", detail.Task.Elapsed(now).Round(time.Second))
+	if detail.Task.Description != "" {
+		fmt.Printf("Description:\n%s\n", detail.Task.Description)
+	}
+
+	sessions := table.NewWriter()
+	sessions.SetOutputMirror(os.Stdout)
+	sessions.SetTitle("Sessions:")
+	sessions.AppendHeader(table.Row{"#", "Started", "Stopped", "Duration", "Interruptions"})
+	for i, history := range detail.Task.Histories {
+		stoppedAt := "Running"
+		if !history.StoppedAt.IsZero() {
+			stoppedAt = history.StoppedAt.Local().Format(time.DateTime)
+		}
+
+		interruptions := ""
+		if len(history.Pauses) > 0 {
+			interruptions = fmt.Sprintf("%d (%s)", len(history.Pauses), history.Paused(now).Round(time.Second))
+		}
+
+		sessions.AppendRow(table.Row{i + 1, history.StartedAt.Local().Format(time.DateTime), stoppedAt, history.Duration(now).Round(time.Second), interruptions})
+	}
+	sessions.AppendFooter(table.Row{"", "", "Total", detail.Task.Elapsed(now).Round(time.Second), ""})
+	sessions.SetStyle(table.StyleLight)
+	sessions.Style().Format.Footer = text.FormatDefault
+	sessions.Render()
+
+	if len(detail.SubTasks) > 0 {
+		subTasks := table.NewWriter()
+		subTasks.SetOutputMirror(os.Stdout)
+		subTasks.SetTitle("Subtasks:")
+		subTasks.AppendHeader(table.Row{"Name", "Status", "Time spent"})
+		for _, subTask := range detail.SubTasks {
+			subTasks.AppendRow(table.Row{subTask.Name, taskStatus(subTask), subTask.Elapsed(now).Round(time.Second)})
+		}
+		subTasks.SetStyle(table.StyleLight)
+		subTasks.Render()
+	}
+
+	if len(detail.Notes) > 0 {
+		notes := table.NewWriter()
+		notes.SetOutputMirror(os.Stdout)
+		notes.SetTitle("Notes:")
+		notes.AppendHeader(table.Row{"Date", "Note"})
+		for _, note := range detail.Notes {
+			notes.AppendRow(table.Row{note.CreatedAt.Local().Format(time.DateTime), note.Content})
+		}
+		notes.SetStyle(table.StyleLight)
+		notes.Render()
+	}
+
+	return nil
+}
+
+func taskStatus(t entity.Task) string {
+	switch {
+	case !t.CompletedAt.IsZero():
+		return "Completed at " + t.CompletedAt.Local().Format(time.DateTime)
+	case t.IsPaused():
+		pauses := t.Histories[len(t.Histories)-1].Pauses
+		if reason := pauses[len(pauses)-1].Reason; reason != "" {
+			return "Paused (" + reason + ")"
+		}
+		return "Paused"
+	case t.IsStarted:
+		return "Started"
+	default:
+		return "Open"
+	}
+}
+
+// findNoteTask splits the arguments of AddNote into the task and the note.
+func (p *Presenter) findNoteTask(ctx context.Context, args []string) (entity.Task, string, error) {
+	if len(args) > 1 {
+		selectedTask, err := p.taskUseCase.Find(ctx, args[0])
+		if err == nil {
+			return selectedTask, strings.Join(args[1:], " "), nil
+		}
+
+		if !errors.Is(err, task.ErrTaskNotFound) && !errors.Is(err, task.ErrAmbiguousTask) {
+			return entity.Task{}, "", err
+		}
+	}
+
+	selectedTask, err := p.findTask(ctx, "")
+	return selectedTask, strings.Join(args, " "), err
+}
+
+// findTask resolves ref with task.UseCase.Find. Without ref it is the
+// started task, and the task is asked for when none is started.
+func (p *Presenter) findTask(ctx context.Context, ref string) (entity.Task, error) {
+	if ref != "" {
+		return p.taskUseCase.Find(ctx, ref)
+	}
+
+	startedTask, err := p.taskUseCase.GetStarted(ctx)
+	if err == nil {
+		return startedTask, nil
+	}
+
+	if !errors.Is(err, task.ErrTaskNotFound) {
+		return entity.Task{}, err
+	}
+
+	tasks, err := p.taskUseCase.GetUncompleteTasks(ctx)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	views := []TaskView{}
+	number := 1
+	for _, t := range tasks {
+		if t.ParentTaskID == "" {
+			views = append(views, CreateTaskView(fmt.Sprintf("%d.", number), t))
+			number++
+			continue
+		}
+
+		views = append(views, CreateTaskView("-", t))
+	}
+
+	selectPrompt := promptui.Select{
+		Label:     "Select Task",
+		Items:     views,
+		Templates: taskSelectTemplate,
+		Size:      10,
+	}
+
+	taskIndex, _, err := selectPrompt.Run()
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	return tasks[taskIndex], nil
+}
+
+// reportPublishError prints failures of webhooks and hooks without failing the
+// command, since the task change itself is already saved.
+func reportPublishError(err error) bool {
+	var publishErr *task.PublishError
+	if !errors.As(err, &publishErr) {
+		return false
+	}
+
+	fmt.Printf("Hook error: %v\n", err)
+	return true
+}
@@ -0,0 +1,79 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidTime = errors.New("invalid time, use \"15:04\", \"2006-01-02 15:04\" or RFC3339")
+
+// parseTime reads a time given on the command line in local time. A clock
+// without a date is today.
+func parseTime(value string, now time.Time) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+
+	for _, layout := range []string{time.DateTime, "2006-01-02 15:04"} {
+		if parsed, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			return parsed, nil
+		}
+	}
+
+	for _, layout := range []string{time.TimeOnly, "15:04"} {
+		if parsed, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			year, month, day := now.Date()
+			return time.Date(year, month, day, parsed.Hour(), parsed.Minute(), parsed.Second(), 0, now.Location()), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidTime, value)
+}
+
+// parseDueDate reads a due date, a day without a clock is due at its start.
+// Empty is no due date.
+func parseDueDate(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if parsed, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		return parsed, nil
+	}
+
+	return parseTime(value, now)
+}
+
+// sessionRange turns the from, to and duration flags into a session. Only
+// two of them are needed, a missing to is now.
+func sessionRange(from, to string, duration time.Duration, now time.Time) (time.Time, time.Time, error) {
+	stoppedAt := now
+	if to != "" {
+		parsed, err := parseTime(to, now)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+
+		stoppedAt = parsed
+	}
+
+	if from == "" {
+		if duration <= 0 {
+			return time.Time{}, time.Time{}, errors.New("either --from or --duration is required")
+		}
+
+		return stoppedAt.Add(-duration), stoppedAt, nil
+	}
+
+	startedAt, err := parseTime(from, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if to == "" && duration > 0 {
+		stoppedAt = startedAt.Add(duration)
+	}
+
+	return startedAt, stoppedAt, nil
+}
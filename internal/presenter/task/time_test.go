@@ -0,0 +1,136 @@
+package task
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		value       string
+		expectedRes time.Time
+		expectedErr error
+	}{
+		{
+			name:        "clock",
+			value:       "09:15",
+			expectedRes: time.Date(2024, 1, 2, 9, 15, 0, 0, time.UTC),
+		},
+		{
+			name:        "date and clock",
+			value:       "2023-12-31 23:00",
+			expectedRes: time.Date(2023, 12, 31, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "RFC3339",
+			value:       "2023-12-31T23:00:00Z",
+			expectedRes: time.Date(2023, 12, 31, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "invalid",
+			value:       "yesterday",
+			expectedErr: ErrInvalidTime,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := parseTime(test.value, now)
+
+			assert.Equal(t, test.expectedErr, errors.Unwrap(err))
+			assert.True(t, test.expectedRes.Equal(res))
+		})
+	}
+}
+
+func TestParseDueDate(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		value       string
+		expectedRes time.Time
+		expectedErr error
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:        "day",
+			value:       "2024-01-31",
+			expectedRes: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "day and clock",
+			value:       "2024-01-31 17:00",
+			expectedRes: time.Date(2024, 1, 31, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "invalid",
+			value:       "next week",
+			expectedErr: ErrInvalidTime,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := parseDueDate(test.value, now)
+
+			assert.Equal(t, test.expectedErr, errors.Unwrap(err))
+			assert.True(t, test.expectedRes.Equal(res))
+		})
+	}
+}
+
+func TestSessionRange(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name              string
+		from              string
+		to                string
+		duration          time.Duration
+		expectedStartedAt time.Time
+		expectedStoppedAt time.Time
+		expectedErr       bool
+	}{
+		{
+			name:              "from and to",
+			from:              "09:00",
+			to:                "10:30",
+			expectedStartedAt: time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+			expectedStoppedAt: time.Date(2024, 1, 2, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:              "from and duration",
+			from:              "09:00",
+			duration:          45 * time.Minute,
+			expectedStartedAt: time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+			expectedStoppedAt: time.Date(2024, 1, 2, 9, 45, 0, 0, time.UTC),
+		},
+		{
+			name:              "duration until now",
+			duration:          time.Hour,
+			expectedStartedAt: time.Date(2024, 1, 2, 14, 30, 0, 0, time.UTC),
+			expectedStoppedAt: now,
+		},
+		{
+			name:        "neither from nor duration",
+			to:          "10:00",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			startedAt, stoppedAt, err := sessionRange(test.from, test.to, test.duration, now)
+
+			assert.Equal(t, test.expectedErr, err != nil)
+			assert.True(t, test.expectedStartedAt.Equal(startedAt))
+			assert.True(t, test.expectedStoppedAt.Equal(stoppedAt))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package task
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 	"github.com/manifoldco/promptui"
@@ -22,7 +23,9 @@ type TaskView struct {
 
 func CreateTaskView(taskNumber string, t entity.Task) TaskView {
 	taskName := fmt.Sprintf("%s %s", taskNumber, t.Name)
-	if t.IsStarted {
+	if t.IsPaused() {
+		taskName = fmt.Sprintf("%s (Paused)", taskName)
+	} else if t.IsStarted {
 		taskName = fmt.Sprintf("%s (Started)", taskName)
 	}
 
@@ -41,3 +44,171 @@ func CreateTaskView(taskNumber string, t entity.Task) TaskView {
 		ParentTaskID: t.ParentTaskID,
 	}
 }
+
+type TaskInterruptionModel struct {
+	StartedAt time.Time  `json:"started_at"`
+	StoppedAt *time.Time `json:"stopped_at"`
+	Reason    string     `json:"reason,omitempty"`
+}
+
+type TaskSessionModel struct {
+	StartedAt       time.Time               `json:"started_at"`
+	StoppedAt       *time.Time              `json:"stopped_at"`
+	DurationSeconds int64                   `json:"duration_seconds"`
+	PausedSeconds   int64                   `json:"paused_seconds"`
+	Interruptions   []TaskInterruptionModel `json:"interruptions"`
+}
+
+type TaskNoteModel struct {
+	CreatedAt time.Time `json:"created_at"`
+	Content   string    `json:"content"`
+}
+
+type TaskSummaryModel struct {
+	ID               string     `json:"id"`
+	Name             string     `json:"name"`
+	IsStarted        bool       `json:"is_started"`
+	CompletedAt      *time.Time `json:"completed_at"`
+	TimeSpentSeconds int64      `json:"time_spent_seconds"`
+}
+
+type TaskDetailModel struct {
+	ID               string             `json:"id"`
+	Name             string             `json:"name"`
+	Description      string             `json:"description"`
+	Project          string             `json:"project"`
+	IsStarted        bool               `json:"is_started"`
+	CompletedAt      *time.Time         `json:"completed_at"`
+	DueAt            *time.Time         `json:"due_at"`
+	Priority         string             `json:"priority"`
+	Tags             []string           `json:"tags"`
+	IntegrationID    string             `json:"integration_id,omitempty"`
+	IntegrationType  string             `json:"integration_type,omitempty"`
+	IssueURL         string             `json:"issue_url,omitempty"`
+	Parent           *TaskSummaryModel  `json:"parent"`
+	SubTasks         []TaskSummaryModel `json:"subtasks"`
+	Sessions         []TaskSessionModel `json:"sessions"`
+	TimeSpentSeconds int64              `json:"time_spent_seconds"`
+	Notes            []TaskNoteModel    `json:"notes"`
+}
+
+// CreateTaskDetailModel counts a running session up to now.
+func CreateTaskDetailModel(detail entity.TaskDetail, now time.Time) TaskDetailModel {
+	model := TaskDetailModel{
+		ID:               detail.Task.ID,
+		Name:             detail.Task.Name,
+		Description:      detail.Task.Description,
+		Project:          detail.Project.Name,
+		IsStarted:        detail.Task.IsStarted,
+		CompletedAt:      optionalTime(detail.Task.CompletedAt),
+		DueAt:            optionalTime(detail.Task.DueAt),
+		Priority:         detail.Task.Priority,
+		Tags:             append([]string{}, detail.Task.Tags...),
+		IntegrationID:    detail.Task.Integration.ID,
+		IntegrationType:  string(detail.Task.Integration.Type),
+		IssueURL:         detail.IssueURL,
+		SubTasks:         []TaskSummaryModel{},
+		Sessions:         []TaskSessionModel{},
+		TimeSpentSeconds: int64(detail.Task.Elapsed(now).Seconds()),
+		Notes:            []TaskNoteModel{},
+	}
+
+	if detail.Parent != nil {
+		parent := createTaskSummaryModel(*detail.Parent, now)
+		model.Parent = &parent
+	}
+
+	for _, subTask := range detail.SubTasks {
+		model.SubTasks = append(model.SubTasks, createTaskSummaryModel(subTask, now))
+	}
+
+	for _, history := range detail.Task.Histories {
+		session := TaskSessionModel{
+			StartedAt:       history.StartedAt,
+			StoppedAt:       optionalTime(history.StoppedAt),
+			DurationSeconds: int64(history.Duration(now).Seconds()),
+			PausedSeconds:   int64(history.Paused(now).Seconds()),
+			Interruptions:   []TaskInterruptionModel{},
+		}
+
+		for _, pause := range history.Pauses {
+			session.Interruptions = append(session.Interruptions, TaskInterruptionModel{
+				StartedAt: pause.StartedAt,
+				StoppedAt: optionalTime(pause.StoppedAt),
+				Reason:    pause.Reason,
+			})
+		}
+
+		model.Sessions = append(model.Sessions, session)
+	}
+
+	for _, note := range detail.Notes {
+		model.Notes = append(model.Notes, TaskNoteModel{
+			CreatedAt: note.CreatedAt,
+			Content:   note.Content,
+		})
+	}
+
+	return model
+}
+
+func createTaskSummaryModel(t entity.Task, now time.Time) TaskSummaryModel {
+	return TaskSummaryModel{
+		ID:               t.ID,
+		Name:             t.Name,
+		IsStarted:        t.IsStarted,
+		CompletedAt:      optionalTime(t.CompletedAt),
+		TimeSpentSeconds: int64(t.Elapsed(now).Seconds()),
+	}
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+// TrackingFlags are the flags of `todo setting tracking`, the ones left nil
+// keep their stored value.
+type TrackingFlags struct {
+	MaxSession *time.Duration
+	AutoTrim   *bool
+	IdleSource *string
+	IdleFile   *string
+	IdleAfter  *time.Duration
+}
+
+func (f TrackingFlags) apply(setting *entity.TrackingSetting) bool {
+	changed := false
+	if f.MaxSession != nil {
+		setting.MaxSession = *f.MaxSession
+		changed = true
+	}
+
+	if f.AutoTrim != nil {
+		setting.AutoTrim = *f.AutoTrim
+		changed = true
+	}
+
+	if f.IdleSource != nil {
+		setting.IdleSource = entity.IdleSourceType(*f.IdleSource)
+		if *f.IdleSource == "none" {
+			setting.IdleSource = entity.IdleSourceNone
+		}
+		changed = true
+	}
+
+	if f.IdleFile != nil {
+		setting.IdleFile = *f.IdleFile
+		changed = true
+	}
+
+	if f.IdleAfter != nil {
+		setting.IdleAfter = *f.IdleAfter
+		changed = true
+	}
+
+	return changed
+}
@@ -2,6 +2,7 @@ package task
 
 import (
 	"testing"
+	"time"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 	"github.com/stretchr/testify/assert"
@@ -32,3 +33,85 @@ func TestCreateTaskView(t *testing.T) {
 
 	assert.Equal(t, expected, res)
 }
+
+func TestCreateTaskDetailModel(t *testing.T) {
+	now := time.Date(2020, 1, 1, 2, 0, 0, 0, time.UTC)
+	completedAt := time.Date(2020, 1, 1, 0, 30, 0, 0, time.UTC)
+	parentTask := entity.Task{ID: "10001", Name: "Parent"}
+	detail := entity.TaskDetail{
+		Task: entity.Task{
+			ID:           "task-1",
+			Name:         "Task 1",
+			Description:  "Description 1",
+			IsStarted:    true,
+			ParentTaskID: "10001",
+			Priority:     "A",
+			Tags:         []string{"phone"},
+			Histories: []entity.TaskHistory{
+				{
+					StartedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+					StoppedAt: time.Date(2020, 1, 1, 0, 10, 0, 0, time.UTC),
+				},
+				{
+					StartedAt: time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC),
+					Pauses: []entity.TaskPause{
+						{StartedAt: time.Date(2020, 1, 1, 1, 30, 0, 0, time.UTC), Reason: "meeting"},
+					},
+				},
+			},
+		},
+		Project:  entity.Project{Name: "Project 1"},
+		Parent:   &parentTask,
+		SubTasks: entity.Tasks{{ID: "task-2", Name: "Task 2", CompletedAt: completedAt}},
+		Notes:    entity.TaskNotes{{Content: "any-note", CreatedAt: completedAt}},
+		IssueURL: "https://any.atlassian.net/browse/TODO-1",
+	}
+
+	stoppedAt := time.Date(2020, 1, 1, 0, 10, 0, 0, time.UTC)
+	expected := TaskDetailModel{
+		ID:          "task-1",
+		Name:        "Task 1",
+		Description: "Description 1",
+		Project:     "Project 1",
+		IsStarted:   true,
+		Priority:    "A",
+		Tags:        []string{"phone"},
+		IssueURL:    "https://any.atlassian.net/browse/TODO-1",
+		Parent:      &TaskSummaryModel{ID: "10001", Name: "Parent"},
+		SubTasks: []TaskSummaryModel{
+			{ID: "task-2", Name: "Task 2", CompletedAt: &completedAt},
+		},
+		Sessions: []TaskSessionModel{
+			{StartedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), StoppedAt: &stoppedAt, DurationSeconds: 600, Interruptions: []TaskInterruptionModel{}},
+			{
+				StartedAt:       time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC),
+				DurationSeconds: 1800,
+				PausedSeconds:   1800,
+				Interruptions: []TaskInterruptionModel{
+					{StartedAt: time.Date(2020, 1, 1, 1, 30, 0, 0, time.UTC), Reason: "meeting"},
+				},
+			},
+		},
+		TimeSpentSeconds: 2400,
+		Notes:            []TaskNoteModel{{CreatedAt: completedAt, Content: "any-note"}},
+	}
+
+	res := CreateTaskDetailModel(detail, now)
+
+	assert.Equal(t, expected, res)
+}
+
+func TestTrackingFlagsApply(t *testing.T) {
+	maxSession := 10 * time.Hour
+	idleSource := "none"
+	setting := entity.TrackingSetting{MaxSession: 12 * time.Hour, IdleSource: entity.IdleSourceX11, IdleAfter: 15 * time.Minute}
+
+	changed := TrackingFlags{}.apply(&setting)
+
+	assert.False(t, changed)
+
+	changed = TrackingFlags{MaxSession: &maxSession, IdleSource: &idleSource}.apply(&setting)
+
+	assert.True(t, changed)
+	assert.Equal(t, entity.TrackingSetting{MaxSession: 10 * time.Hour, IdleAfter: 15 * time.Minute}, setting)
+}
@@ -0,0 +1,99 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
+	"github.com/azisuazusa/todo-cli/internal/domain/transfer"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+type Presenter struct {
+	transferUseCase        transfer.UseCase
+	syncintegrationUseCase syncintegration.UseCase
+}
+
+func New(transferUseCase transfer.UseCase, settingUseCase syncintegration.UseCase) *Presenter {
+	return &Presenter{
+		transferUseCase:        transferUseCase,
+		syncintegrationUseCase: settingUseCase,
+	}
+}
+
+// Import reports what changed, or would change on a dry run, and the
+// conflicts between what is stored and what is imported.
+func (p *Presenter) Import(ctx context.Context, format entity.TransferFormat, path string, options entity.ImportOptions) error {
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+	defer file.Close()
+
+	result, err := p.transferUseCase.Import(ctx, format, file, options)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	if options.DryRun {
+		fmt.Println("Dry run, nothing was changed.")
+	} else if err = p.syncintegrationUseCase.Upload(ctx); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	fmt.Printf("Projects: %d added, %d updated\n", result.AddedProjects, result.UpdatedProjects)
+	fmt.Printf("Tasks:    %d added, %d updated\n", result.AddedTasks, result.UpdatedTasks)
+	fmt.Printf("Notes:    %d added\n", result.AddedNotes)
+	fmt.Printf("Settings: %d updated\n", result.UpdatedSettings)
+	if len(result.Conflicts) == 0 {
+		return nil
+	}
+
+	title := "Conflicts, the imported version wins:"
+	if options.KeepLocal {
+		title = "Conflicts, the local version is kept:"
+	}
+
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.SetTitle(title)
+	t.AppendHeader(table.Row{"Kind", "ID", "Name", "Differs in"})
+	for _, conflict := range result.Conflicts {
+		t.AppendRow(table.Row{conflict.Kind, conflict.ID, conflict.Name, strings.Join(conflict.Fields, ", ")})
+	}
+
+	t.SetStyle(table.StyleLight)
+	t.Render()
+
+	return nil
+}
+
+// Export writes to the output file, or to the standard output when it is
+// empty.
+func (p *Presenter) Export(ctx context.Context, format entity.TransferFormat, output string) error {
+	var w io.Writer = os.Stdout
+	if output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return err
+		}
+		defer file.Close()
+
+		w = file
+	}
+
+	if err := p.transferUseCase.Export(ctx, format, w); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	return nil
+}
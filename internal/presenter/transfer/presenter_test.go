@@ -0,0 +1,88 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	syncintegrationMocks "github.com/azisuazusa/todo-cli/internal/domain/syncintegration/mocks"
+	transferMocks "github.com/azisuazusa/todo-cli/internal/domain/transfer/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PresenterTestSuite struct {
+	suite.Suite
+	transferUseCase *transferMocks.UseCase
+	settingUseCase  *syncintegrationMocks.UseCase
+	presenter       *Presenter
+}
+
+func (t *PresenterTestSuite) SetupTest() {
+	t.transferUseCase = new(transferMocks.UseCase)
+	t.settingUseCase = new(syncintegrationMocks.UseCase)
+	t.presenter = New(t.transferUseCase, t.settingUseCase)
+}
+
+func TestPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(PresenterTestSuite))
+}
+
+func (t *PresenterTestSuite) TestImport() {
+	path := filepath.Join(t.T().TempDir(), "todo.txt")
+	t.Require().NoError(os.WriteFile(path, []byte("Call Mom\n"), 0644))
+	t.transferUseCase.On("Import", context.Background(), entity.TransferFormatTodoTxt, mock.MatchedBy(func(r io.Reader) bool {
+		content, _ := io.ReadAll(r)
+		return string(content) == "Call Mom\n"
+	}), entity.ImportOptions{}).Return(entity.ImportResult{AddedTasks: 1}, nil).Once()
+	t.settingUseCase.On("Upload", context.Background()).Return(nil).Once()
+
+	err := t.presenter.Import(context.Background(), entity.TransferFormatTodoTxt, path, entity.ImportOptions{})
+
+	t.NoError(err)
+	t.settingUseCase.AssertExpectations(t.T())
+}
+
+func (t *PresenterTestSuite) TestImportDryRun() {
+	path := filepath.Join(t.T().TempDir(), "backup.json")
+	t.Require().NoError(os.WriteFile(path, []byte("{}"), 0644))
+	options := entity.ImportOptions{DryRun: true}
+	t.transferUseCase.On("Import", context.Background(), entity.TransferFormatJSON, mock.Anything, options).Return(entity.ImportResult{
+		UpdatedTasks: 1,
+		Conflicts:    []entity.ImportConflict{{Kind: entity.ImportConflictTask, ID: "task-1", Name: "any-task", Fields: []string{"name"}}},
+	}, nil).Once()
+
+	err := t.presenter.Import(context.Background(), entity.TransferFormatJSON, path, options)
+
+	t.NoError(err)
+	t.settingUseCase.AssertNotCalled(t.T(), "Upload", mock.Anything)
+}
+
+func (t *PresenterTestSuite) TestImportFailed() {
+	path := filepath.Join(t.T().TempDir(), "todo.txt")
+	t.Require().NoError(os.WriteFile(path, nil, 0644))
+	t.transferUseCase.On("Import", context.Background(), entity.TransferFormatTodoTxt, mock.Anything, entity.ImportOptions{}).Return(entity.ImportResult{}, errors.New("any-error")).Once()
+
+	err := t.presenter.Import(context.Background(), entity.TransferFormatTodoTxt, path, entity.ImportOptions{})
+
+	t.EqualError(err, "any-error")
+	t.settingUseCase.AssertNotCalled(t.T(), "Upload", mock.Anything)
+}
+
+func (t *PresenterTestSuite) TestExport() {
+	path := filepath.Join(t.T().TempDir(), "todo.txt")
+	t.transferUseCase.On("Export", context.Background(), entity.TransferFormatTodoTxt, mock.Anything).Return(func(ctx context.Context, format entity.TransferFormat, w io.Writer) error {
+		_, err := io.WriteString(w, "Call Mom id:task-1\n")
+		return err
+	}).Once()
+
+	err := t.presenter.Export(context.Background(), entity.TransferFormatTodoTxt, path)
+
+	t.NoError(err)
+	content, _ := os.ReadFile(path)
+	t.Equal("Call Mom id:task-1\n", string(content))
+}
@@ -0,0 +1,605 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type pane int
+
+const (
+	paneTasks pane = iota
+	paneProjects
+)
+
+type mode int
+
+const (
+	modeBrowse mode = iota
+	modeForm
+	modeConfirm
+)
+
+type tickMsg time.Time
+
+type loadedMsg struct {
+	projects entity.Projects
+	tasks    entity.Tasks
+	err      error
+}
+
+type doneMsg struct {
+	status string
+	err    error
+}
+
+type form struct {
+	title    string
+	labels   []string
+	inputs   []textinput.Model
+	focused  int
+	onSubmit func(values []string) tea.Cmd
+}
+
+type confirm struct {
+	question string
+	onYes    tea.Cmd
+}
+
+type model struct {
+	ctx       context.Context
+	presenter *Presenter
+
+	projects      entity.Projects
+	tasks         entity.Tasks
+	pane          pane
+	taskCursor    int
+	projectCursor int
+
+	mode    mode
+	form    form
+	confirm confirm
+
+	status  string
+	err     error
+	loading bool
+	now     time.Time
+	width   int
+}
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	paneStyle     = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	activeStyle   = paneStyle.Copy().BorderForeground(lipgloss.Color("12"))
+	cursorStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("10"))
+	startedStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("11"))
+	mutedStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpBrowse    = "tab pane • ↑/↓ move • enter select project • a add • A add subtask • s start • x stop • p pause/resume • c complete • e edit • d remove • r refresh • q quit"
+	helpForm      = "tab/↓ next field • shift+tab/↑ previous field • enter submit • esc cancel"
+	helpConfirm   = "y confirm • n cancel"
+	projectsWidth = 28
+)
+
+func newModel(ctx context.Context, presenter *Presenter) model {
+	return model{
+		ctx:       ctx,
+		presenter: presenter,
+		loading:   true,
+		now:       time.Now(),
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.load(), tick())
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return tickMsg(t)
+	})
+}
+
+func (m model) load() tea.Cmd {
+	return func() tea.Msg {
+		projects, tasks, err := m.presenter.load(m.ctx)
+		return loadedMsg{projects: projects, tasks: tasks, err: err}
+	}
+}
+
+// run calls a presenter action in the background, the result is reported
+// with a doneMsg which reloads the lists.
+func (m model) run(action func(ctx context.Context) (string, error)) tea.Cmd {
+	return func() tea.Msg {
+		status, err := action(m.ctx)
+		return doneMsg{status: status, err: err}
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		return m, nil
+	case tickMsg:
+		m.now = time.Time(msg)
+		return m, tick()
+	case loadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+
+		m.projects = msg.projects
+		m.tasks = msg.tasks
+		m.taskCursor = clamp(m.taskCursor, len(m.tasks))
+		m.projectCursor = clamp(m.projectCursor, len(m.projects))
+		return m, nil
+	case doneMsg:
+		m.status = msg.status
+		m.err = msg.err
+		m.loading = true
+		return m, m.load()
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+
+		switch m.mode {
+		case modeForm:
+			return m.updateForm(msg)
+		case modeConfirm:
+			return m.updateConfirm(msg)
+		default:
+			return m.updateBrowse(msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m model) updateBrowse(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "tab":
+		if m.pane == paneTasks {
+			m.pane = paneProjects
+		} else {
+			m.pane = paneTasks
+		}
+	case "up", "k":
+		m.moveCursor(-1)
+	case "down", "j":
+		m.moveCursor(1)
+	case "r":
+		m.loading = true
+		return m, m.load()
+	case "enter":
+		if m.pane != paneProjects || len(m.projects) == 0 {
+			return m, nil
+		}
+
+		id := m.projects[m.projectCursor].ID
+		m.taskCursor = 0
+		return m, m.run(func(ctx context.Context) (string, error) {
+			return m.presenter.selectProject(ctx, id)
+		})
+	case "a":
+		m.openForm("Add task", []string{"Name", "Description"}, []string{"", ""}, func(values []string) tea.Cmd {
+			newTask := entity.Task{Name: values[0], Description: values[1]}
+			return m.run(func(ctx context.Context) (string, error) {
+				return m.presenter.add(ctx, newTask)
+			})
+		})
+	case "A":
+		parentTask, ok := m.parentOfSelected()
+		if !ok {
+			return m, nil
+		}
+
+		m.openForm("Add subtask to "+parentTask.Name, []string{"Name", "Description"}, []string{"", ""}, func(values []string) tea.Cmd {
+			newTask := entity.Task{Name: values[0], Description: values[1], ParentTaskID: parentTask.ID}
+			return m.run(func(ctx context.Context) (string, error) {
+				return m.presenter.add(ctx, newTask)
+			})
+		})
+	case "s":
+		selectedTask, ok := m.selectedTask()
+		if !ok {
+			return m, nil
+		}
+
+		return m, m.run(func(ctx context.Context) (string, error) {
+			return m.presenter.start(ctx, selectedTask)
+		})
+	case "x":
+		return m, m.run(m.presenter.stop)
+	case "p":
+		startedTask, ok := m.startedTask()
+		if !ok {
+			return m, nil
+		}
+
+		if startedTask.IsPaused() {
+			return m, m.run(m.presenter.resume)
+		}
+
+		m.openForm("Pause "+startedTask.Name, []string{"Reason"}, []string{""}, func(values []string) tea.Cmd {
+			return m.run(func(ctx context.Context) (string, error) {
+				return m.presenter.pause(ctx, values[0])
+			})
+		})
+	case "c":
+		selectedTask, ok := m.selectedTask()
+		if !ok {
+			return m, nil
+		}
+
+		if !m.canAddWorklog(selectedTask) {
+			return m, m.run(func(ctx context.Context) (string, error) {
+				return m.presenter.complete(ctx, selectedTask, 0)
+			})
+		}
+
+		timeSpent := selectedTask.Elapsed(m.now).Round(time.Minute).String()
+		m.openForm("Complete "+selectedTask.Name, []string{"Add Worklog"}, []string{timeSpent}, func(values []string) tea.Cmd {
+			worklog, err := time.ParseDuration(values[0])
+			if err != nil {
+				return func() tea.Msg {
+					return doneMsg{err: fmt.Errorf("parse duration failed: %w", err)}
+				}
+			}
+
+			return m.run(func(ctx context.Context) (string, error) {
+				return m.presenter.complete(ctx, selectedTask, worklog)
+			})
+		})
+	case "e":
+		selectedTask, ok := m.selectedTask()
+		if !ok {
+			return m, nil
+		}
+
+		m.openForm("Edit task", []string{"Name", "Description", "Due (2006-01-02)"}, []string{selectedTask.Name, selectedTask.Description, formatDue(selectedTask.DueAt)}, func(values []string) tea.Cmd {
+			dueAt, err := parseDue(values[2])
+			if err != nil {
+				return func() tea.Msg {
+					return doneMsg{err: err}
+				}
+			}
+
+			editedTask := entity.Task{ID: selectedTask.ID, Name: values[0], Description: values[1], DueAt: dueAt}
+			return m.run(func(ctx context.Context) (string, error) {
+				return m.presenter.edit(ctx, editedTask)
+			})
+		})
+	case "d":
+		selectedTask, ok := m.selectedTask()
+		if !ok {
+			return m, nil
+		}
+
+		m.mode = modeConfirm
+		m.confirm = confirm{
+			question: fmt.Sprintf("Remove %q?", selectedTask.Name),
+			onYes: m.run(func(ctx context.Context) (string, error) {
+				return m.presenter.remove(ctx, selectedTask.ID)
+			}),
+		}
+	}
+
+	return m, nil
+}
+
+func (m model) updateForm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.mode = modeBrowse
+		return m, nil
+	case "tab", "down":
+		m.focusInput(m.form.focused + 1)
+		return m, nil
+	case "shift+tab", "up":
+		m.focusInput(m.form.focused - 1)
+		return m, nil
+	case "enter":
+		if m.form.focused < len(m.form.inputs)-1 {
+			m.focusInput(m.form.focused + 1)
+			return m, nil
+		}
+
+		values := make([]string, len(m.form.inputs))
+		for i, input := range m.form.inputs {
+			values[i] = strings.TrimSpace(input.Value())
+		}
+
+		if values[0] == "" {
+			return m, nil
+		}
+
+		m.mode = modeBrowse
+		return m, m.form.onSubmit(values)
+	}
+
+	var cmd tea.Cmd
+	m.form.inputs[m.form.focused], cmd = m.form.inputs[m.form.focused].Update(msg)
+	return m, cmd
+}
+
+func (m model) updateConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		m.mode = modeBrowse
+		return m, m.confirm.onYes
+	case "n", "N", "esc":
+		m.mode = modeBrowse
+	}
+
+	return m, nil
+}
+
+func (m *model) openForm(title string, labels, values []string, onSubmit func(values []string) tea.Cmd) {
+	inputs := make([]textinput.Model, len(labels))
+	for i := range labels {
+		inputs[i] = textinput.New()
+		inputs[i].Prompt = ""
+		inputs[i].SetValue(values[i])
+	}
+
+	m.mode = modeForm
+	m.form = form{
+		title:    title,
+		labels:   labels,
+		inputs:   inputs,
+		onSubmit: onSubmit,
+	}
+	m.focusInput(0)
+}
+
+func (m *model) focusInput(index int) {
+	if index < 0 || index >= len(m.form.inputs) {
+		return
+	}
+
+	m.form.inputs[m.form.focused].Blur()
+	m.form.focused = index
+	m.form.inputs[index].Focus()
+}
+
+func (m *model) moveCursor(delta int) {
+	if m.pane == paneProjects {
+		m.projectCursor = clamp(m.projectCursor+delta, len(m.projects))
+		return
+	}
+
+	m.taskCursor = clamp(m.taskCursor+delta, len(m.tasks))
+}
+
+func (m model) selectedTask() (entity.Task, bool) {
+	if m.pane != paneTasks || len(m.tasks) == 0 {
+		return entity.Task{}, false
+	}
+
+	return m.tasks[m.taskCursor], true
+}
+
+// parentOfSelected returns the selected task, or its parent when a subtask is
+// selected, since subtasks can not be nested.
+func (m model) parentOfSelected() (entity.Task, bool) {
+	selectedTask, ok := m.selectedTask()
+	if !ok || selectedTask.ParentTaskID == "" {
+		return selectedTask, ok
+	}
+
+	return m.taskByID(selectedTask.ParentTaskID)
+}
+
+func (m model) taskByID(id string) (entity.Task, bool) {
+	for _, t := range m.tasks {
+		if t.ID == id {
+			return t, true
+		}
+	}
+
+	return entity.Task{}, false
+}
+
+// canAddWorklog reports whether the integration of the task, or of its
+// parent for subtasks, logs the time spent.
+func (m model) canAddWorklog(t entity.Task) bool {
+	if t.ParentTaskID != "" {
+		parentTask, ok := m.taskByID(t.ParentTaskID)
+		if !ok {
+			return false
+		}
+		t = parentTask
+	}
+
+	return m.presenter.capabilities(m.ctx, t.Integration.Type).Worklog
+}
+
+func (m model) startedTask() (entity.Task, bool) {
+	for _, t := range m.tasks {
+		if t.IsStarted {
+			return t, true
+		}
+	}
+
+	return entity.Task{}, false
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Todo-CLI"))
+	if startedTask, ok := m.startedTask(); ok {
+		icon := "▶"
+		if startedTask.IsPaused() {
+			icon = "⏸"
+		}
+		b.WriteString("  " + startedStyle.Render(fmt.Sprintf("%s %s %s", icon, startedTask.Name, formatElapsed(startedTask.Elapsed(m.now)))))
+	}
+	b.WriteString("\n")
+
+	switch m.mode {
+	case modeForm:
+		b.WriteString(m.viewForm())
+	case modeConfirm:
+		b.WriteString(paneStyle.Render(m.confirm.question))
+	default:
+		b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, m.viewProjects(), m.viewTasks()))
+	}
+	b.WriteString("\n")
+
+	switch {
+	case m.err != nil:
+		b.WriteString(errorStyle.Render("Error: " + m.err.Error()))
+	case m.loading:
+		b.WriteString(mutedStyle.Render("Loading..."))
+	default:
+		b.WriteString(m.status)
+	}
+	b.WriteString("\n")
+
+	help := helpBrowse
+	switch m.mode {
+	case modeForm:
+		help = helpForm
+	case modeConfirm:
+		help = helpConfirm
+	}
+	b.WriteString(mutedStyle.Render(help))
+
+	return b.String()
+}
+
+func (m model) viewProjects() string {
+	var lines []string
+	lines = append(lines, titleStyle.Render("Projects"))
+	for i, p := range m.projects {
+		name := "  " + p.Name
+		if p.IsSelected {
+			name = "* " + p.Name
+		}
+
+		lines = append(lines, m.renderRow(m.pane == paneProjects && i == m.projectCursor, name))
+	}
+
+	style := paneStyle
+	if m.pane == paneProjects {
+		style = activeStyle
+	}
+
+	return style.Width(projectsWidth).Render(strings.Join(lines, "\n"))
+}
+
+func (m model) viewTasks() string {
+	var lines []string
+	lines = append(lines, titleStyle.Render("Tasks"))
+	if len(m.tasks) == 0 {
+		lines = append(lines, mutedStyle.Render("No tasks, press a to add one"))
+	}
+
+	number := 1
+	for i, t := range m.tasks {
+		prefix := fmt.Sprintf("%d.", number)
+		if t.ParentTaskID != "" {
+			prefix = "  └"
+		} else {
+			number++
+		}
+
+		name := prefix + " " + t.Name
+		if t.Integration.ID != "" {
+			name = fmt.Sprintf("%s (%s)", name, t.Integration.ID)
+		}
+
+		if t.IsStarted {
+			name += " " + startedStyle.Render(formatElapsed(t.Elapsed(m.now)))
+		}
+
+		lines = append(lines, m.renderRow(m.pane == paneTasks && i == m.taskCursor, name))
+	}
+
+	style := paneStyle
+	if m.pane == paneTasks {
+		style = activeStyle
+	}
+
+	if width := m.width - projectsWidth - 8; width > 0 {
+		style = style.Width(width)
+	}
+
+	return style.Render(strings.Join(lines, "\n"))
+}
+
+func (m model) viewForm() string {
+	lines := []string{titleStyle.Render(m.form.title)}
+	for i, input := range m.form.inputs {
+		lines = append(lines, fmt.Sprintf("%s: %s", m.form.labels[i], input.View()))
+	}
+
+	return activeStyle.Render(strings.Join(lines, "\n"))
+}
+
+func (m model) renderRow(isCursor bool, text string) string {
+	if isCursor {
+		return cursorStyle.Render("> " + text)
+	}
+
+	return "  " + text
+}
+
+func formatElapsed(d time.Duration) string {
+	d = d.Round(time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+}
+
+// formatDue shows a due date without its clock when it is due at the start of
+// the day.
+func formatDue(dueAt time.Time) string {
+	if dueAt.IsZero() {
+		return ""
+	}
+
+	dueAt = dueAt.Local()
+	if hour, minute, second := dueAt.Clock(); hour == 0 && minute == 0 && second == 0 {
+		return dueAt.Format(time.DateOnly)
+	}
+
+	return dueAt.Format("2006-01-02 15:04")
+}
+
+func parseDue(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range []string{time.DateOnly, "2006-01-02 15:04"} {
+		if dueAt, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return dueAt, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid due date %q, use 2006-01-02", value)
+}
+
+func clamp(index, length int) int {
+	if index >= length {
+		index = length - 1
+	}
+
+	if index < 0 {
+		index = 0
+	}
+
+	return index
+}
@@ -0,0 +1,265 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration"
+	integrationMocks "github.com/azisuazusa/todo-cli/internal/domain/integration/mocks"
+	projectMocks "github.com/azisuazusa/todo-cli/internal/domain/project/mocks"
+	slackMocks "github.com/azisuazusa/todo-cli/internal/domain/slack/mocks"
+	syncintegrationMocks "github.com/azisuazusa/todo-cli/internal/domain/syncintegration/mocks"
+	"github.com/azisuazusa/todo-cli/internal/domain/task"
+	taskMocks "github.com/azisuazusa/todo-cli/internal/domain/task/mocks"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModelTestSuite struct {
+	suite.Suite
+	taskUseCase        *taskMocks.UseCase
+	projectUseCase     *projectMocks.UseCase
+	settingUseCase     *syncintegrationMocks.UseCase
+	integrationUseCase *integrationMocks.UseCase
+	slackUseCase       *slackMocks.UseCase
+	model              model
+}
+
+func (t *ModelTestSuite) SetupTest() {
+	t.taskUseCase = new(taskMocks.UseCase)
+	t.projectUseCase = new(projectMocks.UseCase)
+	t.settingUseCase = new(syncintegrationMocks.UseCase)
+	t.integrationUseCase = new(integrationMocks.UseCase)
+	t.integrationUseCase.On("GetDefinition", mock.Anything, entity.IntegrationTypeJIRA).Return(entity.IntegrationDefinition{
+		Capabilities: entity.IntegrationCapabilities{Worklog: true, Transition: true},
+	}, nil).Maybe()
+	t.integrationUseCase.On("GetDefinition", mock.Anything, entity.IntegrationType("")).Return(entity.IntegrationDefinition{}, integration.ErrUnsupportedIntegration).Maybe()
+	t.slackUseCase = new(slackMocks.UseCase)
+	presenter := New(t.taskUseCase, t.projectUseCase, t.settingUseCase, t.integrationUseCase, t.slackUseCase)
+
+	t.model = newModel(context.Background(), presenter)
+	t.model.loading = false
+	t.model.tasks = entity.Tasks{
+		{ID: "task-1", Name: "parent", Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA}},
+		{ID: "task-2", Name: "child", ParentTaskID: "task-1"},
+		{ID: "task-3", Name: "other"},
+	}
+}
+
+func TestModelTestSuite(t *testing.T) {
+	suite.Run(t, new(ModelTestSuite))
+}
+
+func keyPress(key string) tea.KeyMsg {
+	switch key {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	}
+
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+}
+
+func (t *ModelTestSuite) press(m model, keys ...string) (model, tea.Cmd) {
+	var cmd tea.Cmd
+	for _, key := range keys {
+		var updated tea.Model
+		updated, cmd = m.Update(keyPress(key))
+		m = updated.(model)
+	}
+
+	return m, cmd
+}
+
+func (t *ModelTestSuite) TestLoad() {
+	tests := []struct {
+		name             string
+		expectedProjects entity.Projects
+		expectedTasks    entity.Tasks
+		expectedErr      error
+		mockFunc         func()
+	}{
+		{
+			name:        "failed to get projects",
+			expectedErr: errors.New("any-error"),
+			mockFunc: func() {
+				t.projectUseCase.On("GetAll", mock.Anything).Return(nil, errors.New("any-error")).Once()
+			},
+		},
+		{
+			name:             "no project selected",
+			expectedProjects: entity.Projects{{ID: "project-1"}},
+			mockFunc: func() {
+				t.projectUseCase.On("GetAll", mock.Anything).Return(entity.Projects{{ID: "project-1"}}, nil).Once()
+				t.taskUseCase.On("GetUncompleteTasks", mock.Anything).Return(nil, entity.ErrNoProjectSelected).Once()
+			},
+		},
+		{
+			name:             "success",
+			expectedProjects: entity.Projects{{ID: "project-1", IsSelected: true}},
+			expectedTasks:    entity.Tasks{{ID: "task-1"}},
+			mockFunc: func() {
+				t.projectUseCase.On("GetAll", mock.Anything).Return(entity.Projects{{ID: "project-1", IsSelected: true}}, nil).Once()
+				t.taskUseCase.On("GetUncompleteTasks", mock.Anything).Return(entity.Tasks{{ID: "task-1"}}, nil).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func() {
+			test.mockFunc()
+
+			msg := t.model.load()().(loadedMsg)
+
+			t.Equal(test.expectedProjects, msg.projects)
+			t.Equal(test.expectedTasks, msg.tasks)
+			t.Equal(test.expectedErr, msg.err)
+		})
+	}
+}
+
+func (t *ModelTestSuite) TestStart() {
+	t.taskUseCase.On("Start", mock.Anything, "task-2").Return(nil).Once()
+	t.settingUseCase.On("Upload", mock.Anything).Return(nil).Once()
+	t.slackUseCase.On("SetStatus", mock.Anything, t.model.tasks[1]).Return(nil).Once()
+
+	_, cmd := t.press(t.model, "down", "s")
+
+	t.Equal(doneMsg{status: "Task started successfully"}, cmd())
+	t.taskUseCase.AssertExpectations(t.T())
+	t.slackUseCase.AssertExpectations(t.T())
+}
+
+func (t *ModelTestSuite) TestStopWithHookError() {
+	startedTask := entity.Task{ID: "task-1", ProjectID: "project-1", IsStarted: true}
+	t.taskUseCase.On("GetStarted", mock.Anything).Return(startedTask, nil).Once()
+	t.taskUseCase.On("Stop", mock.Anything).Return(&task.PublishError{EventType: entity.EventTypeTaskStopped, Err: errors.New("any-error")}).Once()
+	t.settingUseCase.On("Upload", mock.Anything).Return(nil).Once()
+	t.slackUseCase.On("ClearStatus", mock.Anything, startedTask).Return(nil).Once()
+
+	_, cmd := t.press(t.model, "x")
+
+	t.Equal(doneMsg{status: "Task stopped successfully (Hook error: error while publishing TaskStopped event: any-error)"}, cmd())
+}
+
+func (t *ModelTestSuite) TestPauseAndResume() {
+	t.model.tasks[2].IsStarted = true
+	t.model.tasks[2].Histories = []entity.TaskHistory{{StartedAt: t.model.now.Add(-time.Hour)}}
+	t.taskUseCase.On("Pause", mock.Anything, "lunch").Return(nil).Once()
+	t.settingUseCase.On("Upload", mock.Anything).Return(nil).Twice()
+
+	m, _ := t.press(t.model, "p")
+	t.Equal(modeForm, m.mode)
+
+	_, cmd := t.press(m, "lunch", "enter")
+
+	t.Equal(doneMsg{status: "Task paused successfully"}, cmd())
+
+	t.model.tasks[2].Histories[0].Pauses = []entity.TaskPause{{StartedAt: t.model.now, Reason: "lunch"}}
+	t.taskUseCase.On("Resume", mock.Anything).Return(nil).Once()
+
+	_, cmd = t.press(t.model, "p")
+
+	t.Equal(doneMsg{status: "Task resumed successfully"}, cmd())
+	t.taskUseCase.AssertExpectations(t.T())
+}
+
+func (t *ModelTestSuite) TestAddSubTask() {
+	t.taskUseCase.On("Add", mock.Anything, entity.Task{Name: "new-subtask", ParentTaskID: "task-1"}).Return(nil).Once()
+	t.settingUseCase.On("Upload", mock.Anything).Return(nil).Once()
+
+	m, _ := t.press(t.model, "down", "A")
+	t.Equal(modeForm, m.mode)
+
+	m, _ = t.press(m, "new-subtask", "enter")
+	m, cmd := t.press(m, "enter")
+
+	t.Equal(modeBrowse, m.mode)
+	t.Equal(doneMsg{status: "Subtask added successfully"}, cmd())
+}
+
+func (t *ModelTestSuite) TestEdit() {
+	t.taskUseCase.On("Edit", mock.Anything, entity.Task{ID: "task-3", Name: "other-renamed", Description: "any-description"}).Return(nil).Once()
+	t.settingUseCase.On("Upload", mock.Anything).Return(nil).Once()
+
+	m, _ := t.press(t.model, "down", "down", "e", "-renamed", "enter", "any-description", "enter")
+	_, cmd := t.press(m, "enter")
+
+	t.Equal(doneMsg{status: "Task edited successfully"}, cmd())
+}
+
+func (t *ModelTestSuite) TestEditDueDate() {
+	dueAt := time.Date(2024, 1, 31, 0, 0, 0, 0, time.Local)
+	t.taskUseCase.On("Edit", mock.Anything, entity.Task{ID: "task-3", Name: "other", DueAt: dueAt}).Return(nil).Once()
+	t.settingUseCase.On("Upload", mock.Anything).Return(nil).Once()
+
+	m, _ := t.press(t.model, "down", "down", "e", "enter", "enter", "2024-01-31")
+	_, cmd := t.press(m, "enter")
+
+	t.Equal(doneMsg{status: "Task edited successfully"}, cmd())
+}
+
+func (t *ModelTestSuite) TestEditInvalidDueDate() {
+	m, _ := t.press(t.model, "down", "down", "e", "enter", "enter", "tomorrow")
+	_, cmd := t.press(m, "enter")
+
+	t.Error(cmd().(doneMsg).err)
+}
+
+func (t *ModelTestSuite) TestCompleteJIRATask() {
+	t.model.tasks[0].IsStarted = true
+	t.model.tasks[0].Histories = []entity.TaskHistory{{StartedAt: t.model.now.Add(-30 * time.Minute)}}
+	completedTask := entity.Task{ID: "task-1", CompletedAt: time.Now()}
+	t.taskUseCase.On("Complete", mock.Anything, "task-1").Return(nil).Once()
+	t.settingUseCase.On("Upload", mock.Anything).Return(nil).Once()
+	t.slackUseCase.On("ClearStatus", mock.Anything, t.model.tasks[0]).Return(nil).Once()
+	t.taskUseCase.On("GetByID", mock.Anything, "task-1").Return(completedTask, nil).Once()
+	t.integrationUseCase.On("AddWorklog", mock.Anything, completedTask, 30*time.Minute).Return(nil).Once()
+	t.integrationUseCase.On("Transition", mock.Anything, t.model.tasks[0]).Return(nil).Once()
+
+	m, _ := t.press(t.model, "c")
+	t.Equal("30m0s", m.form.inputs[0].Value())
+
+	_, cmd := t.press(m, "enter")
+
+	t.Equal(doneMsg{status: "Task completed successfully"}, cmd())
+	t.integrationUseCase.AssertExpectations(t.T())
+}
+
+func (t *ModelTestSuite) TestRemove() {
+	t.taskUseCase.On("Remove", mock.Anything, "task-1").Return(nil).Once()
+	t.settingUseCase.On("Upload", mock.Anything).Return(nil).Once()
+
+	m, cmd := t.press(t.model, "d")
+	t.Equal(modeConfirm, m.mode)
+	t.Nil(cmd)
+
+	m, cmd = t.press(m, "y")
+
+	t.Equal(modeBrowse, m.mode)
+	t.Equal(doneMsg{status: "Task removed successfully"}, cmd())
+}
+
+func (t *ModelTestSuite) TestSelectProject() {
+	t.model.projects = entity.Projects{{ID: "project-1", IsSelected: true}, {ID: "project-2"}}
+	t.taskUseCase.On("Stop", mock.Anything).Return(task.ErrTaskNotFound).Once()
+	t.projectUseCase.On("Select", mock.Anything, "project-2").Return(nil).Once()
+	t.settingUseCase.On("Upload", mock.Anything).Return(errors.New("any-error")).Once()
+
+	_, cmd := t.press(t.model, "tab", "down", "enter")
+
+	t.Equal(doneMsg{err: errors.New("any-error")}, unwrapDone(cmd()))
+}
+
+func unwrapDone(msg tea.Msg) doneMsg {
+	done := msg.(doneMsg)
+	done.err = errors.Unwrap(done.err)
+	return done
+}
@@ -0,0 +1,262 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration"
+	"github.com/azisuazusa/todo-cli/internal/domain/project"
+	"github.com/azisuazusa/todo-cli/internal/domain/slack"
+	"github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
+	"github.com/azisuazusa/todo-cli/internal/domain/task"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Presenter struct {
+	taskUseCase        task.UseCase
+	projectUseCase     project.UseCase
+	settingUseCase     syncintegration.UseCase
+	integrationUseCase integration.UseCase
+	slackUseCase       slack.UseCase
+}
+
+func New(taskUseCase task.UseCase, projectUseCase project.UseCase, settingUseCase syncintegration.UseCase, integrationUseCase integration.UseCase, slackUseCase slack.UseCase) *Presenter {
+	return &Presenter{
+		taskUseCase:        taskUseCase,
+		projectUseCase:     projectUseCase,
+		settingUseCase:     settingUseCase,
+		integrationUseCase: integrationUseCase,
+		slackUseCase:       slackUseCase,
+	}
+}
+
+// Run opens the full-screen interface and blocks until it is closed.
+func (p *Presenter) Run(ctx context.Context) error {
+	program := tea.NewProgram(newModel(ctx, p), tea.WithAltScreen(), tea.WithContext(ctx))
+	if _, err := program.Run(); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+func (p *Presenter) load(ctx context.Context) (entity.Projects, entity.Tasks, error) {
+	projects, err := p.projectUseCase.GetAll(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tasks, err := p.taskUseCase.GetUncompleteTasks(ctx)
+	if errors.Is(err, entity.ErrNoProjectSelected) {
+		return projects, nil, nil
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return projects, tasks, nil
+}
+
+func (p *Presenter) add(ctx context.Context, newTask entity.Task) (string, error) {
+	var warnings []string
+	if err := p.taskUseCase.Add(ctx, newTask); err != nil && !collectPublishError(err, &warnings) {
+		return "", err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		return "", fmt.Errorf("upload error: %w", err)
+	}
+
+	if newTask.ParentTaskID != "" {
+		return withWarnings("Subtask added successfully", warnings), nil
+	}
+
+	return withWarnings("Task added successfully", warnings), nil
+}
+
+func (p *Presenter) start(ctx context.Context, startedTask entity.Task) (string, error) {
+	var warnings []string
+
+	// Start stops the running task in the same transaction
+	if err := p.taskUseCase.Start(ctx, startedTask.ID); err != nil && !collectPublishError(err, &warnings) {
+		return "", err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		return "", fmt.Errorf("upload error: %w", err)
+	}
+
+	if err := p.slackUseCase.SetStatus(ctx, startedTask); err != nil {
+		return "", fmt.Errorf("slack error: %w", err)
+	}
+
+	if p.capabilities(ctx, startedTask.Integration.Type).Start {
+		if err := p.integrationUseCase.Start(ctx, startedTask); err != nil {
+			return "", err
+		}
+	}
+
+	return withWarnings("Task started successfully", warnings), nil
+}
+
+func (p *Presenter) stop(ctx context.Context) (string, error) {
+	// The started task is only needed to clear its Slack status
+	startedTask, _ := p.taskUseCase.GetStarted(ctx)
+
+	var warnings []string
+	if err := p.taskUseCase.Stop(ctx); err != nil && !collectPublishError(err, &warnings) {
+		return "", err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		return "", fmt.Errorf("upload error: %w", err)
+	}
+
+	if err := p.slackUseCase.ClearStatus(ctx, startedTask); err != nil {
+		return "", fmt.Errorf("slack error: %w", err)
+	}
+
+	return withWarnings("Task stopped successfully", warnings), nil
+}
+
+func (p *Presenter) pause(ctx context.Context, reason string) (string, error) {
+	var warnings []string
+	if err := p.taskUseCase.Pause(ctx, reason); err != nil && !collectPublishError(err, &warnings) {
+		return "", err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		return "", fmt.Errorf("upload error: %w", err)
+	}
+
+	return withWarnings("Task paused successfully", warnings), nil
+}
+
+func (p *Presenter) resume(ctx context.Context) (string, error) {
+	var warnings []string
+	if err := p.taskUseCase.Resume(ctx); err != nil && !collectPublishError(err, &warnings) {
+		return "", err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		return "", fmt.Errorf("upload error: %w", err)
+	}
+
+	return withWarnings("Task resumed successfully", warnings), nil
+}
+
+// capabilities returns what the integration of the type supports, local tasks
+// have none.
+func (p *Presenter) capabilities(ctx context.Context, integrationType entity.IntegrationType) entity.IntegrationCapabilities {
+	definition, _ := p.integrationUseCase.GetDefinition(ctx, integrationType)
+	return definition.Capabilities
+}
+
+// complete finishes the task and, when worklog is set, logs it to the issue
+// the task belongs to. Tasks synced from an integration supporting it are
+// transitioned.
+func (p *Presenter) complete(ctx context.Context, completedTask entity.Task, worklog time.Duration) (string, error) {
+	var warnings []string
+	if err := p.taskUseCase.Complete(ctx, completedTask.ID); err != nil && !collectPublishError(err, &warnings) {
+		return "", err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		return "", fmt.Errorf("upload error: %w", err)
+	}
+
+	if completedTask.IsStarted {
+		if err := p.slackUseCase.ClearStatus(ctx, completedTask); err != nil {
+			return "", fmt.Errorf("slack error: %w", err)
+		}
+	}
+
+	if worklog > 0 {
+		storedTask, err := p.taskUseCase.GetByID(ctx, completedTask.ID)
+		if err != nil {
+			return "", err
+		}
+
+		if err = p.integrationUseCase.AddWorklog(ctx, storedTask, worklog); err != nil {
+			return "", err
+		}
+	}
+
+	if p.capabilities(ctx, completedTask.Integration.Type).Transition {
+		if err := p.integrationUseCase.Transition(ctx, completedTask); err != nil {
+			return "", err
+		}
+	}
+
+	return withWarnings("Task completed successfully", warnings), nil
+}
+
+func (p *Presenter) edit(ctx context.Context, editedTask entity.Task) (string, error) {
+	if err := p.taskUseCase.Edit(ctx, editedTask); err != nil {
+		return "", err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		return "", fmt.Errorf("upload error: %w", err)
+	}
+
+	return "Task edited successfully", nil
+}
+
+func (p *Presenter) remove(ctx context.Context, id string) (string, error) {
+	var warnings []string
+	if err := p.taskUseCase.Remove(ctx, id); err != nil && !collectPublishError(err, &warnings) {
+		return "", err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		return "", fmt.Errorf("upload error: %w", err)
+	}
+
+	return withWarnings("Task removed successfully", warnings), nil
+}
+
+func (p *Presenter) selectProject(ctx context.Context, id string) (string, error) {
+	var warnings []string
+
+	// Makesure there is no task running before change project
+	if err := p.taskUseCase.Stop(ctx); err != nil && !errors.Is(err, task.ErrTaskNotFound) && !collectPublishError(err, &warnings) {
+		return "", err
+	}
+
+	if err := p.projectUseCase.Select(ctx, id); err != nil {
+		return "", err
+	}
+
+	if err := p.settingUseCase.Upload(ctx); err != nil {
+		return "", fmt.Errorf("upload error: %w", err)
+	}
+
+	return withWarnings("Project selected successfully", warnings), nil
+}
+
+// collectPublishError keeps failures of webhooks and hooks as warnings instead
+// of failing the action, since the task change itself is already saved.
+func collectPublishError(err error, warnings *[]string) bool {
+	var publishErr *task.PublishError
+	if !errors.As(err, &publishErr) {
+		return false
+	}
+
+	*warnings = append(*warnings, "Hook error: "+err.Error())
+	return true
+}
+
+func withWarnings(status string, warnings []string) string {
+	if len(warnings) == 0 {
+		return status
+	}
+
+	return status + " (" + strings.Join(warnings, "; ") + ")"
+}
@@ -0,0 +1,48 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+// RepoImpl reads and writes backups as JSON documents, see BackupModel for
+// their schema.
+type RepoImpl struct {
+	now func() time.Time
+}
+
+func New() *RepoImpl {
+	return &RepoImpl{now: time.Now}
+}
+
+func (ri *RepoImpl) Decode(ctx context.Context, r io.Reader) (entity.Transfer, error) {
+	var backup BackupModel
+	if err := json.NewDecoder(r).Decode(&backup); err != nil {
+		return entity.Transfer{}, fmt.Errorf("failed to decode backup: %w", err)
+	}
+
+	if backup.Version == 0 {
+		return entity.Transfer{}, fmt.Errorf("failed to decode backup: no version, it is not a todo-cli backup")
+	}
+
+	if backup.Version > SchemaVersion {
+		return entity.Transfer{}, fmt.Errorf("failed to decode backup: version %d is newer than the supported version %d", backup.Version, SchemaVersion)
+	}
+
+	return backup.ToEntity(), nil
+}
+
+func (ri *RepoImpl) Encode(ctx context.Context, w io.Writer, transfer entity.Transfer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(CreateModel(transfer, ri.now().UTC())); err != nil {
+		return fmt.Errorf("failed to encode backup: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	exportedAt := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	startedAt := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	trackingSetting := entity.TrackingSetting{MaxSession: 8 * time.Hour, AutoTrim: true, IdleSource: entity.IdleSourceX11, IdleAfter: 10 * time.Minute}
+	transfer := entity.Transfer{
+		Projects: entity.Projects{
+			{ID: "project-1", Name: "Work", IsSelected: true, Integrations: []entity.Integration{{Type: entity.IntegrationTypeJIRA, IsEnabled: true, Details: map[string]string{"url": "https://any.atlassian.net"}}}},
+		},
+		Tasks: entity.Tasks{
+			{
+				ID:          "task-1",
+				ProjectID:   "project-1",
+				Name:        "Review",
+				Description: "any-description",
+				IsStarted:   true,
+				DueAt:       time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+				Priority:    "A",
+				Tags:        []string{"blocked"},
+				Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA},
+				Histories: []entity.TaskHistory{
+					{StartedAt: startedAt, StoppedAt: startedAt.Add(time.Hour), Pauses: []entity.TaskPause{{StartedAt: startedAt.Add(time.Minute), StoppedAt: startedAt.Add(2 * time.Minute), Reason: "call"}}},
+					{StartedAt: startedAt.Add(2 * time.Hour)},
+				},
+			},
+			{ID: "task-2", ProjectID: "project-1", ParentTaskID: "task-1", Name: "Answer", CompletedAt: startedAt},
+		},
+		Notes:           entity.TaskNotes{{ID: "note-1", TaskID: "task-1", Content: "any-note", CreatedAt: startedAt}},
+		TrackingSetting: &trackingSetting,
+	}
+	repoImpl := &RepoImpl{now: func() time.Time { return exportedAt }}
+	var buf bytes.Buffer
+
+	err := repoImpl.Encode(context.Background(), &buf, transfer)
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), `"version": 1,`)
+	assert.Contains(t, buf.String(), `"exported_at": "2024-01-10T12:00:00Z",`)
+	assert.Contains(t, buf.String(), `"max_session_seconds": 28800,`)
+
+	res, err := repoImpl.Decode(context.Background(), &buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, transfer, res)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectedErr string
+	}{
+		{
+			name:        "not JSON",
+			content:     "x done",
+			expectedErr: "failed to decode backup",
+		},
+		{
+			name:        "without version",
+			content:     `{"projects": []}`,
+			expectedErr: "it is not a todo-cli backup",
+		},
+		{
+			name:        "newer version",
+			content:     `{"version": 2}`,
+			expectedErr: "version 2 is newer than the supported version 1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New().Decode(context.Background(), strings.NewReader(test.content))
+
+			assert.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package backup
+
+import (
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+// SchemaVersion is raised whenever a change of the backup schema would be
+// misread by older versions. Fields may be added without raising it, they are
+// ignored by older versions.
+const SchemaVersion = 1
+
+// BackupModel is the whole backup. It does not follow the database schema,
+// so a backup can be restored by any later version.
+type BackupModel struct {
+	Version    int            `json:"version"`
+	ExportedAt time.Time      `json:"exported_at"`
+	Projects   []ProjectModel `json:"projects"`
+	Tasks      []TaskModel    `json:"tasks"`
+	Notes      []NoteModel    `json:"notes"`
+	Settings   *SettingsModel `json:"settings,omitempty"`
+}
+
+// ProjectModel leaves the credentials of its integrations out.
+type ProjectModel struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	Description  string             `json:"description,omitempty"`
+	IsSelected   bool               `json:"is_selected"`
+	Integrations []IntegrationModel `json:"integrations,omitempty"`
+}
+
+type IntegrationModel struct {
+	ID           string            `json:"id,omitempty"`
+	Type         string            `json:"type"`
+	IsEnabled    bool              `json:"is_enabled"`
+	Details      map[string]string `json:"details,omitempty"`
+	SecretFields []string          `json:"secret_fields,omitempty"`
+}
+
+type TaskModel struct {
+	ID           string                `json:"id"`
+	ProjectID    string                `json:"project_id"`
+	ParentTaskID string                `json:"parent_task_id,omitempty"`
+	Name         string                `json:"name"`
+	Description  string                `json:"description,omitempty"`
+	IsStarted    bool                  `json:"is_started"`
+	CompletedAt  *time.Time            `json:"completed_at,omitempty"`
+	DueAt        *time.Time            `json:"due_at,omitempty"`
+	Priority     string                `json:"priority,omitempty"`
+	Tags         []string              `json:"tags,omitempty"`
+	Integration  *TaskIntegrationModel `json:"integration,omitempty"`
+	Sessions     []SessionModel        `json:"sessions,omitempty"`
+}
+
+type TaskIntegrationModel struct {
+	Type     string `json:"type"`
+	ID       string `json:"id"`
+	Revision string `json:"revision,omitempty"`
+}
+
+// SessionModel has no stop time while it is running.
+type SessionModel struct {
+	StartedAt time.Time    `json:"started_at"`
+	StoppedAt *time.Time   `json:"stopped_at,omitempty"`
+	Pauses    []PauseModel `json:"pauses,omitempty"`
+}
+
+type PauseModel struct {
+	StartedAt time.Time  `json:"started_at"`
+	StoppedAt *time.Time `json:"stopped_at,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+}
+
+type NoteModel struct {
+	ID        string    `json:"id"`
+	TaskID    string    `json:"task_id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SettingsModel struct {
+	Tracking *TrackingModel `json:"tracking,omitempty"`
+}
+
+type TrackingModel struct {
+	MaxSessionSeconds int64  `json:"max_session_seconds"`
+	AutoTrim          bool   `json:"auto_trim"`
+	IdleSource        string `json:"idle_source,omitempty"`
+	IdleFile          string `json:"idle_file,omitempty"`
+	IdleAfterSeconds  int64  `json:"idle_after_seconds"`
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+func valueOf(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
+
+func CreateModel(transfer entity.Transfer, exportedAt time.Time) BackupModel {
+	backup := BackupModel{
+		Version:    SchemaVersion,
+		ExportedAt: exportedAt,
+		Projects:   []ProjectModel{},
+		Tasks:      []TaskModel{},
+		Notes:      []NoteModel{},
+	}
+
+	for _, project := range transfer.Projects {
+		projectModel := ProjectModel{
+			ID:          project.ID,
+			Name:        project.Name,
+			Description: project.Description,
+			IsSelected:  project.IsSelected,
+		}
+
+		for _, integration := range project.Integrations {
+			projectModel.Integrations = append(projectModel.Integrations, IntegrationModel{
+				ID:           integration.ID,
+				Type:         string(integration.Type),
+				IsEnabled:    integration.IsEnabled,
+				Details:      integration.Details,
+				SecretFields: integration.SecretFields,
+			})
+		}
+
+		backup.Projects = append(backup.Projects, projectModel)
+	}
+
+	for _, task := range transfer.Tasks {
+		backup.Tasks = append(backup.Tasks, createTaskModel(task))
+	}
+
+	for _, note := range transfer.Notes {
+		backup.Notes = append(backup.Notes, NoteModel{
+			ID:        note.ID,
+			TaskID:    note.TaskID,
+			Content:   note.Content,
+			CreatedAt: note.CreatedAt,
+		})
+	}
+
+	if setting := transfer.TrackingSetting; setting != nil {
+		backup.Settings = &SettingsModel{
+			Tracking: &TrackingModel{
+				MaxSessionSeconds: int64(setting.MaxSession.Seconds()),
+				AutoTrim:          setting.AutoTrim,
+				IdleSource:        string(setting.IdleSource),
+				IdleFile:          setting.IdleFile,
+				IdleAfterSeconds:  int64(setting.IdleAfter.Seconds()),
+			},
+		}
+	}
+
+	return backup
+}
+
+func createTaskModel(task entity.Task) TaskModel {
+	taskModel := TaskModel{
+		ID:           task.ID,
+		ProjectID:    task.ProjectID,
+		ParentTaskID: task.ParentTaskID,
+		Name:         task.Name,
+		Description:  task.Description,
+		IsStarted:    task.IsStarted,
+		CompletedAt:  optionalTime(task.CompletedAt),
+		DueAt:        optionalTime(task.DueAt),
+		Priority:     task.Priority,
+		Tags:         task.Tags,
+	}
+
+	if task.Integration.Type != "" {
+		taskModel.Integration = &TaskIntegrationModel{
+			Type:     string(task.Integration.Type),
+			ID:       task.Integration.ID,
+			Revision: task.Integration.Revision,
+		}
+	}
+
+	for _, history := range task.Histories {
+		session := SessionModel{
+			StartedAt: history.StartedAt,
+			StoppedAt: optionalTime(history.StoppedAt),
+		}
+
+		for _, pause := range history.Pauses {
+			session.Pauses = append(session.Pauses, PauseModel{
+				StartedAt: pause.StartedAt,
+				StoppedAt: optionalTime(pause.StoppedAt),
+				Reason:    pause.Reason,
+			})
+		}
+
+		taskModel.Sessions = append(taskModel.Sessions, session)
+	}
+
+	return taskModel
+}
+
+func (bm BackupModel) ToEntity() entity.Transfer {
+	var transfer entity.Transfer
+	for _, projectModel := range bm.Projects {
+		project := entity.Project{
+			ID:          projectModel.ID,
+			Name:        projectModel.Name,
+			Description: projectModel.Description,
+			IsSelected:  projectModel.IsSelected,
+		}
+
+		for _, integration := range projectModel.Integrations {
+			project.Integrations = append(project.Integrations, entity.Integration{
+				ID:           integration.ID,
+				Type:         entity.IntegrationType(integration.Type),
+				IsEnabled:    integration.IsEnabled,
+				Details:      integration.Details,
+				SecretFields: integration.SecretFields,
+			})
+		}
+
+		transfer.Projects = append(transfer.Projects, project)
+	}
+
+	for _, taskModel := range bm.Tasks {
+		transfer.Tasks = append(transfer.Tasks, taskModel.ToEntity())
+	}
+
+	for _, note := range bm.Notes {
+		transfer.Notes = append(transfer.Notes, entity.TaskNote{
+			ID:        note.ID,
+			TaskID:    note.TaskID,
+			Content:   note.Content,
+			CreatedAt: note.CreatedAt,
+		})
+	}
+
+	if bm.Settings != nil && bm.Settings.Tracking != nil {
+		tracking := bm.Settings.Tracking
+		transfer.TrackingSetting = &entity.TrackingSetting{
+			MaxSession: time.Duration(tracking.MaxSessionSeconds) * time.Second,
+			AutoTrim:   tracking.AutoTrim,
+			IdleSource: entity.IdleSourceType(tracking.IdleSource),
+			IdleFile:   tracking.IdleFile,
+			IdleAfter:  time.Duration(tracking.IdleAfterSeconds) * time.Second,
+		}
+	}
+
+	return transfer
+}
+
+func (tm TaskModel) ToEntity() entity.Task {
+	task := entity.Task{
+		ID:           tm.ID,
+		ProjectID:    tm.ProjectID,
+		ParentTaskID: tm.ParentTaskID,
+		Name:         tm.Name,
+		Description:  tm.Description,
+		IsStarted:    tm.IsStarted,
+		CompletedAt:  valueOf(tm.CompletedAt),
+		DueAt:        valueOf(tm.DueAt),
+		Priority:     tm.Priority,
+		Tags:         tm.Tags,
+	}
+
+	if tm.Integration != nil {
+		task.Integration = entity.TaskIntegration{
+			Type:     entity.IntegrationType(tm.Integration.Type),
+			ID:       tm.Integration.ID,
+			Revision: tm.Integration.Revision,
+		}
+	}
+
+	for _, session := range tm.Sessions {
+		history := entity.TaskHistory{
+			StartedAt: session.StartedAt,
+			StoppedAt: valueOf(session.StoppedAt),
+		}
+
+		for _, pause := range session.Pauses {
+			history.Pauses = append(history.Pauses, entity.TaskPause{
+				StartedAt: pause.StartedAt,
+				StoppedAt: valueOf(pause.StoppedAt),
+				Reason:    pause.Reason,
+			})
+		}
+
+		task.Histories = append(task.Histories, history)
+	}
+
+	return task
+}
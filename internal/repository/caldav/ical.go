@@ -0,0 +1,271 @@
+package caldav
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+const (
+	icalDateLayout     = "20060102"
+	icalDateTimeLayout = "20060102T150405"
+
+	// Content lines longer than this many octets are folded
+	icalLineLength = 75
+)
+
+// property is an unfolded content line, e.g. DUE;VALUE=DATE:20240131.
+type property struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// unfold splits data into content lines, joining the lines continued with a
+// leading space or tab.
+func unfold(data string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+// fold breaks a content line after at most 75 octets without splitting a
+// character.
+func fold(line string) string {
+	var folded strings.Builder
+	for len(line) > icalLineLength {
+		cut := icalLineLength
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		folded.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+	}
+	folded.WriteString(line)
+
+	return folded.String()
+}
+
+// parseProperty reads a content line, a colon in a quoted parameter value
+// does not end the parameters.
+func parseProperty(line string) property {
+	quoted := false
+	end := len(line)
+	for i, char := range line {
+		if char == '"' {
+			quoted = !quoted
+		}
+
+		if char == ':' && !quoted {
+			end = i
+			break
+		}
+	}
+
+	prop := property{Params: map[string]string{}}
+	if end < len(line) {
+		prop.Value = line[end+1:]
+	}
+
+	parts := strings.Split(line[:end], ";")
+	prop.Name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		prop.Params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+
+	return prop
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+
+// parseTime reads a DATE or DATE-TIME value. Dates and floating times are in
+// local time.
+func parseTime(prop property) (time.Time, error) {
+	if prop.Params["VALUE"] == "DATE" || len(prop.Value) == len(icalDateLayout) {
+		return time.ParseInLocation(icalDateLayout, prop.Value, time.Local)
+	}
+
+	if strings.HasSuffix(prop.Value, "Z") {
+		return time.Parse(icalDateTimeLayout+"Z", prop.Value)
+	}
+
+	location := time.Local
+	if tzid := prop.Params["TZID"]; tzid != "" {
+		if tz, err := time.LoadLocation(tzid); err == nil {
+			location = tz
+		}
+	}
+
+	return time.ParseInLocation(icalDateTimeLayout, prop.Value, location)
+}
+
+// formatDue writes a due date at the start of a day as a DATE, so it shows up
+// as an all-day task.
+func formatDue(dueAt time.Time) string {
+	local := dueAt.Local()
+	if hour, minute, second := local.Clock(); hour == 0 && minute == 0 && second == 0 {
+		return "DUE;VALUE=DATE:" + local.Format(icalDateLayout)
+	}
+
+	return "DUE:" + formatUTC(dueAt)
+}
+
+func formatUTC(t time.Time) string {
+	return t.UTC().Format(icalDateTimeLayout) + "Z"
+}
+
+// parseTodo reads the first VTODO of a calendar object, alarms inside it are
+// skipped.
+func parseTodo(data string) (TodoModel, bool, error) {
+	todo := TodoModel{Data: data}
+	inTodo, found, depth := false, false, 0
+	isCompleted := false
+	for _, line := range unfold(data) {
+		prop := parseProperty(line)
+		switch {
+		case prop.Name == "BEGIN" && strings.EqualFold(prop.Value, "VTODO") && !found:
+			inTodo, found = true, true
+			continue
+		case !inTodo:
+			continue
+		case prop.Name == "BEGIN":
+			depth++
+			continue
+		case prop.Name == "END" && depth > 0:
+			depth--
+			continue
+		case prop.Name == "END":
+			inTodo = false
+			continue
+		case depth > 0:
+			continue
+		}
+
+		var err error
+		switch prop.Name {
+		case "UID":
+			todo.UID = prop.Value
+		case "SUMMARY":
+			todo.Summary = textUnescaper.Replace(prop.Value)
+		case "DESCRIPTION":
+			todo.Description = textUnescaper.Replace(prop.Value)
+		case "RELATED-TO":
+			if reltype := prop.Params["RELTYPE"]; reltype == "" || strings.EqualFold(reltype, "PARENT") {
+				todo.RelatedTo = prop.Value
+			}
+		case "DUE":
+			todo.DueAt, err = parseTime(prop)
+		case "COMPLETED":
+			todo.CompletedAt, err = parseTime(prop)
+		case "STATUS":
+			isCompleted = strings.EqualFold(prop.Value, "COMPLETED")
+		}
+
+		if err != nil {
+			return TodoModel{}, false, fmt.Errorf("failed to parse %s of %s: %w", prop.Name, todo.UID, err)
+		}
+	}
+
+	// Some clients only set the status, the completion time is then unknown
+	if isCompleted && todo.CompletedAt.IsZero() {
+		todo.CompletedAt = time.Now()
+	}
+
+	return todo, found, nil
+}
+
+// todoLines are the properties of the VTODO we sync, with the names they
+// replace in an existing VTODO.
+func todoLines(task entity.Task, now time.Time) ([]string, map[string]bool) {
+	lines := []string{
+		"DTSTAMP:" + formatUTC(now),
+		"LAST-MODIFIED:" + formatUTC(now),
+		"SUMMARY:" + textEscaper.Replace(task.Name),
+	}
+	replaced := map[string]bool{"DTSTAMP": true, "LAST-MODIFIED": true, "SUMMARY": true, "DESCRIPTION": true}
+
+	if task.Description != "" {
+		lines = append(lines, "DESCRIPTION:"+textEscaper.Replace(task.Description))
+	}
+
+	// A due date can not be combined with a duration
+	if !task.DueAt.IsZero() {
+		lines = append(lines, formatDue(task.DueAt))
+		replaced["DUE"], replaced["DURATION"] = true, true
+	}
+
+	if !task.CompletedAt.IsZero() {
+		lines = append(lines, "STATUS:COMPLETED", "COMPLETED:"+formatUTC(task.CompletedAt), "PERCENT-COMPLETE:100")
+		replaced["STATUS"], replaced["COMPLETED"], replaced["PERCENT-COMPLETE"] = true, true, true
+	}
+
+	return lines, replaced
+}
+
+// newCalendar creates the calendar object of a task which is not on the
+// server yet, its UID is the task ID.
+func newCalendar(task entity.Task, now time.Time) string {
+	lines, _ := todoLines(task, now)
+	calendar := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//todo-cli//todo-cli//EN", "BEGIN:VTODO", "UID:" + task.ID}
+	calendar = append(calendar, lines...)
+	if task.ParentTaskID != "" {
+		calendar = append(calendar, "RELATED-TO;RELTYPE=PARENT:"+task.ParentTaskID)
+	}
+	calendar = append(calendar, "END:VTODO", "END:VCALENDAR")
+
+	return joinLines(calendar)
+}
+
+// patchCalendar replaces the synced properties of the VTODO in data and keeps
+// everything else, e.g. alarms and categories set on a phone.
+func patchCalendar(data string, task entity.Task, now time.Time) string {
+	lines, replaced := todoLines(task, now)
+	var calendar []string
+	inTodo, patched, depth := false, false, 0
+	for _, line := range unfold(data) {
+		prop := parseProperty(line)
+		switch {
+		case prop.Name == "BEGIN" && strings.EqualFold(prop.Value, "VTODO") && !patched:
+			inTodo, patched = true, true
+		case inTodo && prop.Name == "BEGIN":
+			depth++
+		case inTodo && prop.Name == "END" && depth > 0:
+			depth--
+		case inTodo && prop.Name == "END":
+			calendar = append(calendar, lines...)
+			inTodo = false
+		case inTodo && depth == 0 && replaced[prop.Name]:
+			continue
+		}
+
+		calendar = append(calendar, line)
+	}
+
+	return joinLines(calendar)
+}
+
+func joinLines(lines []string) string {
+	var data strings.Builder
+	for _, line := range lines {
+		data.WriteString(fold(line) + "\r\n")
+	}
+
+	return data.String()
+}
@@ -0,0 +1,438 @@
+package caldav
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/repository/secret"
+)
+
+const todoQuery = `<?xml version="1.0" encoding="utf-8"?>
+<c:calendar-query xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:prop><d:getetag/><c:calendar-data/></d:prop>
+  <c:filter><c:comp-filter name="VCALENDAR"><c:comp-filter name="VTODO">%s</c:comp-filter></c:comp-filter></c:filter>
+</c:calendar-query>`
+
+const uidFilter = `<c:prop-filter name="UID"><c:text-match collation="i;octet">%s</c:text-match></c:prop-filter>`
+
+var ErrConflict = errors.New("todo was changed on the server while syncing, sync again")
+
+type SecretRepo interface {
+	Get(ctx context.Context, key string) (string, error)
+}
+
+type TaskRepo interface {
+	GetAll(ctx context.Context) (entity.Tasks, error)
+}
+
+// RepoImpl syncs tasks both ways with the VTODOs of a CalDAV collection, e.g.
+// a Nextcloud or Radicale task list. Task IDs are the UIDs of their VTODO.
+//
+// Each synced task keeps the ETag of its VTODO and a checksum of the synced
+// fields as its integration revision, so a sync can tell which side changed.
+// A change on the server wins over a local one, but completing a task or
+// setting its due date is never undone by the other side.
+type RepoImpl struct {
+	taskRepo   TaskRepo
+	secretRepo SecretRepo
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+func New(taskRepo TaskRepo, secretRepo SecretRepo) *RepoImpl {
+	return &RepoImpl{
+		taskRepo:   taskRepo,
+		secretRepo: secretRepo,
+		httpClient: &http.Client{},
+		now:        time.Now,
+	}
+}
+
+func (ri *RepoImpl) Metadata() entity.IntegrationMetadata {
+	return entity.IntegrationMetadata{
+		Type:        entity.IntegrationTypeCalDAV,
+		Description: "Sync tasks both ways with a CalDAV task list, e.g. Nextcloud or Radicale",
+	}
+}
+
+func (ri *RepoImpl) Schema() []entity.IntegrationField {
+	return []entity.IntegrationField{
+		{
+			Key:   "url",
+			Label: "CalDAV Task List URL",
+			Help:  "The URL of the calendar holding the tasks, e.g. https://cloud.example.com/remote.php/dav/calendars/<user>/tasks/",
+		},
+		{Key: "username", Label: "CalDAV Username"},
+		{
+			Key:   "token",
+			Label: "CalDAV Password",
+			Help:  "Prefer an app password, e.g. from Nextcloud under Settings > Security.",
+			Type:  entity.IntegrationFieldSecret,
+		},
+	}
+}
+
+// GetTasks merges the VTODOs with the tasks of the project and returns the
+// merged tasks. Local changes are written to the server on the way, and open
+// tasks which are not synced anywhere yet are created on it. Completed VTODOs
+// are only synced when their task exists already.
+func (ri *RepoImpl) GetTasks(ctx context.Context, projectID string, integrationDetails map[string]string) (entity.Tasks, error) {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationDetails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve caldav credentials: %w", err)
+	}
+
+	todos, err := ri.getTodos(ctx, details, "")
+	if err != nil {
+		return nil, err
+	}
+
+	localTasks, err := ri.taskRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	syncedTasks := map[string]entity.Task{}
+	unsyncedIDs := map[string]bool{}
+	var unsyncedTasks entity.Tasks
+	for _, task := range localTasks {
+		if task.ProjectID != projectID {
+			continue
+		}
+
+		switch task.Integration.Type {
+		case entity.IntegrationTypeCalDAV:
+			syncedTasks[task.Integration.ID] = task
+		case "":
+			if task.CompletedAt.IsZero() {
+				unsyncedIDs[task.ID] = true
+				unsyncedTasks = append(unsyncedTasks, task)
+			}
+		}
+	}
+
+	remoteUIDs := map[string]bool{}
+	for _, todo := range todos {
+		remoteUIDs[todo.UID] = true
+	}
+
+	var tasks entity.Tasks
+	for _, todo := range todos {
+		localTask, ok := syncedTasks[todo.UID]
+		if !ok && unsyncedIDs[todo.UID] {
+			// The todo was created for the task by a sync which failed before
+			// the task was saved, so it is linked instead of created again
+			continue
+		}
+
+		if !ok {
+			if todo.IsCompleted() {
+				continue
+			}
+
+			task := todo.ToEntity(projectID)
+			if remoteUIDs[todo.RelatedTo] {
+				task.ParentTaskID = todo.RelatedTo
+			}
+			task.Integration.Revision = revision(task, todo.ETag)
+			tasks = append(tasks, task)
+			continue
+		}
+
+		task, err := ri.merge(ctx, details, localTask, todo)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	for _, task := range unsyncedTasks {
+		if remoteUIDs[task.ID] {
+			linkedTask, err := ri.link(ctx, details, task, todos)
+			if err != nil {
+				return nil, err
+			}
+
+			tasks = append(tasks, linkedTask)
+			continue
+		}
+
+		href, err := collectionURL(details["url"], url.PathEscape(task.ID)+".ics")
+		if err != nil {
+			return nil, err
+		}
+
+		etag, err := ri.put(ctx, details, href, newCalendar(task, ri.now()), "")
+		if errors.Is(err, ErrConflict) {
+			// Created in the meantime, by this sync running elsewhere
+			linkedTask, err := ri.linkCreated(ctx, details, task)
+			if err != nil {
+				return nil, err
+			}
+
+			tasks = append(tasks, linkedTask)
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create todo %s: %w", task.Name, err)
+		}
+
+		task.Integration = entity.TaskIntegration{
+			ID:       task.ID,
+			Type:     entity.IntegrationTypeCalDAV,
+			Revision: revision(task, etag),
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+// link ties the task to the todo of todos created for it, as if the sync
+// which created the todo had been saved, and merges them.
+func (ri *RepoImpl) link(ctx context.Context, details map[string]string, task entity.Task, todos []TodoModel) (entity.Task, error) {
+	for _, todo := range todos {
+		if todo.UID != task.ID {
+			continue
+		}
+
+		task.Integration = entity.TaskIntegration{
+			ID:       task.ID,
+			Type:     entity.IntegrationTypeCalDAV,
+			Revision: revision(todo.ToEntity(task.ProjectID), todo.ETag),
+		}
+
+		return ri.merge(ctx, details, task, todo)
+	}
+
+	return entity.Task{}, fmt.Errorf("failed to find todo %s", task.ID)
+}
+
+// linkCreated links the task to its todo when creating it failed because
+// it exists already.
+func (ri *RepoImpl) linkCreated(ctx context.Context, details map[string]string, task entity.Task) (entity.Task, error) {
+	todos, err := ri.getTodos(ctx, details, task.ID)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	return ri.link(ctx, details, task, todos)
+}
+
+// merge applies the side which changed since the last sync and writes the
+// result back to the server when it differs from the VTODO.
+func (ri *RepoImpl) merge(ctx context.Context, details map[string]string, localTask entity.Task, todo TodoModel) (entity.Task, error) {
+	task := localTask
+	sum, etag, _ := strings.Cut(localTask.Integration.Revision, " ")
+	if etag != todo.ETag {
+		task.Name, task.Description = todo.Summary, todo.Description
+		if !todo.DueAt.IsZero() {
+			task.DueAt = todo.DueAt
+		}
+
+		if task.CompletedAt.IsZero() {
+			task.CompletedAt = todo.CompletedAt
+		}
+	} else if sum == checksum(localTask) {
+		return task, nil
+	}
+
+	etag = todo.ETag
+	if checksum(task) != checksum(todo.ToEntity(task.ProjectID)) {
+		var err error
+		etag, err = ri.put(ctx, details, todo.Href, patchCalendar(todo.Data, task, ri.now()), todo.ETag)
+		if err != nil {
+			return entity.Task{}, fmt.Errorf("failed to update todo %s: %w", task.Name, err)
+		}
+	}
+
+	task.Integration.Revision = revision(task, etag)
+	return task, nil
+}
+
+// Transition completes the VTODO of the task right away, instead of on the
+// next sync.
+func (ri *RepoImpl) Transition(ctx context.Context, issueID string, integrationEntity entity.Integration) error {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationEntity.Details)
+	if err != nil {
+		return fmt.Errorf("failed to resolve caldav credentials: %w", err)
+	}
+
+	todos, err := ri.getTodos(ctx, details, issueID)
+	if err != nil {
+		return err
+	}
+
+	if len(todos) == 0 {
+		return fmt.Errorf("failed to find todo %s", issueID)
+	}
+
+	todo := todos[0]
+	if todo.IsCompleted() {
+		return nil
+	}
+
+	task := todo.ToEntity("")
+	task.CompletedAt = ri.now()
+	if _, err = ri.put(ctx, details, todo.Href, patchCalendar(todo.Data, task, ri.now()), todo.ETag); err != nil {
+		return fmt.Errorf("failed to complete todo: %w", err)
+	}
+
+	return nil
+}
+
+// getTodos returns the VTODOs of the collection, only the one with the UID
+// when it is given.
+func (ri *RepoImpl) getTodos(ctx context.Context, details map[string]string, uid string) ([]TodoModel, error) {
+	filter := ""
+	if uid != "" {
+		var escaped bytes.Buffer
+		xml.EscapeText(&escaped, []byte(uid))
+		filter = fmt.Sprintf(uidFilter, escaped.String())
+	}
+
+	resp, err := ri.do(ctx, details, "REPORT", details["url"], strings.NewReader(fmt.Sprintf(todoQuery, filter)), map[string]string{
+		"Content-Type": "application/xml; charset=utf-8",
+		"Depth":        "1",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query todos: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, fmt.Errorf("failed to query todos: unexpected status code %d", resp.StatusCode)
+	}
+
+	var multistatus MultistatusModel
+	if err = xml.NewDecoder(resp.Body).Decode(&multistatus); err != nil {
+		return nil, fmt.Errorf("failed to decode todos: %w", err)
+	}
+
+	var todos []TodoModel
+	for _, response := range multistatus.Responses {
+		for _, propstat := range response.Propstats {
+			if !strings.Contains(propstat.Status, " 200 ") || propstat.Prop.CalendarData == "" {
+				continue
+			}
+
+			todo, ok, err := parseTodo(propstat.Prop.CalendarData)
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok || todo.UID == "" {
+				continue
+			}
+
+			if todo.Href, err = collectionURL(details["url"], response.Href); err != nil {
+				return nil, err
+			}
+			todo.ETag = propstat.Prop.ETag
+			todos = append(todos, todo)
+		}
+	}
+
+	return todos, nil
+}
+
+// put writes a calendar object and returns its new ETag. Without ifMatch the
+// object is created, and the write fails when it exists already.
+func (ri *RepoImpl) put(ctx context.Context, details map[string]string, href, data, ifMatch string) (string, error) {
+	headers := map[string]string{"Content-Type": "text/calendar; charset=utf-8"}
+	if ifMatch != "" {
+		headers["If-Match"] = ifMatch
+	} else {
+		headers["If-None-Match"] = "*"
+	}
+
+	resp, err := ri.do(ctx, details, http.MethodPut, href, strings.NewReader(data), headers)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		return "", ErrConflict
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	// Servers which do not return the ETag are caught up with on the next sync
+	return resp.Header.Get("ETag"), nil
+}
+
+func (ri *RepoImpl) do(ctx context.Context, details map[string]string, method, target string, body io.Reader, headers map[string]string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.SetBasicAuth(details["username"], details["token"])
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := ri.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+		return nil, errors.New("invalid caldav username or password")
+	}
+
+	return resp, nil
+}
+
+// collectionURL resolves ref, a path returned by the server or the name of a
+// new object, against the collection.
+func collectionURL(collection, ref string) (string, error) {
+	base, err := url.Parse(collection)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse caldav url: %w", err)
+	}
+
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+
+	resolved, err := base.Parse(ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse todo url: %w", err)
+	}
+
+	return resolved.String(), nil
+}
+
+// checksum identifies the synced fields of a task. Only whether a task is
+// completed counts, servers keep the completion time in seconds.
+func checksum(task entity.Task) string {
+	due := ""
+	if !task.DueAt.IsZero() {
+		due = task.DueAt.UTC().Truncate(time.Second).Format(time.RFC3339)
+	}
+
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%s\x00%t", task.Name, task.Description, due, !task.CompletedAt.IsZero())))
+	return hex.EncodeToString(sum[:8])
+}
+
+func revision(task entity.Task, etag string) string {
+	return checksum(task) + " " + etag
+}
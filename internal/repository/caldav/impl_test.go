@@ -0,0 +1,428 @@
+package caldav
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type secretRepoStub struct {
+	secrets map[string]string
+}
+
+func (r *secretRepoStub) Get(ctx context.Context, key string) (string, error) {
+	value, ok := r.secrets[key]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+
+	return value, nil
+}
+
+type taskRepoStub struct {
+	tasks entity.Tasks
+}
+
+func (r *taskRepoStub) GetAll(ctx context.Context) (entity.Tasks, error) {
+	return r.tasks, nil
+}
+
+type calendarObject struct {
+	data string
+	etag string
+}
+
+// calDAVServer is an in-process stand-in for a CalDAV task list, it answers
+// calendar queries and conditional writes like Radicale does.
+type calDAVServer struct {
+	mu       sync.Mutex
+	objects  map[string]calendarObject
+	version  int
+	puts     int
+	username string
+	password string
+}
+
+var uidMatch = regexp.MustCompile(`<c:text-match[^>]*>([^<]*)</c:text-match>`)
+
+func (c *calDAVServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if username, password, ok := r.BasicAuth(); !ok || username != c.username || password != c.password {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch r.Method {
+	case "REPORT":
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get("Depth") != "1" || !strings.Contains(string(body), `<c:comp-filter name="VTODO">`) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		uid := ""
+		if match := uidMatch.FindStringSubmatch(string(body)); match != nil {
+			uid = match[1]
+		}
+
+		hrefs := make([]string, 0, len(c.objects))
+		for href := range c.objects {
+			hrefs = append(hrefs, href)
+		}
+		sort.Strings(hrefs)
+
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprint(w, `<?xml version="1.0"?><multistatus xmlns="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">`)
+		for _, href := range hrefs {
+			object := c.objects[href]
+			if uid != "" && !strings.Contains(object.data, "UID:"+uid+"\r\n") {
+				continue
+			}
+
+			fmt.Fprintf(w, `<response><href>%s</href><propstat><prop><getetag>%s</getetag><C:calendar-data>%s</C:calendar-data></prop><status>HTTP/1.1 200 OK</status></propstat></response>`, href, object.etag, object.data)
+		}
+		fmt.Fprint(w, `</multistatus>`)
+	case http.MethodPut:
+		object, exists := c.objects[r.URL.Path]
+		if r.Header.Get("If-None-Match") == "*" && exists || r.Header.Get("If-Match") != "" && r.Header.Get("If-Match") != object.etag {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		c.puts++
+		c.set(r.URL.Path, string(body))
+		w.Header().Set("ETag", c.objects[r.URL.Path].etag)
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (c *calDAVServer) set(href, data string) {
+	c.version++
+	c.objects[href] = calendarObject{data: data, etag: fmt.Sprintf(`"%d"`, c.version)}
+}
+
+func (c *calDAVServer) object(href string) calendarObject {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.objects[href]
+}
+
+func calendar(lines ...string) string {
+	return strings.Join(append(append([]string{"BEGIN:VCALENDAR", "VERSION:2.0", "BEGIN:VTODO"}, lines...), "END:VTODO", "END:VCALENDAR"), "\r\n") + "\r\n"
+}
+
+type RepoImplTestSuite struct {
+	suite.Suite
+	caldav   *calDAVServer
+	server   *httptest.Server
+	details  map[string]string
+	taskRepo *taskRepoStub
+	repoImpl *RepoImpl
+	now      time.Time
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	s.caldav = &calDAVServer{objects: map[string]calendarObject{}, username: "any-user", password: "any-password"}
+	s.server = httptest.NewServer(s.caldav)
+	s.details = map[string]string{"url": s.server.URL + "/dav/tasks", "username": "any-user", "token": "secret:caldav-token"}
+	s.taskRepo = &taskRepoStub{}
+	s.now = time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	s.repoImpl = New(s.taskRepo, &secretRepoStub{secrets: map[string]string{"caldav-token": "any-password"}})
+	s.repoImpl.now = func() time.Time { return s.now }
+}
+
+func (s *RepoImplTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+// synced returns the task as the previous sync stored it with the object.
+func (s *RepoImplTestSuite) synced(task entity.Task, href string) entity.Task {
+	task.Integration = entity.TaskIntegration{ID: task.ID, Type: entity.IntegrationTypeCalDAV, Revision: revision(task, s.caldav.object(href).etag)}
+	return task
+}
+
+func (s *RepoImplTestSuite) TestGetTasksPullsTodos() {
+	dueAt := time.Date(2024, 1, 31, 0, 0, 0, 0, time.Local)
+	s.caldav.set("/dav/tasks/parent.ics", calendar("UID:parent", "SUMMARY:Buy groceries", "DESCRIPTION:milk\\, eggs\\nbread", "DUE;VALUE=DATE:20240131"))
+	s.caldav.set("/dav/tasks/child.ics", calendar("UID:child", "SUMMARY:Milk", "RELATED-TO:parent", "BEGIN:VALARM", "SUMMARY:Alarm", "END:VALARM"))
+	s.caldav.set("/dav/tasks/orphan.ics", calendar("UID:orphan", "SUMMARY:Orphan", "RELATED-TO:elsewhere"))
+	s.caldav.set("/dav/tasks/done.ics", calendar("UID:done", "SUMMARY:Done long ago", "STATUS:COMPLETED", "COMPLETED:20230101T100000Z"))
+
+	tasks, err := s.repoImpl.GetTasks(context.Background(), "project-1", s.details)
+
+	s.NoError(err)
+	s.Equal(0, s.caldav.puts)
+	s.Equal(entity.Tasks{
+		{ID: "child", ProjectID: "project-1", Name: "Milk", ParentTaskID: "parent", Integration: entity.TaskIntegration{ID: "child", Type: entity.IntegrationTypeCalDAV}},
+		{ID: "orphan", ProjectID: "project-1", Name: "Orphan", Integration: entity.TaskIntegration{ID: "orphan", Type: entity.IntegrationTypeCalDAV}},
+		{ID: "parent", ProjectID: "project-1", Name: "Buy groceries", Description: "milk, eggs\nbread", DueAt: dueAt, Integration: entity.TaskIntegration{ID: "parent", Type: entity.IntegrationTypeCalDAV}},
+	}, withoutRevisions(tasks))
+}
+
+func (s *RepoImplTestSuite) TestGetTasksPushesLocalTasks() {
+	s.taskRepo.tasks = entity.Tasks{
+		{ID: "local-1", ProjectID: "project-1", Name: "Write report", Description: "for Q1", DueAt: time.Date(2024, 1, 5, 17, 30, 0, 0, time.UTC)},
+		{ID: "local-2", ProjectID: "project-1", Name: "Outline", ParentTaskID: "local-1"},
+		{ID: "local-3", ProjectID: "project-1", Name: "Completed", CompletedAt: s.now},
+		{ID: "jira-1", ProjectID: "project-1", Name: "From JIRA", Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA}},
+		{ID: "other-1", ProjectID: "project-2", Name: "Other project"},
+	}
+
+	tasks, err := s.repoImpl.GetTasks(context.Background(), "project-1", s.details)
+
+	s.NoError(err)
+	s.Len(tasks, 2)
+	s.Equal(entity.TaskIntegration{ID: "local-1", Type: entity.IntegrationTypeCalDAV, Revision: revision(s.taskRepo.tasks[0], `"1"`)}, tasks[0].Integration)
+	s.Equal(`"2"`, strings.Fields(tasks[1].Integration.Revision)[1])
+
+	todo, ok, err := parseTodo(s.caldav.object("/dav/tasks/local-1.ics").data)
+	s.NoError(err)
+	s.True(ok)
+	s.Equal("Write report", todo.Summary)
+	s.Equal("for Q1", todo.Description)
+	s.True(todo.DueAt.Equal(time.Date(2024, 1, 5, 17, 30, 0, 0, time.UTC)))
+	s.Contains(s.caldav.object("/dav/tasks/local-2.ics").data, "RELATED-TO;RELTYPE=PARENT:local-1\r\n")
+
+	// Pushed tasks are synced from now on, nothing changed so nothing is written
+	for i := range tasks {
+		s.taskRepo.tasks[i] = tasks[i]
+	}
+	tasks, err = s.repoImpl.GetTasks(context.Background(), "project-1", s.details)
+
+	s.NoError(err)
+	s.Len(tasks, 2)
+	s.Equal(2, s.caldav.puts)
+}
+
+func (s *RepoImplTestSuite) TestGetTasksPushesLocalChanges() {
+	s.caldav.set("/dav/tasks/todo-1.ics", calendar("UID:todo-1", "SUMMARY:Old name", "CATEGORIES:home", "BEGIN:VALARM", "TRIGGER:-PT15M", "DESCRIPTION:Reminder", "END:VALARM"))
+	localTask := s.synced(entity.Task{ID: "todo-1", ProjectID: "project-1", Name: "Old name"}, "/dav/tasks/todo-1.ics")
+	localTask.Name = "New name"
+	localTask.Description = "Added here"
+	localTask.DueAt = time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local)
+	localTask.CompletedAt = s.now
+	localTask.Histories = []entity.TaskHistory{{StartedAt: s.now.Add(-time.Hour), StoppedAt: s.now}}
+	s.taskRepo.tasks = entity.Tasks{localTask}
+
+	tasks, err := s.repoImpl.GetTasks(context.Background(), "project-1", s.details)
+
+	s.NoError(err)
+	s.Equal(1, s.caldav.puts)
+	object := s.caldav.object("/dav/tasks/todo-1.ics")
+	expectedTask := localTask
+	expectedTask.Integration.Revision = revision(localTask, object.etag)
+	s.Equal(entity.Tasks{expectedTask}, tasks)
+
+	todo, _, _ := parseTodo(object.data)
+	s.Equal("New name", todo.Summary)
+	s.Equal("Added here", todo.Description)
+	s.True(todo.DueAt.Equal(localTask.DueAt))
+	s.True(todo.IsCompleted())
+	s.Contains(object.data, "DUE;VALUE=DATE:20240201\r\n")
+	s.Contains(object.data, "CATEGORIES:home\r\n")
+	s.Contains(object.data, "BEGIN:VALARM\r\nTRIGGER:-PT15M\r\nDESCRIPTION:Reminder\r\nEND:VALARM\r\n")
+}
+
+func (s *RepoImplTestSuite) TestGetTasksPullsRemoteChanges() {
+	localTask := entity.Task{ID: "todo-1", ProjectID: "project-1", Name: "Old name", DueAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local)}
+	s.caldav.set("/dav/tasks/todo-1.ics", calendar("UID:todo-1", "SUMMARY:Old name", "DUE;VALUE=DATE:20240201"))
+	localTask = s.synced(localTask, "/dav/tasks/todo-1.ics")
+	s.caldav.set("/dav/tasks/todo-1.ics", calendar("UID:todo-1", "SUMMARY:Renamed on the phone", "DESCRIPTION:Details", "STATUS:COMPLETED", "COMPLETED:20240102T080000Z"))
+	s.taskRepo.tasks = entity.Tasks{localTask}
+
+	tasks, err := s.repoImpl.GetTasks(context.Background(), "project-1", s.details)
+
+	s.NoError(err)
+	s.Len(tasks, 1)
+	s.Equal("Renamed on the phone", tasks[0].Name)
+	s.Equal("Details", tasks[0].Description)
+	s.Equal(time.Date(2024, 1, 2, 8, 0, 0, 0, time.UTC), tasks[0].CompletedAt)
+
+	// The due date cleared on the phone is kept and written back
+	s.Equal(localTask.DueAt, tasks[0].DueAt)
+	s.Equal(1, s.caldav.puts)
+	s.Contains(s.caldav.object("/dav/tasks/todo-1.ics").data, "DUE;VALUE=DATE:20240201\r\n")
+	s.Equal(revision(tasks[0], s.caldav.object("/dav/tasks/todo-1.ics").etag), tasks[0].Integration.Revision)
+}
+
+func (s *RepoImplTestSuite) TestGetTasksKeepsLocalCompletion() {
+	s.caldav.set("/dav/tasks/todo-1.ics", calendar("UID:todo-1", "SUMMARY:Task"))
+	localTask := s.synced(entity.Task{ID: "todo-1", ProjectID: "project-1", Name: "Task"}, "/dav/tasks/todo-1.ics")
+	localTask.CompletedAt = s.now
+	s.caldav.set("/dav/tasks/todo-1.ics", calendar("UID:todo-1", "SUMMARY:Task renamed"))
+	s.taskRepo.tasks = entity.Tasks{localTask}
+
+	tasks, err := s.repoImpl.GetTasks(context.Background(), "project-1", s.details)
+
+	s.NoError(err)
+	s.Equal("Task renamed", tasks[0].Name)
+	s.Equal(s.now, tasks[0].CompletedAt)
+	todo, _, _ := parseTodo(s.caldav.object("/dav/tasks/todo-1.ics").data)
+	s.Equal("Task renamed", todo.Summary)
+	s.True(todo.IsCompleted())
+}
+
+func (s *RepoImplTestSuite) TestGetTasksConflict() {
+	s.caldav.set("/dav/tasks/todo-1.ics", calendar("UID:todo-1", "SUMMARY:Task"))
+	localTask := s.synced(entity.Task{ID: "todo-1", ProjectID: "project-1", Name: "Task"}, "/dav/tasks/todo-1.ics")
+	localTask.Name = "Renamed here"
+	s.taskRepo.tasks = entity.Tasks{localTask}
+	s.repoImpl.httpClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		// The todo changes between the query and the write
+		if req.Method == http.MethodPut {
+			s.caldav.mu.Lock()
+			s.caldav.set("/dav/tasks/todo-1.ics", calendar("UID:todo-1", "SUMMARY:Renamed on the phone"))
+			s.caldav.mu.Unlock()
+		}
+
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	_, err := s.repoImpl.GetTasks(context.Background(), "project-1", s.details)
+
+	s.ErrorIs(err, ErrConflict)
+}
+
+func (s *RepoImplTestSuite) TestGetTasksLinksCreatedTodos() {
+	s.taskRepo.tasks = entity.Tasks{{ID: "local-1", ProjectID: "project-1", Name: "Write report"}}
+
+	// The todo is created but the synced task is never saved
+	_, err := s.repoImpl.GetTasks(context.Background(), "project-1", s.details)
+	s.NoError(err)
+	s.taskRepo.tasks[0].Name = "Write the report"
+	s.taskRepo.tasks[0].Histories = []entity.TaskHistory{{StartedAt: s.now.Add(-time.Hour), StoppedAt: s.now}}
+
+	tasks, err := s.repoImpl.GetTasks(context.Background(), "project-1", s.details)
+
+	s.NoError(err)
+	s.Equal(2, s.caldav.puts)
+	object := s.caldav.object("/dav/tasks/local-1.ics")
+	expectedTask := s.taskRepo.tasks[0]
+	expectedTask.Integration = entity.TaskIntegration{ID: "local-1", Type: entity.IntegrationTypeCalDAV, Revision: revision(expectedTask, object.etag)}
+	s.Equal(entity.Tasks{expectedTask}, tasks)
+	s.Contains(object.data, "SUMMARY:Write the report\r\n")
+}
+
+func (s *RepoImplTestSuite) TestGetTasksLinksTodosCreatedMeanwhile() {
+	s.taskRepo.tasks = entity.Tasks{{ID: "local-1", ProjectID: "project-1", Name: "Write report"}}
+	s.repoImpl.httpClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		// The todo is created by another sync between the query and the write
+		if req.Method == http.MethodPut && req.Header.Get("If-None-Match") == "*" {
+			s.caldav.mu.Lock()
+			s.caldav.set("/dav/tasks/local-1.ics", calendar("UID:local-1", "SUMMARY:Write report"))
+			s.caldav.mu.Unlock()
+		}
+
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	tasks, err := s.repoImpl.GetTasks(context.Background(), "project-1", s.details)
+
+	s.NoError(err)
+	s.Equal(0, s.caldav.puts)
+	s.Equal(entity.Tasks{s.synced(s.taskRepo.tasks[0], "/dav/tasks/local-1.ics")}, tasks)
+}
+
+func (s *RepoImplTestSuite) TestGetTasksUnauthorized() {
+	s.details["username"] = "someone-else"
+
+	_, err := s.repoImpl.GetTasks(context.Background(), "project-1", s.details)
+
+	s.EqualError(err, "failed to query todos: invalid caldav username or password")
+}
+
+func (s *RepoImplTestSuite) TestTransition() {
+	s.caldav.set("/dav/tasks/other.ics", calendar("UID:other", "SUMMARY:Other"))
+	s.caldav.set("/dav/tasks/todo-1.ics", calendar("UID:todo-1", "SUMMARY:Task", "STATUS:NEEDS-ACTION", "PERCENT-COMPLETE:40"))
+
+	err := s.repoImpl.Transition(context.Background(), "todo-1", entity.Integration{Type: entity.IntegrationTypeCalDAV, Details: s.details})
+
+	s.NoError(err)
+	todo, _, _ := parseTodo(s.caldav.object("/dav/tasks/todo-1.ics").data)
+	s.Equal(s.now, todo.CompletedAt)
+	s.Equal("Task", todo.Summary)
+	s.NotContains(s.caldav.object("/dav/tasks/todo-1.ics").data, "NEEDS-ACTION")
+	s.NotContains(s.caldav.object("/dav/tasks/other.ics").data, "COMPLETED")
+
+	err = s.repoImpl.Transition(context.Background(), "missing", entity.Integration{Type: entity.IntegrationTypeCalDAV, Details: s.details})
+
+	s.EqualError(err, "failed to find todo missing")
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func withoutRevisions(tasks entity.Tasks) entity.Tasks {
+	for i := range tasks {
+		tasks[i].Integration.Revision = ""
+	}
+
+	return tasks
+}
+
+func TestFold(t *testing.T) {
+	line := "DESCRIPTION:" + strings.Repeat("é", 60)
+
+	folded := fold(line)
+
+	for _, part := range strings.Split(folded, "\r\n") {
+		if len(part) > icalLineLength {
+			t.Fatalf("line of %d octets: %q", len(part), part)
+		}
+	}
+
+	if unfolded := unfold(folded); len(unfolded) != 1 || unfolded[0] != line {
+		t.Fatalf("unfolded to %q", unfolded)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	tests := []struct {
+		line     string
+		expected time.Time
+	}{
+		{line: "DUE;VALUE=DATE:20240131", expected: time.Date(2024, 1, 31, 0, 0, 0, 0, time.Local)},
+		{line: "DUE:20240131T100000Z", expected: time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)},
+		{line: `DUE;TZID="Europe/Berlin":20240131T100000`, expected: time.Date(2024, 1, 31, 10, 0, 0, 0, berlin)},
+		{line: "DUE:20240131T100000", expected: time.Date(2024, 1, 31, 10, 0, 0, 0, time.Local)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.line, func(t *testing.T) {
+			parsed, err := parseTime(parseProperty(test.line))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !parsed.Equal(test.expected) {
+				t.Fatalf("parsed %s, expected %s", parsed, test.expected)
+			}
+		})
+	}
+}
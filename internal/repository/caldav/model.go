@@ -0,0 +1,59 @@
+package caldav
+
+import (
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+type MultistatusModel struct {
+	Responses []ResponseModel `xml:"response"`
+}
+
+type ResponseModel struct {
+	Href      string          `xml:"href"`
+	Propstats []PropstatModel `xml:"propstat"`
+}
+
+type PropstatModel struct {
+	Status string    `xml:"status"`
+	Prop   PropModel `xml:"prop"`
+}
+
+type PropModel struct {
+	ETag         string `xml:"getetag"`
+	CalendarData string `xml:"calendar-data"`
+}
+
+// TodoModel is a VTODO of the collection, Data is the calendar object it was
+// read from so it can be updated without losing what we do not sync.
+type TodoModel struct {
+	Href        string
+	ETag        string
+	UID         string
+	Summary     string
+	Description string
+	RelatedTo   string
+	DueAt       time.Time
+	CompletedAt time.Time
+	Data        string
+}
+
+func (tm TodoModel) IsCompleted() bool {
+	return !tm.CompletedAt.IsZero()
+}
+
+func (tm TodoModel) ToEntity(projectID string) entity.Task {
+	return entity.Task{
+		ID:          tm.UID,
+		ProjectID:   projectID,
+		Name:        tm.Summary,
+		Description: tm.Description,
+		DueAt:       tm.DueAt,
+		CompletedAt: tm.CompletedAt,
+		Integration: entity.TaskIntegration{
+			ID:   tm.UID,
+			Type: entity.IntegrationTypeCalDAV,
+		},
+	}
+}
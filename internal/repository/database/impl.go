@@ -0,0 +1,42 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+type RepoImpl struct {
+	localPath  string
+	remotePath string
+}
+
+func New(localPath, remotePath string) *RepoImpl {
+	return &RepoImpl{
+		localPath:  localPath,
+		remotePath: remotePath,
+	}
+}
+
+func (ri *RepoImpl) ReadLocal(ctx context.Context) ([]byte, error) {
+	content, err := os.ReadFile(ri.localPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read database: %w", err)
+	}
+
+	return content, nil
+}
+
+// WriteRemote keeps the downloaded database readable by the owner only,
+// including a copy written with wider permissions by older versions.
+func (ri *RepoImpl) WriteRemote(ctx context.Context, content []byte) error {
+	if err := os.WriteFile(ri.remotePath, content, 0600); err != nil {
+		return fmt.Errorf("failed to write database: %w", err)
+	}
+
+	if err := os.Chmod(ri.remotePath, 0600); err != nil {
+		return fmt.Errorf("failed to restrict database permissions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package database
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RepoImplTestSuite struct {
+	suite.Suite
+	localPath  string
+	remotePath string
+	repoImpl   *RepoImpl
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	dir := s.T().TempDir()
+	s.localPath = filepath.Join(dir, ".todo-cli.db")
+	s.remotePath = filepath.Join(dir, ".todo-cli-remote.db")
+	s.repoImpl = New(s.localPath, s.remotePath)
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+func (s *RepoImplTestSuite) TestReadLocal() {
+	_, err := s.repoImpl.ReadLocal(context.Background())
+	s.ErrorIs(err, os.ErrNotExist)
+
+	s.NoError(os.WriteFile(s.localPath, []byte("any-database"), 0644))
+	content, err := s.repoImpl.ReadLocal(context.Background())
+	s.NoError(err)
+	s.Equal([]byte("any-database"), content)
+}
+
+func (s *RepoImplTestSuite) TestWriteRemote() {
+	s.NoError(os.WriteFile(s.remotePath, []byte("old-database"), 0644))
+
+	s.NoError(s.repoImpl.WriteRemote(context.Background(), []byte("any-database")))
+
+	content, err := os.ReadFile(s.remotePath)
+	s.NoError(err)
+	s.Equal([]byte("any-database"), content)
+	info, err := os.Stat(s.remotePath)
+	s.NoError(err)
+	s.Equal(os.FileMode(0600), info.Mode().Perm())
+}
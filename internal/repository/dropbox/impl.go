@@ -1,16 +1,17 @@
 package dropbox
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"os"
 	"strings"
 
 	syncintegrationDomain "github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
+	"github.com/azisuazusa/todo-cli/internal/repository/secret"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox"
 	"github.com/dropbox/dropbox-sdk-go-unofficial/v6/dropbox/files"
 )
@@ -21,73 +22,71 @@ type SyncIntegrationRepo interface {
 	SetSyncIntegration(ctx context.Context, integration syncintegrationDomain.SyncIntegration) error
 }
 
+type SecretRepo interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key, value string) error
+}
+
 type RepoImpl struct {
 	syncIntegrationRepo SyncIntegrationRepo
+	secretRepo          SecretRepo
 }
 
-func New(syncIntegrationRepo SyncIntegrationRepo) *RepoImpl {
+func New(syncIntegrationRepo SyncIntegrationRepo, secretRepo SecretRepo) *RepoImpl {
 	return &RepoImpl{
 		syncIntegrationRepo: syncIntegrationRepo,
+		secretRepo:          secretRepo,
 	}
 }
 
-func (ri *RepoImpl) Download(ctx context.Context, integrationEntity syncintegrationDomain.SyncIntegration) error {
+func (ri *RepoImpl) Download(ctx context.Context, integrationEntity syncintegrationDomain.SyncIntegration) ([]byte, error) {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationEntity.Details)
+	if err != nil {
+		return nil, fmt.Errorf("error while resolving dropbox credentials: %w", err)
+	}
+
 	dbxCfg := dropbox.Config{
-		Token: integrationEntity.Details["token"],
+		Token: details["token"],
 	}
 	fileDbx := files.New(dbxCfg)
 	downloadArg := files.NewDownloadArg("/.todo-cli.db")
 	_, content, err := fileDbx.Download(downloadArg)
 	if err != nil && !strings.Contains(err.Error(), "expired") {
-		return fmt.Errorf("error while downloading file: %w", err)
+		return nil, fmt.Errorf("error while downloading file: %w", err)
 	}
 
 	if err != nil && strings.Contains(err.Error(), "expired") {
 		err = ri.refreshToken(ctx, integrationEntity)
 		if err != nil {
-			return fmt.Errorf("error while refreshing token: %w", err)
+			return nil, fmt.Errorf("error while refreshing token: %w", err)
 		}
 
 		return ri.Download(ctx, integrationEntity)
 	}
+	defer content.Close()
 
 	contentBytes, err := io.ReadAll(content)
 	if err != nil {
-		return fmt.Errorf("error while reading file content: %w", err)
+		return nil, fmt.Errorf("error while reading file content: %w", err)
 	}
 
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("error while getting user home dir: %w", err)
-	}
+	return contentBytes, nil
+}
 
-	err = os.WriteFile(homeDir+"/.todo-cli-remote.db", contentBytes, 0644)
+func (ri *RepoImpl) Upload(ctx context.Context, integrationEntity syncintegrationDomain.SyncIntegration, content []byte) error {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationEntity.Details)
 	if err != nil {
-		return fmt.Errorf("error while writing file: %w", err)
+		return fmt.Errorf("error while resolving dropbox credentials: %w", err)
 	}
 
-	return nil
-}
-
-func (ri *RepoImpl) Upload(ctx context.Context, integrationEntity syncintegrationDomain.SyncIntegration) error {
 	dbxCfg := dropbox.Config{
-		Token: integrationEntity.Details["token"],
+		Token: details["token"],
 	}
 	fileDbx := files.New(dbxCfg)
 
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("error while getting user home dir: %w", err)
-	}
-
-	file, err := os.Open(homeDir + "/.todo-cli.db")
-	if err != nil {
-		return fmt.Errorf("error while opening file: %w", err)
-	}
-
 	uploadArg := files.NewUploadArg("/.todo-cli.db")
 	uploadArg.CommitInfo.Mode.Tag = files.WriteModeOverwrite
-	_, err = fileDbx.Upload(uploadArg, file)
+	_, err = fileDbx.Upload(uploadArg, bytes.NewReader(content))
 	if err != nil && !strings.Contains(err.Error(), "expired") {
 		return fmt.Errorf("error while uploading file: %w", err)
 	}
@@ -98,16 +97,21 @@ func (ri *RepoImpl) Upload(ctx context.Context, integrationEntity syncintegratio
 			return fmt.Errorf("error while refreshing token: %w", err)
 		}
 
-		return ri.Upload(ctx, integrationEntity)
+		return ri.Upload(ctx, integrationEntity, content)
 	}
 
 	return err
 }
 
 func (ri *RepoImpl) refreshToken(ctx context.Context, integrationEntity syncintegrationDomain.SyncIntegration) error {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationEntity.Details)
+	if err != nil {
+		return fmt.Errorf("error while resolving dropbox credentials: %w", err)
+	}
+
 	data := url.Values{}
 	data.Set("grant_type", "refresh_token")
-	data.Set("refresh_token", integrationEntity.Details["refresh_token"])
+	data.Set("refresh_token", details["refresh_token"])
 	data.Set("client_id", DROPBOX_API_KEY)
 
 	req, err := http.NewRequest("POST", "https://api.dropbox.com/oauth2/token", nil)
@@ -144,8 +148,10 @@ func (ri *RepoImpl) refreshToken(ctx context.Context, integrationEntity syncinte
 		return fmt.Errorf("error while unmarshalling response: %w", err)
 	}
 
-	integrationEntity.Details["token"] = dropboxTokenResponse.AccessToken
-	integrationEntity.Details["refresh_token"] = integrationEntity.Details["refresh_token"]
+	if err = secret.StoreDetail(ctx, ri.secretRepo, integrationEntity.Details, "token", dropboxTokenResponse.AccessToken); err != nil {
+		return fmt.Errorf("error while setting dropbox token: %w", err)
+	}
+
 	integrationEntity.Details["token_type"] = dropboxTokenResponse.TokenType
 	integrationEntity.Details["expires_in"] = fmt.Sprintf("%d", dropboxTokenResponse.ExpiresIn)
 
@@ -0,0 +1,163 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	syncintegrationDomain "github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
+	"github.com/azisuazusa/todo-cli/internal/repository/secret"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	saltSize = 16
+	keySize  = 32
+)
+
+// header marks an encrypted database, followed by the salt, the nonce and the
+// AES-GCM ciphertext.
+var header = []byte("todo-cli-encrypted-v1\n")
+
+type SecretRepo interface {
+	Get(ctx context.Context, key string) (string, error)
+}
+
+type RepoImpl struct {
+	secretRepo SecretRepo
+}
+
+func New(secretRepo SecretRepo) *RepoImpl {
+	return &RepoImpl{
+		secretRepo: secretRepo,
+	}
+}
+
+func (ri *RepoImpl) Encrypt(ctx context.Context, integration syncintegrationDomain.SyncIntegration, content []byte) ([]byte, error) {
+	if integration.Details[syncintegrationDomain.EncryptionDetailKey] == "" {
+		return content, nil
+	}
+
+	keyMaterial, err := ri.keyMaterial(ctx, integration)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err = io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	gcm, err := newGCM(keyMaterial, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	encrypted := make([]byte, 0, len(header)+len(salt)+len(nonce)+len(content)+gcm.Overhead())
+	encrypted = append(encrypted, header...)
+	encrypted = append(encrypted, salt...)
+	encrypted = append(encrypted, nonce...)
+	return gcm.Seal(encrypted, nonce, content, header), nil
+}
+
+// Decrypt returns content unchanged when it was uploaded without encryption
+// and no encryption is configured. With encryption configured a plaintext
+// database is refused, it could have been replaced by anyone with access to
+// the remote storage.
+func (ri *RepoImpl) Decrypt(ctx context.Context, integration syncintegrationDomain.SyncIntegration, content []byte) ([]byte, error) {
+	if !bytes.HasPrefix(content, header) {
+		if integration.Details[syncintegrationDomain.EncryptionDetailKey] != "" {
+			return nil, syncintegrationDomain.ErrUnencryptedDatabase
+		}
+
+		return content, nil
+	}
+
+	if integration.Details[syncintegrationDomain.EncryptionDetailKey] == "" {
+		return nil, syncintegrationDomain.ErrEncryptionKeyRequired
+	}
+
+	keyMaterial, err := ri.keyMaterial(ctx, integration)
+	if err != nil {
+		return nil, err
+	}
+
+	content = content[len(header):]
+	if len(content) < saltSize {
+		return nil, syncintegrationDomain.ErrInvalidEncryptionKey
+	}
+
+	salt, content := content[:saltSize], content[saltSize:]
+	gcm, err := newGCM(keyMaterial, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) < gcm.NonceSize() {
+		return nil, syncintegrationDomain.ErrInvalidEncryptionKey
+	}
+
+	nonce, content := content[:gcm.NonceSize()], content[gcm.NonceSize():]
+	decrypted, err := gcm.Open(nil, nonce, content, header)
+	if err != nil {
+		return nil, syncintegrationDomain.ErrInvalidEncryptionKey
+	}
+
+	return decrypted, nil
+}
+
+func (ri *RepoImpl) keyMaterial(ctx context.Context, integration syncintegrationDomain.SyncIntegration) ([]byte, error) {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integration.Details)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve encryption key: %w", err)
+	}
+
+	var keyMaterial []byte
+	switch details[syncintegrationDomain.EncryptionDetailKey] {
+	case syncintegrationDomain.EncryptionPassphrase:
+		keyMaterial = []byte(details[syncintegrationDomain.EncryptionPassphraseDetailKey])
+	case syncintegrationDomain.EncryptionKeyFile:
+		keyMaterial, err = os.ReadFile(details[syncintegrationDomain.EncryptionKeyFileDetailKey])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key file: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", syncintegrationDomain.ErrUnsupportedEncryption, details[syncintegrationDomain.EncryptionDetailKey])
+	}
+
+	if strings.TrimSpace(string(keyMaterial)) == "" {
+		return nil, syncintegrationDomain.ErrEncryptionKeyRequired
+	}
+
+	return keyMaterial, nil
+}
+
+func newGCM(keyMaterial, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(keyMaterial, salt, 1<<15, 8, 1, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	return gcm, nil
+}
@@ -0,0 +1,180 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	syncintegrationDomain "github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
+	"github.com/stretchr/testify/suite"
+)
+
+type secretRepoStub struct {
+	secrets map[string]string
+}
+
+func (r *secretRepoStub) Get(ctx context.Context, key string) (string, error) {
+	value, ok := r.secrets[key]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+
+	return value, nil
+}
+
+type RepoImplTestSuite struct {
+	suite.Suite
+	keyFile  string
+	repoImpl *RepoImpl
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	s.keyFile = filepath.Join(s.T().TempDir(), "sync.key")
+	s.NoError(os.WriteFile(s.keyFile, []byte("any-key-file-content"), 0600))
+	s.repoImpl = New(&secretRepoStub{secrets: map[string]string{
+		"sync/dropbox/encryption_passphrase": "stored-passphrase",
+	}})
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+func integration(details map[string]string) syncintegrationDomain.SyncIntegration {
+	return syncintegrationDomain.SyncIntegration{
+		Type:    syncintegrationDomain.Dropbox,
+		Details: details,
+	}
+}
+
+func (s *RepoImplTestSuite) TestEncryptAndDecrypt() {
+	tests := []struct {
+		name    string
+		details map[string]string
+	}{
+		{
+			name: "passphrase",
+			details: map[string]string{
+				syncintegrationDomain.EncryptionDetailKey:           syncintegrationDomain.EncryptionPassphrase,
+				syncintegrationDomain.EncryptionPassphraseDetailKey: "any-passphrase",
+			},
+		},
+		{
+			name: "passphrase reference",
+			details: map[string]string{
+				syncintegrationDomain.EncryptionDetailKey:           syncintegrationDomain.EncryptionPassphrase,
+				syncintegrationDomain.EncryptionPassphraseDetailKey: "secret:sync/dropbox/encryption_passphrase",
+			},
+		},
+		{
+			name: "key file",
+			details: map[string]string{
+				syncintegrationDomain.EncryptionDetailKey:        syncintegrationDomain.EncryptionKeyFile,
+				syncintegrationDomain.EncryptionKeyFileDetailKey: "",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			if test.details[syncintegrationDomain.EncryptionDetailKey] == syncintegrationDomain.EncryptionKeyFile {
+				test.details[syncintegrationDomain.EncryptionKeyFileDetailKey] = s.keyFile
+			}
+
+			encrypted, err := s.repoImpl.Encrypt(context.Background(), integration(test.details), []byte("any-database"))
+			s.NoError(err)
+			s.False(bytes.Contains(encrypted, []byte("any-database")))
+
+			decrypted, err := s.repoImpl.Decrypt(context.Background(), integration(test.details), encrypted)
+			s.NoError(err)
+			s.Equal([]byte("any-database"), decrypted)
+		})
+	}
+}
+
+func (s *RepoImplTestSuite) TestEncryptDisabled() {
+	encrypted, err := s.repoImpl.Encrypt(context.Background(), integration(map[string]string{}), []byte("any-database"))
+
+	s.NoError(err)
+	s.Equal([]byte("any-database"), encrypted)
+}
+
+func (s *RepoImplTestSuite) TestDecrypt() {
+	encrypted, err := s.repoImpl.Encrypt(context.Background(), integration(map[string]string{
+		syncintegrationDomain.EncryptionDetailKey:           syncintegrationDomain.EncryptionPassphrase,
+		syncintegrationDomain.EncryptionPassphraseDetailKey: "any-passphrase",
+	}), []byte("any-database"))
+	s.NoError(err)
+
+	tests := []struct {
+		name        string
+		details     map[string]string
+		content     []byte
+		expected    []byte
+		expectedErr error
+	}{
+		{
+			name:     "plaintext database",
+			details:  map[string]string{},
+			content:  []byte("any-database"),
+			expected: []byte("any-database"),
+		},
+		{
+			name: "plaintext database with encryption configured",
+			details: map[string]string{
+				syncintegrationDomain.EncryptionDetailKey:           syncintegrationDomain.EncryptionPassphrase,
+				syncintegrationDomain.EncryptionPassphraseDetailKey: "any-passphrase",
+			},
+			content:     []byte("any-database"),
+			expectedErr: syncintegrationDomain.ErrUnencryptedDatabase,
+		},
+		{
+			name:        "encryption not configured",
+			details:     map[string]string{},
+			content:     encrypted,
+			expectedErr: syncintegrationDomain.ErrEncryptionKeyRequired,
+		},
+		{
+			name: "wrong passphrase",
+			details: map[string]string{
+				syncintegrationDomain.EncryptionDetailKey:           syncintegrationDomain.EncryptionPassphrase,
+				syncintegrationDomain.EncryptionPassphraseDetailKey: "other-passphrase",
+			},
+			content:     encrypted,
+			expectedErr: syncintegrationDomain.ErrInvalidEncryptionKey,
+		},
+		{
+			name: "truncated database",
+			details: map[string]string{
+				syncintegrationDomain.EncryptionDetailKey:           syncintegrationDomain.EncryptionPassphrase,
+				syncintegrationDomain.EncryptionPassphraseDetailKey: "any-passphrase",
+			},
+			content:     header,
+			expectedErr: syncintegrationDomain.ErrInvalidEncryptionKey,
+		},
+		{
+			name: "unsupported encryption",
+			details: map[string]string{
+				syncintegrationDomain.EncryptionDetailKey: "any-encryption",
+			},
+			content:     encrypted,
+			expectedErr: syncintegrationDomain.ErrUnsupportedEncryption,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			decrypted, err := s.repoImpl.Decrypt(context.Background(), integration(test.details), test.content)
+			if test.expectedErr != nil {
+				s.ErrorIs(err, test.expectedErr)
+				return
+			}
+
+			s.NoError(err)
+			s.Equal(test.expected, decrypted)
+		})
+	}
+}
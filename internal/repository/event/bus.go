@@ -0,0 +1,35 @@
+package event
+
+import (
+	"context"
+	"errors"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+type Subscriber interface {
+	Handle(ctx context.Context, event entity.Event) error
+}
+
+// Bus delivers every event to all subscribers, one failing subscriber does
+// not keep the others from being notified.
+type Bus struct {
+	subscribers []Subscriber
+}
+
+func NewBus(subscribers ...Subscriber) *Bus {
+	return &Bus{
+		subscribers: subscribers,
+	}
+}
+
+func (b *Bus) Publish(ctx context.Context, event entity.Event) error {
+	var errs []error
+	for _, subscriber := range b.subscribers {
+		if err := subscriber.Handle(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,41 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type subscriberStub struct {
+	err    error
+	events []entity.Event
+}
+
+func (s *subscriberStub) Handle(ctx context.Context, event entity.Event) error {
+	s.events = append(s.events, event)
+	return s.err
+}
+
+type BusTestSuite struct {
+	suite.Suite
+}
+
+func TestBus(t *testing.T) {
+	suite.Run(t, new(BusTestSuite))
+}
+
+func (s *BusTestSuite) TestPublish() {
+	failing := &subscriberStub{err: errors.New("any-error")}
+	succeeding := &subscriberStub{}
+	event := entity.NewTaskEvent(entity.EventTypeTaskStarted, entity.Task{ID: "task-1"})
+
+	err := NewBus(failing, succeeding).Publish(context.Background(), event)
+
+	s.ErrorIs(err, failing.err)
+	s.Equal([]entity.Event{event}, failing.events)
+	s.Equal([]entity.Event{event}, succeeding.events)
+	s.NoError(NewBus().Publish(context.Background(), event))
+}
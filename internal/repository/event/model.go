@@ -0,0 +1,58 @@
+package event
+
+import (
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+type TaskModel struct {
+	ID               string     `json:"id"`
+	ProjectID        string     `json:"project_id"`
+	ParentTaskID     string     `json:"parent_task_id,omitempty"`
+	Name             string     `json:"name"`
+	Description      string     `json:"description"`
+	IsStarted        bool       `json:"is_started"`
+	CompletedAt      *time.Time `json:"completed_at,omitempty"`
+	IntegrationID    string     `json:"integration_id,omitempty"`
+	IntegrationType  string     `json:"integration_type,omitempty"`
+	TimeSpentSeconds int64      `json:"time_spent_seconds"`
+	PauseReason      string     `json:"pause_reason,omitempty"`
+}
+
+// EventModel is the payload sent to webhooks and piped to shell hooks.
+type EventModel struct {
+	Event      string    `json:"event"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Task       TaskModel `json:"task"`
+}
+
+func CreateEventModel(event entity.Event) EventModel {
+	task := TaskModel{
+		ID:               event.Task.ID,
+		ProjectID:        event.Task.ProjectID,
+		ParentTaskID:     event.Task.ParentTaskID,
+		Name:             event.Task.Name,
+		Description:      event.Task.Description,
+		IsStarted:        event.Task.IsStarted,
+		IntegrationID:    event.Task.Integration.ID,
+		IntegrationType:  string(event.Task.Integration.Type),
+		TimeSpentSeconds: int64(event.Task.TimeSpent().Seconds()),
+	}
+
+	if event.Task.IsPaused() {
+		pauses := event.Task.Histories[len(event.Task.Histories)-1].Pauses
+		task.PauseReason = pauses[len(pauses)-1].Reason
+	}
+
+	if !event.Task.CompletedAt.IsZero() {
+		completedAt := event.Task.CompletedAt
+		task.CompletedAt = &completedAt
+	}
+
+	return EventModel{
+		Event:      string(event.Type),
+		OccurredAt: event.OccurredAt,
+		Task:       task,
+	}
+}
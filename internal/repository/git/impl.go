@@ -0,0 +1,93 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/git"
+)
+
+// RepoImpl runs git in dir, the working directory when it is empty.
+type RepoImpl struct {
+	dir string
+}
+
+func New(dir string) *RepoImpl {
+	return &RepoImpl{dir: dir}
+}
+
+func (ri *RepoImpl) run(ctx context.Context, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = ri.dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to run git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// InstallHook writes the hook into the hooks directory of the repository,
+// honoring core.hooksPath, and returns its path. A hook not installed by
+// todo-cli is only replaced with force.
+func (ri *RepoImpl) InstallHook(ctx context.Context, name, script string, force bool) (string, error) {
+	hooksDir, err := ri.run(ctx, "rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", err
+	}
+
+	if !filepath.IsAbs(hooksDir) {
+		hooksDir = filepath.Join(ri.dir, hooksDir)
+	}
+
+	hooksDir, err = filepath.Abs(hooksDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to get hooks directory: %w", err)
+	}
+
+	if err = os.MkdirAll(hooksDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+
+	path := filepath.Join(hooksDir, name)
+	content, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("failed to read hook: %w", err)
+	}
+
+	if err == nil && !force && !bytes.Contains(content, []byte(git.HookMarker)) {
+		return "", fmt.Errorf("%w: %s", git.ErrHookExists, path)
+	}
+
+	if err = os.WriteFile(path, []byte(script), 0755); err != nil {
+		return "", fmt.Errorf("failed to write hook: %w", err)
+	}
+
+	// WriteFile keeps the mode of an existing file
+	if err = os.Chmod(path, 0755); err != nil {
+		return "", fmt.Errorf("failed to make hook executable: %w", err)
+	}
+
+	return path, nil
+}
+
+func (ri *RepoImpl) HeadCommit(ctx context.Context) (git.Commit, error) {
+	output, err := ri.run(ctx, "log", "-1", "--format=%H%n%s")
+	if err != nil {
+		return git.Commit{}, err
+	}
+
+	sha, subject, _ := strings.Cut(output, "\n")
+	return git.Commit{
+		SHA:     sha,
+		Subject: subject,
+	}, nil
+}
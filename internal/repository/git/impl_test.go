@@ -0,0 +1,101 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/git"
+	"github.com/stretchr/testify/suite"
+)
+
+type RepoImplTestSuite struct {
+	suite.Suite
+	dir      string
+	repoImpl *RepoImpl
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	if _, err := exec.LookPath("git"); err != nil {
+		s.T().Skip("git is not installed")
+	}
+
+	s.dir = s.T().TempDir()
+	s.git("init", "-q")
+	s.repoImpl = New(s.dir)
+}
+
+func (s *RepoImplTestSuite) git(args ...string) {
+	cmd := exec.Command("git", append([]string{"-c", "user.name=any-name", "-c", "user.email=any@email"}, args...)...)
+	cmd.Dir = s.dir
+	output, err := cmd.CombinedOutput()
+	s.Require().NoError(err, string(output))
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+func (s *RepoImplTestSuite) TestInstallHook() {
+	hookPath := filepath.Join(s.dir, ".git", "hooks", "post-commit")
+	tests := []struct {
+		name          string
+		existingHook  string
+		force         bool
+		expectedError error
+	}{
+		{
+			name: "new hook",
+		},
+		{
+			name:         "replace todo-cli hook",
+			existingHook: "#!/bin/sh\n" + git.HookMarker + "\nold\n",
+		},
+		{
+			name:          "keep user hook",
+			existingHook:  "#!/bin/sh\necho user hook\n",
+			expectedError: git.ErrHookExists,
+		},
+		{
+			name:         "replace user hook with force",
+			existingHook: "#!/bin/sh\necho user hook\n",
+			force:        true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			os.Remove(hookPath)
+			if test.existingHook != "" {
+				s.Require().NoError(os.WriteFile(hookPath, []byte(test.existingHook), 0644))
+			}
+
+			path, err := s.repoImpl.InstallHook(context.Background(), "post-commit", "any-script", test.force)
+			if test.expectedError != nil {
+				s.ErrorIs(err, test.expectedError)
+				content, _ := os.ReadFile(hookPath)
+				s.Equal(test.existingHook, string(content))
+				return
+			}
+
+			s.NoError(err)
+			s.Equal(hookPath, path)
+			content, _ := os.ReadFile(hookPath)
+			s.Equal("any-script", string(content))
+			info, _ := os.Stat(hookPath)
+			s.Equal(os.FileMode(0755), info.Mode().Perm())
+		})
+	}
+}
+
+func (s *RepoImplTestSuite) TestHeadCommit() {
+	s.git("commit", "-q", "--allow-empty", "-m", "any-subject")
+
+	commit, err := s.repoImpl.HeadCommit(context.Background())
+
+	s.NoError(err)
+	s.Len(commit.SHA, 40)
+	s.Equal("any-subject", commit.Subject)
+}
@@ -0,0 +1,272 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/repository/secret"
+)
+
+const (
+	GITLAB_URL = "https://gitlab.com"
+
+	optionYes = "yes"
+	optionNo  = "no"
+)
+
+type SecretRepo interface {
+	Get(ctx context.Context, key string) (string, error)
+}
+
+// RepoImpl syncs GitLab issues, and merge requests awaiting review, as tasks.
+// Task IDs are the API path of the issue or merge request in its project,
+// e.g. 42/issues/12, so the time spent can be posted back.
+type RepoImpl struct {
+	secretRepo SecretRepo
+	httpClient *http.Client
+}
+
+func New(secretRepo SecretRepo) *RepoImpl {
+	return &RepoImpl{
+		secretRepo: secretRepo,
+		httpClient: &http.Client{},
+	}
+}
+
+func (ri *RepoImpl) Metadata() entity.IntegrationMetadata {
+	return entity.IntegrationMetadata{
+		Type:        entity.IntegrationTypeGitLab,
+		Description: "Sync issues and merge requests from GitLab, track time spent and close them when completed",
+	}
+}
+
+func (ri *RepoImpl) Schema() []entity.IntegrationField {
+	return []entity.IntegrationField{
+		{Key: "url", Label: "GitLab URL", Default: GITLAB_URL},
+		{
+			Key:   "token",
+			Label: "GitLab Personal Access Token",
+			Help:  "Create a personal access token with the api scope.",
+			Type:  entity.IntegrationFieldSecret,
+		},
+		{
+			Key:     "scope",
+			Label:   "GitLab Issue Scope",
+			Type:    entity.IntegrationFieldSelect,
+			Options: []string{"assigned_to_me", "created_by_me", "all"},
+		},
+		{Key: "labels", Label: "GitLab Labels (comma separated, empty for any)"},
+		{Key: "milestone", Label: "GitLab Milestone (empty for any)"},
+		{
+			Key:     "merge_requests",
+			Label:   "Sync Merge Requests Awaiting Your Review",
+			Type:    entity.IntegrationFieldSelect,
+			Options: []string{optionNo, optionYes},
+		},
+		{
+			Key:     "close_on_complete",
+			Label:   "Close Issues on Completion",
+			Type:    entity.IntegrationFieldSelect,
+			Options: []string{optionNo, optionYes},
+		},
+	}
+}
+
+func (ri *RepoImpl) GetTasks(ctx context.Context, projectID string, integrationDetails map[string]string) (entity.Tasks, error) {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationDetails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve gitlab credentials: %w", err)
+	}
+
+	scope := details["scope"]
+	if scope == "" {
+		scope = "assigned_to_me"
+	}
+
+	query := url.Values{"state": {"opened"}, "scope": {scope}}
+	if details["labels"] != "" {
+		query.Set("labels", details["labels"])
+	}
+
+	if details["milestone"] != "" {
+		query.Set("milestone", details["milestone"])
+	}
+
+	issues, err := getAll[IssueModel](ctx, ri, details, "/issues", query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issues: %w", err)
+	}
+
+	var tasks entity.Tasks
+	for _, issue := range issues {
+		tasks = append(tasks, entity.Task{
+			ID:          fmt.Sprintf("%d/issues/%d", issue.ProjectID, issue.IID),
+			ProjectID:   projectID,
+			Name:        issue.Title,
+			Description: issue.Description,
+			Integration: entity.TaskIntegration{
+				ID:   issue.References.Full,
+				Type: entity.IntegrationTypeGitLab,
+			},
+		})
+	}
+
+	if details["merge_requests"] != optionYes {
+		return tasks, nil
+	}
+
+	var user UserModel
+	if _, err = ri.do(ctx, details, http.MethodGet, "/user", nil, &user); err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	query = url.Values{"state": {"opened"}, "scope": {"all"}, "reviewer_username": {user.Username}}
+	mergeRequests, err := getAll[MergeRequestModel](ctx, ri, details, "/merge_requests", query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merge requests: %w", err)
+	}
+
+	for _, mergeRequest := range mergeRequests {
+		tasks = append(tasks, entity.Task{
+			ID:          fmt.Sprintf("%d/merge_requests/%d", mergeRequest.ProjectID, mergeRequest.IID),
+			ProjectID:   projectID,
+			Name:        "Review: " + mergeRequest.Title,
+			Description: mergeRequest.Description,
+			Integration: entity.TaskIntegration{
+				ID:   mergeRequest.References.Full,
+				Type: entity.IntegrationTypeGitLab,
+			},
+		})
+	}
+
+	return tasks, nil
+}
+
+// AddWorklog posts the time spent with the time tracking API, the same as a
+// /spend quick action. GitLab tracks time in minutes.
+func (ri *RepoImpl) AddWorklog(ctx context.Context, issueID, taskName string, timeSpent time.Duration, integrationEntity entity.Integration) error {
+	duration := formatDuration(timeSpent)
+	if duration == "" {
+		return nil
+	}
+
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationEntity.Details)
+	if err != nil {
+		return fmt.Errorf("failed to resolve gitlab credentials: %w", err)
+	}
+
+	query := url.Values{"duration": {duration}, "summary": {taskName}}
+	if _, err = ri.do(ctx, details, http.MethodPost, "/projects/"+issueID+"/add_spent_time", query, nil); err != nil {
+		return fmt.Errorf("failed to add spent time: %w", err)
+	}
+
+	fmt.Printf("Worklog added to %s\n", taskName)
+
+	return nil
+}
+
+// Transition closes the issue when close_on_complete is set, merge requests
+// are left to be merged.
+func (ri *RepoImpl) Transition(ctx context.Context, issueID string, integrationEntity entity.Integration) error {
+	if integrationEntity.Details["close_on_complete"] != optionYes || !strings.Contains(issueID, "/issues/") {
+		return nil
+	}
+
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationEntity.Details)
+	if err != nil {
+		return fmt.Errorf("failed to resolve gitlab credentials: %w", err)
+	}
+
+	query := url.Values{"state_event": {"close"}}
+	if _, err = ri.do(ctx, details, http.MethodPut, "/projects/"+issueID, query, nil); err != nil {
+		return fmt.Errorf("failed to close issue: %w", err)
+	}
+
+	return nil
+}
+
+// getAll follows the pages of a list endpoint.
+func getAll[T any](ctx context.Context, ri *RepoImpl, details map[string]string, path string, query url.Values) ([]T, error) {
+	var items []T
+	query.Set("per_page", "100")
+	page := "1"
+	for page != "" {
+		query.Set("page", page)
+
+		var chunk []T
+		header, err := ri.do(ctx, details, http.MethodGet, path, query, &chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, chunk...)
+		page = header.Get("X-Next-Page")
+	}
+
+	return items, nil
+}
+
+func (ri *RepoImpl) do(ctx context.Context, details map[string]string, method, path string, query url.Values, out any) (http.Header, error) {
+	baseURL := details["url"]
+	if baseURL == "" {
+		baseURL = GITLAB_URL
+	}
+
+	endpoint := strings.TrimSuffix(baseURL, "/") + "/api/v4" + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("PRIVATE-TOKEN", details["token"])
+	resp, err := ri.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if out == nil {
+		return resp.Header, nil
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return resp.Header, nil
+}
+
+// formatDuration formats the duration rounded to minutes, e.g. 1h30m, it is
+// empty under a minute.
+func formatDuration(d time.Duration) string {
+	minutes := int64(d.Round(time.Minute).Minutes())
+	if minutes <= 0 {
+		return ""
+	}
+
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+
+	if minutes%60 == 0 {
+		return fmt.Sprintf("%dh", minutes/60)
+	}
+
+	return fmt.Sprintf("%dh%dm", minutes/60, minutes%60)
+}
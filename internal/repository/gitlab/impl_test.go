@@ -0,0 +1,262 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type secretRepoStub struct {
+	secrets map[string]string
+}
+
+func (r *secretRepoStub) Get(ctx context.Context, key string) (string, error) {
+	value, ok := r.secrets[key]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+
+	return value, nil
+}
+
+type RepoImplTestSuite struct {
+	suite.Suite
+	server      *httptest.Server
+	issueQuery  url.Values
+	spentTime   url.Values
+	closedIssue string
+	details     map[string]string
+	repoImpl    *RepoImpl
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	s.issueQuery, s.spentTime, s.closedIssue = nil, nil, ""
+	mux := http.NewServeMux()
+	authorized := func(handler http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("PRIVATE-TOKEN") != "glpat-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"message":"401 Unauthorized"}`))
+				return
+			}
+
+			handler(w, r)
+		}
+	}
+	mux.HandleFunc("/api/v4/issues", authorized(func(w http.ResponseWriter, r *http.Request) {
+		s.issueQuery = r.URL.Query()
+		if r.URL.Query().Get("page") == "1" {
+			w.Header().Set("X-Next-Page", "2")
+			json.NewEncoder(w).Encode([]map[string]any{
+				{"project_id": 42, "iid": 12, "title": "any-issue", "description": "any-description", "references": map[string]string{"full": "group/project#12"}},
+			})
+			return
+		}
+
+		json.NewEncoder(w).Encode([]map[string]any{
+			{"project_id": 42, "iid": 13, "title": "other-issue", "references": map[string]string{"full": "group/project#13"}},
+		})
+	}))
+	mux.HandleFunc("/api/v4/user", authorized(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"username": "any-user"})
+	}))
+	mux.HandleFunc("/api/v4/merge_requests", authorized(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("reviewer_username") != "any-user" {
+			json.NewEncoder(w).Encode([]map[string]any{})
+			return
+		}
+
+		json.NewEncoder(w).Encode([]map[string]any{
+			{"project_id": 42, "iid": 3, "title": "any-merge-request", "references": map[string]string{"full": "group/project!3"}},
+		})
+	}))
+	mux.HandleFunc("/api/v4/projects/42/issues/12/add_spent_time", authorized(func(w http.ResponseWriter, r *http.Request) {
+		s.spentTime = r.URL.Query()
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{}`))
+	}))
+	mux.HandleFunc("/api/v4/projects/42/issues/12", authorized(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			s.closedIssue = r.URL.Query().Get("state_event")
+		}
+		w.Write([]byte(`{}`))
+	}))
+	s.server = httptest.NewServer(mux)
+	s.details = map[string]string{
+		"url":   s.server.URL,
+		"token": "secret:project/project-1/GitLab/token",
+	}
+	s.repoImpl = &RepoImpl{
+		secretRepo: &secretRepoStub{secrets: map[string]string{
+			"project/project-1/GitLab/token": "glpat-token",
+		}},
+		httpClient: s.server.Client(),
+	}
+}
+
+func (s *RepoImplTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+func (s *RepoImplTestSuite) TestGetTasks() {
+	issues := entity.Tasks{
+		{
+			ID:          "42/issues/12",
+			ProjectID:   "project-1",
+			Name:        "any-issue",
+			Description: "any-description",
+			Integration: entity.TaskIntegration{ID: "group/project#12", Type: entity.IntegrationTypeGitLab},
+		},
+		{
+			ID:          "42/issues/13",
+			ProjectID:   "project-1",
+			Name:        "other-issue",
+			Integration: entity.TaskIntegration{ID: "group/project#13", Type: entity.IntegrationTypeGitLab},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		details       map[string]string
+		expectedQuery url.Values
+		expectedTasks entity.Tasks
+		expectedError string
+	}{
+		{
+			name:          "invalid token",
+			details:       map[string]string{"url": s.server.URL, "token": "any-token"},
+			expectedError: `failed to get issues: unexpected status code 401: {"message":"401 Unauthorized"}`,
+		},
+		{
+			name:    "issues assigned to me by default",
+			details: map[string]string{"url": s.server.URL, "token": "secret:project/project-1/GitLab/token"},
+			expectedQuery: url.Values{
+				"state":    {"opened"},
+				"scope":    {"assigned_to_me"},
+				"per_page": {"100"},
+				"page":     {"2"},
+			},
+			expectedTasks: issues,
+		},
+		{
+			name: "filtered issues and merge requests",
+			details: map[string]string{
+				"url":            s.server.URL,
+				"token":          "secret:project/project-1/GitLab/token",
+				"scope":          "all",
+				"labels":         "backend,bug",
+				"milestone":      "v1.0",
+				"merge_requests": "yes",
+			},
+			expectedQuery: url.Values{
+				"state":     {"opened"},
+				"scope":     {"all"},
+				"labels":    {"backend,bug"},
+				"milestone": {"v1.0"},
+				"per_page":  {"100"},
+				"page":      {"2"},
+			},
+			expectedTasks: append(issues, entity.Task{
+				ID:          "42/merge_requests/3",
+				ProjectID:   "project-1",
+				Name:        "Review: any-merge-request",
+				Integration: entity.TaskIntegration{ID: "group/project!3", Type: entity.IntegrationTypeGitLab},
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			tasks, err := s.repoImpl.GetTasks(context.Background(), "project-1", test.details)
+
+			if test.expectedError != "" {
+				s.EqualError(err, test.expectedError)
+				return
+			}
+
+			s.NoError(err)
+			s.Equal(test.expectedQuery, s.issueQuery)
+			s.Equal(test.expectedTasks, tasks)
+		})
+	}
+}
+
+func (s *RepoImplTestSuite) TestAddWorklog() {
+	integration := entity.Integration{Type: entity.IntegrationTypeGitLab, Details: s.details}
+
+	err := s.repoImpl.AddWorklog(context.Background(), "42/issues/12", "any-task", 90*time.Minute+20*time.Second, integration)
+
+	s.NoError(err)
+	s.Equal(url.Values{"duration": {"1h30m"}, "summary": {"any-task"}}, s.spentTime)
+
+	s.spentTime = nil
+	err = s.repoImpl.AddWorklog(context.Background(), "42/issues/12", "any-task", 20*time.Second, integration)
+
+	s.NoError(err)
+	s.Nil(s.spentTime)
+}
+
+func (s *RepoImplTestSuite) TestTransition() {
+	tests := []struct {
+		name            string
+		issueID         string
+		closeOnComplete string
+		expectedState   string
+	}{
+		{
+			name:            "closing is not configured",
+			issueID:         "42/issues/12",
+			closeOnComplete: "no",
+		},
+		{
+			name:            "merge requests are not closed",
+			issueID:         "42/merge_requests/3",
+			closeOnComplete: "yes",
+		},
+		{
+			name:            "success",
+			issueID:         "42/issues/12",
+			closeOnComplete: "yes",
+			expectedState:   "close",
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.closedIssue = ""
+			details := map[string]string{"close_on_complete": test.closeOnComplete}
+			for key, value := range s.details {
+				details[key] = value
+			}
+
+			err := s.repoImpl.Transition(context.Background(), test.issueID, entity.Integration{Type: entity.IntegrationTypeGitLab, Details: details})
+
+			s.NoError(err)
+			s.Equal(test.expectedState, s.closedIssue)
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	for duration, expected := range map[time.Duration]string{
+		20 * time.Second:  "",
+		25 * time.Minute:  "25m",
+		2 * time.Hour:     "2h",
+		150 * time.Minute: "2h30m",
+	} {
+		assert.Equal(t, expected, formatDuration(duration))
+	}
+}
@@ -0,0 +1,25 @@
+package gitlab
+
+type ReferencesModel struct {
+	Full string `json:"full"`
+}
+
+type IssueModel struct {
+	ProjectID   int64           `json:"project_id"`
+	IID         int64           `json:"iid"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	References  ReferencesModel `json:"references"`
+}
+
+type MergeRequestModel struct {
+	ProjectID   int64           `json:"project_id"`
+	IID         int64           `json:"iid"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	References  ReferencesModel `json:"references"`
+}
+
+type UserModel struct {
+	Username string `json:"username"`
+}
@@ -0,0 +1,89 @@
+package hook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/repository/event"
+)
+
+var hookNames = map[entity.EventType]string{
+	entity.EventTypeTaskAdded:     "on-add",
+	entity.EventTypeTaskStarted:   "on-start",
+	entity.EventTypeTaskStopped:   "on-stop",
+	entity.EventTypeTaskPaused:    "on-pause",
+	entity.EventTypeTaskResumed:   "on-resume",
+	entity.EventTypeTaskCompleted: "on-complete",
+	entity.EventTypeTaskRemoved:   "on-remove",
+}
+
+// RepoImpl runs the executable named after the event, e.g. on-start, from the
+// hooks directory. The event payload is written to its stdin as JSON.
+type RepoImpl struct {
+	dir     string
+	timeout time.Duration
+}
+
+func New(dir string) *RepoImpl {
+	return &RepoImpl{
+		dir:     dir,
+		timeout: 30 * time.Second,
+	}
+}
+
+func (ri *RepoImpl) Handle(ctx context.Context, eventEntity entity.Event) error {
+	name, ok := hookNames[eventEntity.Type]
+	if !ok {
+		return nil
+	}
+
+	path := filepath.Join(ri.dir, name)
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to stat %s hook: %w", name, err)
+	}
+
+	if info.IsDir() || info.Mode().Perm()&0111 == 0 {
+		return fmt.Errorf("%s hook is not executable", name)
+	}
+
+	payload := event.CreateEventModel(eventEntity)
+	input, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ri.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(),
+		"TODO_EVENT="+payload.Event,
+		"TODO_TASK_ID="+payload.Task.ID,
+		"TODO_TASK_NAME="+payload.Task.Name,
+		"TODO_TASK_PROJECT_ID="+payload.Task.ProjectID,
+		"TODO_TASK_INTEGRATION_ID="+payload.Task.IntegrationID,
+		"TODO_PAUSE_REASON="+payload.Task.PauseReason,
+	)
+
+	if err = cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run %s hook: %w", name, err)
+	}
+
+	return nil
+}
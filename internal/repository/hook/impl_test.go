@@ -0,0 +1,98 @@
+package hook
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type RepoImplTestSuite struct {
+	suite.Suite
+	dir      string
+	output   string
+	repoImpl *RepoImpl
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.output = filepath.Join(s.dir, "output")
+	s.repoImpl = New(s.dir)
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+func (s *RepoImplTestSuite) writeHook(name, script string, perm os.FileMode) {
+	s.NoError(os.WriteFile(filepath.Join(s.dir, name), []byte(script), perm))
+}
+
+func (s *RepoImplTestSuite) TestHandle() {
+	event := entity.Event{
+		Type: entity.EventTypeTaskStarted,
+		Task: entity.Task{
+			ID:   "task-1",
+			Name: "any-name",
+			Integration: entity.TaskIntegration{
+				ID: "TODO-1",
+			},
+		},
+		OccurredAt: time.Date(2024, 3, 11, 10, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name           string
+		script         string
+		perm           os.FileMode
+		expectedOutput string
+		expectedErr    bool
+	}{
+		{
+			name:           "no hook",
+			expectedOutput: "",
+		},
+		{
+			name:           "hook receives event",
+			script:         "#!/bin/sh\necho \"$TODO_EVENT $TODO_TASK_ID $TODO_TASK_NAME $TODO_TASK_INTEGRATION_ID\" > " + s.output + "\ncat >> " + s.output + "\n",
+			perm:           0755,
+			expectedOutput: "TaskStarted task-1 any-name TODO-1\n" + `{"event":"TaskStarted","occurred_at":"2024-03-11T10:00:00Z","task":{"id":"task-1","project_id":"","name":"any-name","description":"","is_started":false,"integration_id":"TODO-1","time_spent_seconds":0}}`,
+		},
+		{
+			name:        "hook fails",
+			script:      "#!/bin/sh\nexit 1\n",
+			perm:        0755,
+			expectedErr: true,
+		},
+		{
+			name:        "hook not executable",
+			script:      "#!/bin/sh\n",
+			perm:        0644,
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			os.Remove(s.output)
+			os.Remove(filepath.Join(s.dir, "on-start"))
+			if test.script != "" {
+				s.writeHook("on-start", test.script, test.perm)
+			}
+
+			err := s.repoImpl.Handle(context.Background(), event)
+			if test.expectedErr {
+				s.Error(err)
+				return
+			}
+
+			s.NoError(err)
+			output, _ := os.ReadFile(s.output)
+			s.Equal(test.expectedOutput, string(output))
+		})
+	}
+}
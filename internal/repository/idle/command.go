@@ -0,0 +1,78 @@
+package idle
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+// CommandRepo asks a desktop tool for the current idle time.
+type CommandRepo struct {
+	name  string
+	args  []string
+	parse func(output string) (time.Duration, error)
+}
+
+// NewX11 uses xprintidle, which prints the idle time in milliseconds.
+func NewX11() *CommandRepo {
+	return &CommandRepo{
+		name:  "xprintidle",
+		parse: parseMilliseconds,
+	}
+}
+
+// NewGNOME asks the Mutter idle monitor, which also works on Wayland.
+func NewGNOME() *CommandRepo {
+	return &CommandRepo{
+		name: "gdbus",
+		args: []string{
+			"call", "--session",
+			"--dest", "org.gnome.Mutter.IdleMonitor",
+			"--object-path", "/org/gnome/Mutter/IdleMonitor/Core",
+			"--method", "org.gnome.Mutter.IdleMonitor.GetIdletime",
+		},
+		parse: parseMilliseconds,
+	}
+}
+
+func (r *CommandRepo) LastActivity(ctx context.Context, setting entity.TrackingSetting) (time.Time, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, r.name, r.args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return time.Time{}, fmt.Errorf("failed to run %s: %w: %s", r.name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	idle, err := r.parse(stdout.String())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse idle time: %w", err)
+	}
+
+	return time.Now().Add(-idle), nil
+}
+
+var numberPattern = regexp.MustCompile(`\d+`)
+
+// parseMilliseconds reads the first number of the output, gdbus wraps it as
+// "(uint64 1234,)".
+func parseMilliseconds(output string) (time.Duration, error) {
+	number := numberPattern.FindString(strings.TrimPrefix(strings.TrimSpace(output), "(uint64"))
+	if number == "" {
+		return 0, fmt.Errorf("no idle time in %q", output)
+	}
+
+	milliseconds, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(milliseconds) * time.Millisecond, nil
+}
@@ -0,0 +1,61 @@
+package idle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMilliseconds(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      string
+		expectedRes time.Duration
+		expectedErr bool
+	}{
+		{
+			name:        "xprintidle",
+			output:      "90000\n",
+			expectedRes: 90 * time.Second,
+		},
+		{
+			name:        "gdbus",
+			output:      "(uint64 1500,)\n",
+			expectedRes: 1500 * time.Millisecond,
+		},
+		{
+			name:        "no number",
+			output:      "error",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := parseMilliseconds(test.output)
+
+			assert.Equal(t, test.expectedErr, err != nil)
+			assert.Equal(t, test.expectedRes, res)
+		})
+	}
+}
+
+func TestCommandRepoLastActivity(t *testing.T) {
+	repo := &CommandRepo{name: "echo", args: []string{"600000"}, parse: parseMilliseconds}
+
+	lastActivity, err := repo.LastActivity(context.Background(), entity.TrackingSetting{})
+
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-10*time.Minute), lastActivity, time.Second)
+}
+
+func TestCommandRepoLastActivityMissingCommand(t *testing.T) {
+	repo := &CommandRepo{name: "todo-cli-missing-command", parse: parseMilliseconds}
+
+	_, err := repo.LastActivity(context.Background(), entity.TrackingSetting{})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,32 @@
+package idle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+// FileRepo reads the last activity from the modification time of a file
+// touched by a shell hook, such as PROMPT_COMMAND.
+type FileRepo struct{}
+
+func NewFile() *FileRepo {
+	return &FileRepo{}
+}
+
+func (r *FileRepo) LastActivity(ctx context.Context, setting entity.TrackingSetting) (time.Time, error) {
+	info, err := os.Stat(setting.IdleFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return time.Time{}, nil
+	}
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to read idle file: %w", err)
+	}
+
+	return info.ModTime(), nil
+}
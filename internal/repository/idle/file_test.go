@@ -0,0 +1,31 @@
+package idle
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileRepoLastActivity(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "activity")
+	touchedAt := time.Date(2024, 1, 5, 17, 30, 0, 0, time.Local)
+	repo := NewFile()
+
+	lastActivity, err := repo.LastActivity(context.Background(), entity.TrackingSetting{IdleFile: path})
+
+	assert.NoError(t, err)
+	assert.True(t, lastActivity.IsZero())
+
+	assert.NoError(t, os.WriteFile(path, nil, 0644))
+	assert.NoError(t, os.Chtimes(path, touchedAt, touchedAt))
+
+	lastActivity, err = repo.LastActivity(context.Background(), entity.TrackingSetting{IdleFile: path})
+
+	assert.NoError(t, err)
+	assert.True(t, touchedAt.Equal(lastActivity))
+}
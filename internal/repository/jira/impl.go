@@ -2,26 +2,263 @@ package jira
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/andygrunwald/go-jira"
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration"
+	"github.com/azisuazusa/todo-cli/internal/repository/secret"
+	"golang.org/x/oauth2"
 )
 
-type RepoImpl struct{}
+const (
+	ATLASSIAN_AUTH_URL                 = "https://auth.atlassian.com/authorize"
+	ATLASSIAN_TOKEN_URL                = "https://auth.atlassian.com/oauth/token"
+	ATLASSIAN_ACCESSIBLE_RESOURCES_URL = "https://api.atlassian.com/oauth/token/accessible-resources"
+)
+
+// ErrOAuthStateMismatch is returned when the URL pasted after the OAuth
+// consent does not come from the consent just started.
+var ErrOAuthStateMismatch = errors.New("oauth state does not match, authorize again")
+
+type ProjectRepo interface {
+	GetAll(ctx context.Context) (entity.Projects, error)
+	Update(ctx context.Context, project entity.Project) error
+}
+
+type SecretRepo interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key, value string) error
+}
 
-func New() *RepoImpl {
-	return &RepoImpl{}
+type RepoImpl struct {
+	projectRepo ProjectRepo
+	secretRepo  SecretRepo
+	tokenURL    string
+}
+
+func New(projectRepo ProjectRepo, secretRepo SecretRepo) *RepoImpl {
+	return &RepoImpl{
+		projectRepo: projectRepo,
+		secretRepo:  secretRepo,
+		tokenURL:    ATLASSIAN_TOKEN_URL,
+	}
+}
+
+func (ri *RepoImpl) Metadata() entity.IntegrationMetadata {
+	return entity.IntegrationMetadata{
+		Type:        entity.IntegrationTypeJIRA,
+		Description: "Sync issues from JIRA, log work and transition them when completed",
+	}
+}
+
+func (ri *RepoImpl) Schema() []entity.IntegrationField {
+	basicAuth := map[string]string{entity.JIRADetailAuthMethod: entity.JIRAAuthMethodBasic}
+	bearerAuth := map[string]string{entity.JIRADetailAuthMethod: entity.JIRAAuthMethodBearer}
+	oauth2Auth := map[string]string{entity.JIRADetailAuthMethod: entity.JIRAAuthMethodOAuth2}
+	return []entity.IntegrationField{
+		{Key: "url", Label: "JIRA URL"},
+		{
+			Key:     entity.JIRADetailAuthMethod,
+			Label:   "JIRA Authentication Method",
+			Type:    entity.IntegrationFieldSelect,
+			Options: []string{entity.JIRAAuthMethodBasic, entity.JIRAAuthMethodBearer, entity.JIRAAuthMethodOAuth2},
+		},
+		{Key: "username", Label: "JIRA Username", When: basicAuth},
+		{Key: entity.JIRADetailToken, Label: "JIRA Token", Type: entity.IntegrationFieldSecret, When: basicAuth},
+		{Key: entity.JIRADetailToken, Label: "JIRA Personal Access Token", Type: entity.IntegrationFieldSecret, When: bearerAuth},
+		{Key: entity.JIRADetailClientID, Label: "OAuth Client ID", When: oauth2Auth},
+		{Key: entity.JIRADetailClientSecret, Label: "OAuth Client Secret", Type: entity.IntegrationFieldSecret, When: oauth2Auth},
+		{Key: "redirect_url", Label: "OAuth Callback URL", Default: "http://localhost:8080/callback", When: oauth2Auth},
+		{Key: "jql", Label: "JIRA JQL", Default: "assignee = currentUser() AND resolution = Unresolved"},
+		{
+			Key:   "done_transition",
+			Label: "JIRA Done Transition (e.g. Done, empty to keep the issue as is)",
+			Help:  "Completing a task moves its issue through this transition.",
+		},
+	}
+}
+
+// Authorize runs the OAuth consent for the oauth2 auth method and replaces
+// the site URL with the cloud API URL, the other methods are used as-is.
+func (ri *RepoImpl) Authorize(ctx context.Context, details map[string]string, ask integration.Ask) (map[string]string, error) {
+	if details[entity.JIRADetailAuthMethod] != entity.JIRAAuthMethodOAuth2 {
+		return details, nil
+	}
+
+	config := oauth2.Config{
+		ClientID:     details[entity.JIRADetailClientID],
+		ClientSecret: details[entity.JIRADetailClientSecret],
+		RedirectURL:  details["redirect_url"],
+		Scopes:       []string{"read:jira-work", "write:jira-work", "read:jira-user", "offline_access"},
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   ATLASSIAN_AUTH_URL,
+			TokenURL:  ri.tokenURL,
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+
+	state, err := oauthState()
+	if err != nil {
+		return nil, fmt.Errorf("error while generating oauth state: %w", err)
+	}
+
+	authorizeURL := config.AuthCodeURL(state, oauth2.SetAuthURLParam("audience", "api.atlassian.com"), oauth2.SetAuthURLParam("prompt", "consent"))
+	redirectedURL, err := ask(entity.IntegrationField{
+		Key:   "redirected_url",
+		Label: "JIRA Redirected URL",
+		Help: strings.Join([]string{
+			fmt.Sprintf("1. Go to %s", authorizeURL),
+			"2. Click on 'Accept' (you might have to log in first)",
+			"3. Copy the whole URL you are redirected to",
+			"4. Paste the URL here",
+		}, "\n"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := authorizationCode(redirectedURL, state)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("error while exchanging code: %w", err)
+	}
+
+	siteURL := details["url"]
+	cloudID, err := jiraCloudID(ctx, token.AccessToken, siteURL)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting jira cloud id: %w", err)
+	}
+
+	authorizedDetails := map[string]string{}
+	for key, value := range details {
+		authorizedDetails[key] = value
+	}
+	delete(authorizedDetails, "redirect_url")
+	authorizedDetails["url"] = fmt.Sprintf("https://api.atlassian.com/ex/jira/%s", cloudID)
+	authorizedDetails[entity.JIRADetailSiteURL] = siteURL
+	authorizedDetails[entity.JIRADetailToken] = token.AccessToken
+	authorizedDetails[entity.JIRADetailRefreshToken] = token.RefreshToken
+	authorizedDetails[entity.JIRADetailExpiry] = token.Expiry.Format(time.RFC3339)
+
+	return authorizedDetails, nil
+}
+
+// oauthState returns a random state, so only the redirect of the consent
+// started by Authorize is accepted.
+func oauthState() (string, error) {
+	state := make([]byte, 16)
+	if _, err := rand.Read(state); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(state), nil
+}
+
+// authorizationCode returns the code of the URL redirected to after the
+// consent, once its state is checked.
+func authorizationCode(redirectedURL, state string) (string, error) {
+	parsedURL, err := url.Parse(strings.TrimSpace(redirectedURL))
+	if err != nil {
+		return "", fmt.Errorf("error while parsing redirected url: %w", err)
+	}
+
+	query := parsedURL.Query()
+	if query.Get("state") != state {
+		return "", ErrOAuthStateMismatch
+	}
+
+	if query.Get("code") == "" {
+		return "", fmt.Errorf("no code in redirected url: %s", query.Get("error_description"))
+	}
+
+	return query.Get("code"), nil
+}
+
+func jiraCloudID(ctx context.Context, accessToken, siteURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", ATLASSIAN_ACCESSIBLE_RESOURCES_URL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("error getting accessible resources: %s", string(body))
+	}
+
+	var resources []AccessibleResourceModel
+	if err = json.Unmarshal(body, &resources); err != nil {
+		return "", err
+	}
+
+	for _, resource := range resources {
+		if strings.TrimSuffix(resource.URL, "/") == strings.TrimSuffix(siteURL, "/") {
+			return resource.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("no accessible jira site found for %s", siteURL)
 }
 
 func (ri *RepoImpl) initJIRAClient(ctx context.Context, integrationDetails map[string]string) (*jira.Client, error) {
-	jiraAuth := jira.BasicAuthTransport{
-		Username: integrationDetails["username"],
-		Password: integrationDetails["token"],
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationDetails)
+	if err != nil {
+		return nil, fmt.Errorf("error while resolving jira credentials: %w", err)
 	}
 
-	client, err := jira.NewClient(jiraAuth.Client(), integrationDetails["url"])
+	var httpClient *http.Client
+	switch details[entity.JIRADetailAuthMethod] {
+	case "", entity.JIRAAuthMethodBasic:
+		jiraAuth := jira.BasicAuthTransport{
+			Username: details["username"],
+			Password: details[entity.JIRADetailToken],
+		}
+		httpClient = jiraAuth.Client()
+	case entity.JIRAAuthMethodBearer:
+		jiraAuth := jira.PATAuthTransport{
+			Token: details[entity.JIRADetailToken],
+		}
+		httpClient = jiraAuth.Client()
+	case entity.JIRAAuthMethodOAuth2:
+		token, err := ri.oauth2Token(ctx, integrationDetails, details)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting oauth2 token: %w", err)
+		}
+
+		jiraAuth := jira.BearerAuthTransport{
+			Token: token.AccessToken,
+		}
+		httpClient = jiraAuth.Client()
+	default:
+		return nil, fmt.Errorf("unsupported jira auth method: %s", details[entity.JIRADetailAuthMethod])
+	}
+
+	client, err := jira.NewClient(httpClient, details["url"])
 	if err != nil {
 		return nil, fmt.Errorf("error while creating jira client: %w", err)
 	}
@@ -29,6 +266,100 @@ func (ri *RepoImpl) initJIRAClient(ctx context.Context, integrationDetails map[s
 	return client, nil
 }
 
+// oauth2Token returns a valid access token, refreshing it when it is expired.
+// Atlassian rotates refresh tokens, so a refreshed token is written back to
+// every project holding the old one.
+func (ri *RepoImpl) oauth2Token(ctx context.Context, integrationDetails, resolvedDetails map[string]string) (*oauth2.Token, error) {
+	config := oauth2.Config{
+		ClientID:     resolvedDetails[entity.JIRADetailClientID],
+		ClientSecret: resolvedDetails[entity.JIRADetailClientSecret],
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   ATLASSIAN_AUTH_URL,
+			TokenURL:  ri.tokenURL,
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+
+	currentToken := &oauth2.Token{
+		AccessToken:  resolvedDetails[entity.JIRADetailToken],
+		RefreshToken: resolvedDetails[entity.JIRADetailRefreshToken],
+		TokenType:    "Bearer",
+	}
+
+	if expiry := resolvedDetails[entity.JIRADetailExpiry]; expiry != "" {
+		expiryTime, err := time.Parse(time.RFC3339, expiry)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing token expiry: %w", err)
+		}
+		currentToken.Expiry = expiryTime
+	}
+
+	token, err := config.TokenSource(ctx, currentToken).Token()
+	if err != nil {
+		return nil, fmt.Errorf("error while refreshing token: %w", err)
+	}
+
+	if token.AccessToken == currentToken.AccessToken {
+		return token, nil
+	}
+
+	if err = ri.saveOAuth2Token(ctx, integrationDetails[entity.JIRADetailRefreshToken], token); err != nil {
+		return nil, fmt.Errorf("error while saving refreshed token: %w", err)
+	}
+
+	if err = ri.setOAuth2Token(ctx, integrationDetails, token); err != nil {
+		return nil, fmt.Errorf("error while saving refreshed token: %w", err)
+	}
+
+	return token, nil
+}
+
+// saveOAuth2Token persists the token to the projects whose JIRA integration
+// holds storedRefreshToken, which is either the plain token or its reference.
+func (ri *RepoImpl) saveOAuth2Token(ctx context.Context, storedRefreshToken string, token *oauth2.Token) error {
+	projects, err := ri.projectRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting projects: %w", err)
+	}
+
+	for _, project := range projects {
+		isUpdated := false
+		for _, integration := range project.Integrations {
+			if integration.Type != entity.IntegrationTypeJIRA || integration.Details[entity.JIRADetailRefreshToken] != storedRefreshToken {
+				continue
+			}
+
+			if err = ri.setOAuth2Token(ctx, integration.Details, token); err != nil {
+				return err
+			}
+			isUpdated = true
+		}
+
+		if !isUpdated {
+			continue
+		}
+
+		if err = ri.projectRepo.Update(ctx, project); err != nil {
+			return fmt.Errorf("error while updating project: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (ri *RepoImpl) setOAuth2Token(ctx context.Context, details map[string]string, token *oauth2.Token) error {
+	if err := secret.StoreDetail(ctx, ri.secretRepo, details, entity.JIRADetailToken, token.AccessToken); err != nil {
+		return err
+	}
+
+	if err := secret.StoreDetail(ctx, ri.secretRepo, details, entity.JIRADetailRefreshToken, token.RefreshToken); err != nil {
+		return err
+	}
+
+	details[entity.JIRADetailExpiry] = token.Expiry.Format(time.RFC3339)
+	return nil
+}
+
 func (ri *RepoImpl) GetTasks(ctx context.Context, projectID string, integrationDetails map[string]string) (entity.Tasks, error) {
 	client, err := ri.initJIRAClient(ctx, integrationDetails)
 	if err != nil {
@@ -102,3 +433,50 @@ func (ri *RepoImpl) AddWorklog(ctx context.Context, issueID, taskName string, ti
 	return nil
 
 }
+
+// Transition moves the issue through the done_transition configured for the
+// integration, nothing is done when it is not configured.
+func (ri *RepoImpl) Transition(ctx context.Context, issueID string, integrationEntity entity.Integration) error {
+	transitionName := integrationEntity.Details["done_transition"]
+	if transitionName == "" {
+		return nil
+	}
+
+	client, err := ri.initJIRAClient(ctx, integrationEntity.Details)
+	if err != nil {
+		return fmt.Errorf("error while initializing jira client: %w", err)
+	}
+
+	transitions, _, err := client.Issue.GetTransitionsWithContext(ctx, issueID)
+	if err != nil {
+		return fmt.Errorf("error while getting transitions: %w", err)
+	}
+
+	for _, transition := range transitions {
+		if !strings.EqualFold(transition.Name, transitionName) {
+			continue
+		}
+
+		if _, err = client.Issue.DoTransitionWithContext(ctx, issueID, transition.ID); err != nil {
+			return fmt.Errorf("error while transitioning issue: %w", err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("transition %s is not available for the issue", transitionName)
+}
+
+func (ri *RepoImpl) AddComment(ctx context.Context, issueID, comment string, integrationEntity entity.Integration) error {
+	client, err := ri.initJIRAClient(ctx, integrationEntity.Details)
+	if err != nil {
+		return fmt.Errorf("error while initializing jira client: %w", err)
+	}
+
+	_, _, err = client.Issue.AddCommentWithContext(ctx, issueID, &jira.Comment{Body: comment})
+	if err != nil {
+		return fmt.Errorf("error while adding comment: %w", err)
+	}
+
+	return nil
+}
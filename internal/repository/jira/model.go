@@ -0,0 +1,7 @@
+package jira
+
+type AccessibleResourceModel struct {
+	ID   string `json:"id"`
+	URL  string `json:"url"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,253 @@
+package linear
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/repository/secret"
+)
+
+const (
+	LINEAR_API_URL = "https://api.linear.app/graphql"
+
+	cycleAny     = "any"
+	cycleCurrent = "current"
+
+	stateTypeStarted   = "started"
+	stateTypeCompleted = "completed"
+)
+
+const issuesQuery = `query Issues($filter: IssueFilter, $after: String) {
+  issues(filter: $filter, first: 100, after: $after) {
+    nodes { id identifier title description parent { id } }
+    pageInfo { hasNextPage endCursor }
+  }
+}`
+
+const issueStatesQuery = `query IssueStates($id: String!) {
+  issue(id: $id) {
+    team { states { nodes { id name type position } } }
+  }
+}`
+
+const issueUpdateMutation = `mutation IssueUpdate($id: String!, $stateId: String!) {
+  issueUpdate(id: $id, input: { stateId: $stateId }) { success }
+}`
+
+type SecretRepo interface {
+	Get(ctx context.Context, key string) (string, error)
+}
+
+// RepoImpl syncs the Linear issues assigned to the user through the GraphQL
+// API, sub-issues become subtasks of their parent issue.
+type RepoImpl struct {
+	secretRepo SecretRepo
+	apiURL     string
+	httpClient *http.Client
+}
+
+func New(secretRepo SecretRepo) *RepoImpl {
+	return &RepoImpl{
+		secretRepo: secretRepo,
+		apiURL:     LINEAR_API_URL,
+		httpClient: &http.Client{},
+	}
+}
+
+func (ri *RepoImpl) Metadata() entity.IntegrationMetadata {
+	return entity.IntegrationMetadata{
+		Type:        entity.IntegrationTypeLinear,
+		Description: "Sync issues assigned to you from Linear and move them when tasks are started and completed",
+	}
+}
+
+func (ri *RepoImpl) Schema() []entity.IntegrationField {
+	return []entity.IntegrationField{
+		{
+			Key:   "token",
+			Label: "Linear API Key",
+			Help:  "Create a personal API key in Linear under Settings > Security & access.",
+			Type:  entity.IntegrationFieldSecret,
+		},
+		{Key: "team", Label: "Linear Team Key (e.g. ENG, empty for every team)"},
+		{
+			Key:     "cycle",
+			Label:   "Linear Cycle",
+			Type:    entity.IntegrationFieldSelect,
+			Options: []string{cycleAny, cycleCurrent},
+		},
+		{Key: "start_state", Label: "Linear Started State (empty for the first started state of the team)"},
+		{Key: "done_state", Label: "Linear Done State (empty for the first completed state of the team)"},
+	}
+}
+
+func (ri *RepoImpl) GetTasks(ctx context.Context, projectID string, integrationDetails map[string]string) (entity.Tasks, error) {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationDetails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve linear credentials: %w", err)
+	}
+
+	filter := map[string]any{
+		"assignee": map[string]any{"isMe": map[string]any{"eq": true}},
+		"state":    map[string]any{"type": map[string]any{"nin": []string{stateTypeCompleted, "canceled"}}},
+	}
+
+	if details["team"] != "" {
+		filter["team"] = map[string]any{"key": map[string]any{"eq": details["team"]}}
+	}
+
+	if details["cycle"] == cycleCurrent {
+		filter["cycle"] = map[string]any{"isActive": map[string]any{"eq": true}}
+	}
+
+	var issues []IssueModel
+	variables := map[string]any{"filter": filter}
+	for {
+		var result IssuesModel
+		if err = ri.do(ctx, details, issuesQuery, variables, &result); err != nil {
+			return nil, fmt.Errorf("failed to get issues: %w", err)
+		}
+
+		issues = append(issues, result.Issues.Nodes...)
+		if !result.Issues.PageInfo.HasNextPage {
+			break
+		}
+
+		variables["after"] = result.Issues.PageInfo.EndCursor
+	}
+
+	synced := map[string]bool{}
+	for _, issue := range issues {
+		synced[issue.ID] = true
+	}
+
+	var tasks entity.Tasks
+	for _, issue := range issues {
+		task := entity.Task{
+			ID:          issue.ID,
+			ProjectID:   projectID,
+			Name:        issue.Title,
+			Description: issue.Description,
+			Integration: entity.TaskIntegration{
+				ID:   issue.Identifier,
+				Type: entity.IntegrationTypeLinear,
+			},
+		}
+
+		// A sub-issue whose parent is assigned to someone else stays a task
+		if issue.Parent != nil && synced[issue.Parent.ID] {
+			task.ParentTaskID = issue.Parent.ID
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+// Start moves the issue to the start_state, or to the first started state of
+// its team.
+func (ri *RepoImpl) Start(ctx context.Context, issueID string, integrationEntity entity.Integration) error {
+	return ri.moveIssue(ctx, issueID, stateTypeStarted, integrationEntity.Details["start_state"], integrationEntity)
+}
+
+// Transition moves the issue to the done_state, or to the first completed
+// state of its team.
+func (ri *RepoImpl) Transition(ctx context.Context, issueID string, integrationEntity entity.Integration) error {
+	return ri.moveIssue(ctx, issueID, stateTypeCompleted, integrationEntity.Details["done_state"], integrationEntity)
+}
+
+func (ri *RepoImpl) moveIssue(ctx context.Context, issueID, stateType, stateName string, integrationEntity entity.Integration) error {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationEntity.Details)
+	if err != nil {
+		return fmt.Errorf("failed to resolve linear credentials: %w", err)
+	}
+
+	var states IssueStatesModel
+	if err = ri.do(ctx, details, issueStatesQuery, map[string]any{"id": issueID}, &states); err != nil {
+		return fmt.Errorf("failed to get workflow states: %w", err)
+	}
+
+	state, ok := findState(states.Issue.Team.States.Nodes, stateType, stateName)
+	if !ok {
+		return fmt.Errorf("failed to find %s state %s", stateType, stateName)
+	}
+
+	var update IssueUpdateModel
+	if err = ri.do(ctx, details, issueUpdateMutation, map[string]any{"id": issueID, "stateId": state.ID}, &update); err != nil {
+		return fmt.Errorf("failed to update issue: %w", err)
+	}
+
+	if !update.IssueUpdate.Success {
+		return errors.New("failed to update issue")
+	}
+
+	return nil
+}
+
+// findState returns the state named name, or the first state of stateType in
+// the workflow when name is empty.
+func findState(states []WorkflowStateModel, stateType, name string) (WorkflowStateModel, bool) {
+	var found WorkflowStateModel
+	ok := false
+	for _, state := range states {
+		if name != "" {
+			if strings.EqualFold(state.Name, name) {
+				return state, true
+			}
+			continue
+		}
+
+		if state.Type == stateType && (!ok || state.Position < found.Position) {
+			found, ok = state, true
+		}
+	}
+
+	return found, ok
+}
+
+func (ri *RepoImpl) do(ctx context.Context, details map[string]string, query string, variables map[string]any, out any) error {
+	body, err := json.Marshal(RequestModel{Query: query, Variables: variables})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ri.apiURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Personal API keys are sent as is, without a Bearer prefix
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", details["token"])
+	resp, err := ri.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var response ResponseModel
+	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode response: unexpected status code %d", resp.StatusCode)
+	}
+
+	if len(response.Errors) > 0 {
+		return errors.New(response.Errors[0].Message)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	if err = json.Unmarshal(response.Data, out); err != nil {
+		return fmt.Errorf("failed to decode data: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type secretRepoStub struct {
+	secrets map[string]string
+}
+
+func (r *secretRepoStub) Get(ctx context.Context, key string) (string, error) {
+	value, ok := r.secrets[key]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+
+	return value, nil
+}
+
+type RepoImplTestSuite struct {
+	suite.Suite
+	server        *httptest.Server
+	filters       []map[string]any
+	updatedState  string
+	details       map[string]string
+	repoImpl      *RepoImpl
+	authorization string
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	s.filters, s.updatedState, s.authorization = nil, "", ""
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.authorization = r.Header.Get("Authorization")
+		if s.authorization != "lin_api_key" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]any{"errors": []map[string]string{{"message": "Authentication required, not authenticated"}}})
+			return
+		}
+
+		var request struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&request)
+
+		switch {
+		case strings.Contains(request.Query, "issueUpdate("):
+			s.updatedState = request.Variables["stateId"].(string)
+			json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"issueUpdate": map[string]bool{"success": true}}})
+		case strings.Contains(request.Query, "issue(id:"):
+			json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"issue": map[string]any{"team": map[string]any{"states": map[string]any{"nodes": []map[string]any{
+				{"id": "state-review", "name": "In Review", "type": "started", "position": 3},
+				{"id": "state-progress", "name": "In Progress", "type": "started", "position": 2},
+				{"id": "state-done", "name": "Done", "type": "completed", "position": 4},
+			}}}}}})
+		case strings.Contains(request.Query, "issues("):
+			filter, _ := request.Variables["filter"].(map[string]any)
+			s.filters = append(s.filters, filter)
+			if request.Variables["after"] == nil {
+				json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"issues": map[string]any{
+					"nodes": []map[string]any{
+						{"id": "issue-1", "identifier": "ENG-1", "title": "any-issue", "description": "any-description"},
+						{"id": "issue-2", "identifier": "ENG-2", "title": "any-sub-issue", "parent": map[string]string{"id": "issue-1"}},
+					},
+					"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "cursor-1"},
+				}}})
+				return
+			}
+
+			json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"issues": map[string]any{
+				"nodes": []map[string]any{
+					{"id": "issue-3", "identifier": "ENG-3", "title": "other-sub-issue", "parent": map[string]string{"id": "issue-9"}},
+				},
+				"pageInfo": map[string]any{"hasNextPage": false},
+			}}})
+		}
+	}))
+	s.details = map[string]string{"token": "secret:project/project-1/Linear/token"}
+	s.repoImpl = &RepoImpl{
+		secretRepo: &secretRepoStub{secrets: map[string]string{
+			"project/project-1/Linear/token": "lin_api_key",
+		}},
+		apiURL:     s.server.URL,
+		httpClient: s.server.Client(),
+	}
+}
+
+func (s *RepoImplTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+func (s *RepoImplTestSuite) TestGetTasks() {
+	details := map[string]string{"token": s.details["token"], "team": "ENG", "cycle": "current"}
+
+	tasks, err := s.repoImpl.GetTasks(context.Background(), "project-1", details)
+
+	s.NoError(err)
+	s.Equal(entity.Tasks{
+		{
+			ID:          "issue-1",
+			ProjectID:   "project-1",
+			Name:        "any-issue",
+			Description: "any-description",
+			Integration: entity.TaskIntegration{ID: "ENG-1", Type: entity.IntegrationTypeLinear},
+		},
+		{
+			ID:           "issue-2",
+			ProjectID:    "project-1",
+			Name:         "any-sub-issue",
+			ParentTaskID: "issue-1",
+			Integration:  entity.TaskIntegration{ID: "ENG-2", Type: entity.IntegrationTypeLinear},
+		},
+		{
+			ID:          "issue-3",
+			ProjectID:   "project-1",
+			Name:        "other-sub-issue",
+			Integration: entity.TaskIntegration{ID: "ENG-3", Type: entity.IntegrationTypeLinear},
+		},
+	}, tasks)
+	s.Len(s.filters, 2)
+	s.Equal(map[string]any{
+		"assignee": map[string]any{"isMe": map[string]any{"eq": true}},
+		"state":    map[string]any{"type": map[string]any{"nin": []any{"completed", "canceled"}}},
+		"team":     map[string]any{"key": map[string]any{"eq": "ENG"}},
+		"cycle":    map[string]any{"isActive": map[string]any{"eq": true}},
+	}, s.filters[0])
+}
+
+func (s *RepoImplTestSuite) TestGetTasksInvalidKey() {
+	_, err := s.repoImpl.GetTasks(context.Background(), "project-1", map[string]string{"token": "any-key"})
+
+	s.EqualError(err, "failed to get issues: Authentication required, not authenticated")
+}
+
+func (s *RepoImplTestSuite) TestMoveIssue() {
+	tests := []struct {
+		name          string
+		move          func(integration entity.Integration) error
+		details       map[string]string
+		expectedState string
+		expectedError string
+	}{
+		{
+			name: "start to the first started state",
+			move: func(integration entity.Integration) error {
+				return s.repoImpl.Start(context.Background(), "issue-1", integration)
+			},
+			expectedState: "state-progress",
+		},
+		{
+			name: "start to the configured state",
+			move: func(integration entity.Integration) error {
+				return s.repoImpl.Start(context.Background(), "issue-1", integration)
+			},
+			details:       map[string]string{"start_state": "in review"},
+			expectedState: "state-review",
+		},
+		{
+			name: "complete to the first completed state",
+			move: func(integration entity.Integration) error {
+				return s.repoImpl.Transition(context.Background(), "issue-1", integration)
+			},
+			expectedState: "state-done",
+		},
+		{
+			name: "configured state not found",
+			move: func(integration entity.Integration) error {
+				return s.repoImpl.Transition(context.Background(), "issue-1", integration)
+			},
+			details:       map[string]string{"done_state": "Shipped"},
+			expectedError: "failed to find completed state Shipped",
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.updatedState = ""
+			details := map[string]string{"token": s.details["token"]}
+			for key, value := range test.details {
+				details[key] = value
+			}
+
+			err := test.move(entity.Integration{Type: entity.IntegrationTypeLinear, Details: details})
+
+			if test.expectedError != "" {
+				s.EqualError(err, test.expectedError)
+			} else {
+				s.NoError(err)
+			}
+			s.Equal(test.expectedState, s.updatedState)
+		})
+	}
+}
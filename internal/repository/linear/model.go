@@ -0,0 +1,64 @@
+package linear
+
+import "encoding/json"
+
+type RequestModel struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type ErrorModel struct {
+	Message string `json:"message"`
+}
+
+type ResponseModel struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []ErrorModel    `json:"errors"`
+}
+
+type ParentModel struct {
+	ID string `json:"id"`
+}
+
+type IssueModel struct {
+	ID          string       `json:"id"`
+	Identifier  string       `json:"identifier"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Parent      *ParentModel `json:"parent"`
+}
+
+type PageInfoModel struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+type IssuesModel struct {
+	Issues struct {
+		Nodes    []IssueModel  `json:"nodes"`
+		PageInfo PageInfoModel `json:"pageInfo"`
+	} `json:"issues"`
+}
+
+type WorkflowStateModel struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Position float64 `json:"position"`
+}
+
+type IssueStatesModel struct {
+	Issue struct {
+		Team struct {
+			States struct {
+				Nodes []WorkflowStateModel `json:"nodes"`
+			} `json:"states"`
+		} `json:"team"`
+	} `json:"issue"`
+}
+
+type IssueUpdateModel struct {
+	IssueUpdate struct {
+		Success bool `json:"success"`
+	} `json:"issueUpdate"`
+}
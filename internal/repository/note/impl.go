@@ -0,0 +1,84 @@
+package note
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/repository/transaction"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// CREATE_TABLE_QUERY is run by Migrate since databases created before notes
+// were added, or synced from such a device, lack the table.
+const CREATE_TABLE_QUERY = `CREATE TABLE IF NOT EXISTS task_notes (
+	id VARCHAR PRIMARY KEY,
+	task_id VARCHAR NOT NULL,
+	content TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+)`
+
+type RepoImpl struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *RepoImpl {
+	return &RepoImpl{db: db}
+}
+
+// conn joins the transaction ctx runs in, if any.
+func (ri *RepoImpl) conn(ctx context.Context) transaction.Conn {
+	return transaction.From(ctx, ri.db)
+}
+
+// Migrate creates the task_notes table when it is missing.
+func (ri *RepoImpl) Migrate(ctx context.Context) error {
+	if _, err := ri.conn(ctx).ExecContext(ctx, CREATE_TABLE_QUERY); err != nil {
+		return fmt.Errorf("failed to create task_notes table: %w", err)
+	}
+
+	return nil
+}
+
+func (ri *RepoImpl) Insert(ctx context.Context, noteEntity entity.TaskNote) error {
+	note := CreateModel(noteEntity)
+	query := `INSERT INTO task_notes (id, task_id, content, created_at) VALUES (?, ?, ?, ?)`
+	_, err := ri.conn(ctx).ExecContext(ctx, query, note.ID, note.TaskID, note.Content, note.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert note: %w", err)
+	}
+
+	return nil
+}
+
+func (ri *RepoImpl) GetByTaskID(ctx context.Context, taskID string) (entity.TaskNotes, error) {
+	query := `SELECT id, task_id, content, created_at FROM task_notes WHERE task_id = ? ORDER BY created_at`
+	return ri.getNotes(ctx, query, taskID)
+}
+
+func (ri *RepoImpl) GetAll(ctx context.Context) (entity.TaskNotes, error) {
+	query := `SELECT id, task_id, content, created_at FROM task_notes ORDER BY created_at`
+	return ri.getNotes(ctx, query)
+}
+
+func (ri *RepoImpl) getNotes(ctx context.Context, query string, args ...any) (entity.TaskNotes, error) {
+	rows, err := ri.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notes: %w", err)
+	}
+	defer rows.Close()
+
+	var notes entity.TaskNotes
+	for rows.Next() {
+		var note NoteModel
+		if err = rows.Scan(&note.ID, &note.TaskID, &note.Content, &note.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan note: %w", err)
+		}
+
+		notes = append(notes, note.ToEntity())
+	}
+
+	return notes, nil
+}
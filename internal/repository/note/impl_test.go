@@ -0,0 +1,179 @@
+package note
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type RepoImplTestSuite struct {
+	suite.Suite
+	db       sqlmock.Sqlmock
+	repoImpl RepoImpl
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.db = mock
+
+	s.repoImpl = RepoImpl{db: db}
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+func (s *RepoImplTestSuite) TestMigrate() {
+	s.db.ExpectExec(CREATE_TABLE_QUERY).WillReturnError(errors.New("any-error"))
+	err := s.repoImpl.Migrate(context.Background())
+	s.Equal(errors.New("any-error"), errors.Unwrap(err))
+
+	s.db.ExpectExec(CREATE_TABLE_QUERY).WillReturnResult(sqlmock.NewResult(0, 0))
+	s.NoError(s.repoImpl.Migrate(context.Background()))
+	s.NoError(s.db.ExpectationsWereMet())
+}
+
+func (s *RepoImplTestSuite) TestInsert() {
+	note := entity.TaskNote{
+		ID:        "note-1",
+		TaskID:    "task-1",
+		Content:   "any-content",
+		CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name          string
+		expectedError error
+		mock          func()
+	}{
+		{
+			name:          "failed to insert note",
+			expectedError: errors.New("any-error"),
+			mock: func() {
+				s.db.ExpectExec(`INSERT INTO task_notes (id, task_id, content, created_at) VALUES (?, ?, ?, ?)`).
+					WithArgs(note.ID, note.TaskID, note.Content, note.CreatedAt).
+					WillReturnError(errors.New("any-error"))
+			},
+		},
+		{
+			name: "success",
+			mock: func() {
+				s.db.ExpectExec(`INSERT INTO task_notes (id, task_id, content, created_at) VALUES (?, ?, ?, ?)`).
+					WithArgs(note.ID, note.TaskID, note.Content, note.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.mock()
+
+			err := s.repoImpl.Insert(context.Background(), note)
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			s.Equal(test.expectedError, err)
+			s.NoError(s.db.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *RepoImplTestSuite) TestGetByTaskID() {
+	query := `SELECT id, task_id, content, created_at FROM task_notes WHERE task_id = ? ORDER BY created_at`
+	tests := []struct {
+		name          string
+		expected      entity.TaskNotes
+		expectedError error
+		mock          func()
+	}{
+		{
+			name:          "failed to get notes",
+			expectedError: errors.New("any-error"),
+			mock: func() {
+				s.db.ExpectQuery(query).WithArgs("task-1").WillReturnError(errors.New("any-error"))
+			},
+		},
+		{
+			name: "success",
+			expected: entity.TaskNotes{
+				{
+					ID:        "note-1",
+					TaskID:    "task-1",
+					Content:   "any-content",
+					CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"id", "task_id", "content", "created_at"}).
+					AddRow("note-1", "task-1", "any-content", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+				s.db.ExpectQuery(query).WithArgs("task-1").WillReturnRows(rows)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.mock()
+
+			notes, err := s.repoImpl.GetByTaskID(context.Background(), "task-1")
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			s.Equal(test.expected, notes)
+			s.Equal(test.expectedError, err)
+		})
+	}
+}
+
+func (s *RepoImplTestSuite) TestGetAll() {
+	query := `SELECT id, task_id, content, created_at FROM task_notes ORDER BY created_at`
+	tests := []struct {
+		name          string
+		expected      entity.TaskNotes
+		expectedError error
+		mock          func()
+	}{
+		{
+			name:          "failed to get notes",
+			expectedError: errors.New("any-error"),
+			mock: func() {
+				s.db.ExpectQuery(query).WillReturnError(errors.New("any-error"))
+			},
+		},
+		{
+			name: "success",
+			expected: entity.TaskNotes{
+				{ID: "note-1", TaskID: "task-1", Content: "any-content", CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+				{ID: "note-2", TaskID: "task-2", Content: "other-content", CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+			},
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"id", "task_id", "content", "created_at"}).
+					AddRow("note-1", "task-1", "any-content", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)).
+					AddRow("note-2", "task-2", "other-content", time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+				s.db.ExpectQuery(query).WillReturnRows(rows)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			test.mock()
+
+			notes, err := s.repoImpl.GetAll(context.Background())
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			s.Equal(test.expected, notes)
+			s.Equal(test.expectedError, err)
+		})
+	}
+}
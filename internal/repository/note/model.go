@@ -0,0 +1,37 @@
+package note
+
+import (
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type NoteModel struct {
+	ID        string
+	TaskID    string
+	Content   string
+	CreatedAt time.Time
+}
+
+func (nm NoteModel) ToEntity() entity.TaskNote {
+	return entity.TaskNote{
+		ID:        nm.ID,
+		TaskID:    nm.TaskID,
+		Content:   nm.Content,
+		CreatedAt: nm.CreatedAt,
+	}
+}
+
+func CreateModel(note entity.TaskNote) NoteModel {
+	if note.ID == "" {
+		note.ID = uuid.NewString()
+	}
+
+	return NoteModel{
+		ID:        note.ID,
+		TaskID:    note.TaskID,
+		Content:   note.Content,
+		CreatedAt: note.CreatedAt,
+	}
+}
@@ -0,0 +1,250 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration"
+	"github.com/azisuazusa/todo-cli/internal/repository/secret"
+)
+
+// PLUGIN_PREFIX names the executables discovered as integrations, the rest of
+// the name is the integration type.
+const PLUGIN_PREFIX = "todo-integration-"
+
+type SecretRepo interface {
+	Get(ctx context.Context, key string) (string, error)
+}
+
+// RepoImpl bridges an external executable into an integration provider. Each
+// call runs the executable with one JSON request on stdin and reads one JSON
+// response from stdout.
+type RepoImpl struct {
+	name       string
+	path       string
+	secretRepo SecretRepo
+	timeout    time.Duration
+
+	describeOnce sync.Once
+	description  DescribeResultModel
+	describeErr  error
+}
+
+func New(name, path string, secretRepo SecretRepo) *RepoImpl {
+	return &RepoImpl{
+		name:       name,
+		path:       path,
+		secretRepo: secretRepo,
+		timeout:    30 * time.Second,
+	}
+}
+
+// Discover returns the plugins found in the directories of pathList, a
+// plugin earlier in the list shadows the ones of the same name after it.
+func Discover(pathList string, secretRepo SecretRepo) []*RepoImpl {
+	var plugins []*RepoImpl
+	found := map[string]bool{}
+	for _, dir := range filepath.SplitList(pathList) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name, ok := strings.CutPrefix(entry.Name(), PLUGIN_PREFIX)
+			if !ok || name == "" || found[name] {
+				continue
+			}
+
+			path := filepath.Join(dir, entry.Name())
+			info, err := os.Stat(path)
+			if err != nil || info.IsDir() || info.Mode().Perm()&0111 == 0 {
+				continue
+			}
+
+			found[name] = true
+			plugins = append(plugins, New(name, path, secretRepo))
+		}
+	}
+
+	return plugins
+}
+
+// Metadata does not run the plugin, it is needed every time the registry is
+// looked up.
+func (ri *RepoImpl) Metadata() entity.IntegrationMetadata {
+	return entity.IntegrationMetadata{
+		Type:        entity.IntegrationType(ri.name),
+		Description: fmt.Sprintf("External integration %s", ri.path),
+	}
+}
+
+func (ri *RepoImpl) Schema() []entity.IntegrationField {
+	description, err := ri.describe()
+	if err != nil {
+		return nil
+	}
+
+	var fields []entity.IntegrationField
+	for _, field := range description.Fields {
+		fields = append(fields, entity.IntegrationField{
+			Key:     field.Key,
+			Label:   field.Label,
+			Help:    field.Help,
+			Type:    entity.IntegrationFieldType(field.Type),
+			Default: field.Default,
+			Options: field.Options,
+			When:    field.When,
+		})
+	}
+
+	return fields
+}
+
+func (ri *RepoImpl) Capabilities() entity.IntegrationCapabilities {
+	description, err := ri.describe()
+	if err != nil {
+		return entity.IntegrationCapabilities{}
+	}
+
+	return entity.IntegrationCapabilities{
+		Worklog:    description.Capabilities.Worklog,
+		Transition: description.Capabilities.Transition,
+		Start:      description.Capabilities.Start,
+	}
+}
+
+// Authorize reports a plugin which failed to describe itself, Schema has no
+// way to.
+func (ri *RepoImpl) Authorize(ctx context.Context, details map[string]string, ask integration.Ask) (map[string]string, error) {
+	if _, err := ri.describe(); err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+func (ri *RepoImpl) GetTasks(ctx context.Context, projectID string, integrationDetails map[string]string) (entity.Tasks, error) {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationDetails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s credentials: %w", ri.name, err)
+	}
+
+	var result GetTasksResultModel
+	params := GetTasksParamsModel{ProjectID: projectID, Details: details}
+	if err = ri.call(ctx, MethodGetTasks, params, &result); err != nil {
+		return nil, err
+	}
+
+	var tasks entity.Tasks
+	for _, task := range result.Tasks {
+		key := task.Key
+		if key == "" {
+			key = task.ID
+		}
+
+		tasks = append(tasks, entity.Task{
+			ID:          task.ID,
+			ProjectID:   projectID,
+			Name:        task.Name,
+			Description: task.Description,
+			Integration: entity.TaskIntegration{
+				ID:   key,
+				Type: entity.IntegrationType(ri.name),
+			},
+		})
+	}
+
+	return tasks, nil
+}
+
+func (ri *RepoImpl) AddWorklog(ctx context.Context, issueID, taskName string, timeSpent time.Duration, integrationEntity entity.Integration) error {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationEntity.Details)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s credentials: %w", ri.name, err)
+	}
+
+	params := AddWorklogParamsModel{
+		IssueID:          issueID,
+		TaskName:         taskName,
+		TimeSpentSeconds: int64(timeSpent.Seconds()),
+		Details:          details,
+	}
+
+	return ri.call(ctx, MethodAddWorklog, params, nil)
+}
+
+func (ri *RepoImpl) Transition(ctx context.Context, issueID string, integrationEntity entity.Integration) error {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationEntity.Details)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s credentials: %w", ri.name, err)
+	}
+
+	return ri.call(ctx, MethodTransition, TransitionParamsModel{IssueID: issueID, Details: details}, nil)
+}
+
+func (ri *RepoImpl) Start(ctx context.Context, issueID string, integrationEntity entity.Integration) error {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationEntity.Details)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s credentials: %w", ri.name, err)
+	}
+
+	return ri.call(ctx, MethodStart, TransitionParamsModel{IssueID: issueID, Details: details}, nil)
+}
+
+// describe runs the plugin once per invocation of todo-cli, its schema and
+// capabilities do not change in between.
+func (ri *RepoImpl) describe() (DescribeResultModel, error) {
+	ri.describeOnce.Do(func() {
+		ri.describeErr = ri.call(context.Background(), MethodDescribe, nil, &ri.description)
+	})
+
+	return ri.description, ri.describeErr
+}
+
+func (ri *RepoImpl) call(ctx context.Context, method string, params, result any) error {
+	input, err := json.Marshal(RequestModel{Version: PROTOCOL_VERSION, Method: method, Params: params})
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s request: %w", method, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ri.timeout)
+	defer cancel()
+
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext(ctx, ri.path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	if err = cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run %s plugin: %w", ri.name, err)
+	}
+
+	var response ResponseModel
+	if err = json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", method, err)
+	}
+
+	if response.Error != "" {
+		return fmt.Errorf("%s plugin failed to %s: %s", ri.name, method, response.Error)
+	}
+
+	if result == nil || len(response.Result) == 0 {
+		return nil
+	}
+
+	if err = json.Unmarshal(response.Result, result); err != nil {
+		return fmt.Errorf("failed to decode %s result: %w", method, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/integration"
+	"github.com/stretchr/testify/suite"
+)
+
+type secretRepoStub struct {
+	secrets map[string]string
+}
+
+func (r *secretRepoStub) Get(ctx context.Context, key string) (string, error) {
+	return r.secrets[key], nil
+}
+
+// RepoImplTestSuite checks the protocol contract against the sample plugin.
+type RepoImplTestSuite struct {
+	suite.Suite
+	binDir     string
+	issuesFile string
+	secretRepo *secretRepoStub
+	repoImpl   *RepoImpl
+}
+
+func (s *RepoImplTestSuite) SetupSuite() {
+	s.binDir = s.T().TempDir()
+	cmd := exec.Command("go", "build", "-o", filepath.Join(s.binDir, PLUGIN_PREFIX+"example"), "../../../examples/todo-integration-example")
+	output, err := cmd.CombinedOutput()
+	s.Require().NoError(err, string(output))
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	s.issuesFile = filepath.Join(s.T().TempDir(), "issues.json")
+	s.Require().NoError(os.WriteFile(s.issuesFile, []byte(`[
+		{"id": "10001", "key": "ACME-1", "name": "any-name", "description": "any-description"},
+		{"id": "10002", "name": "other-name", "done": true}
+	]`), 0644))
+	s.secretRepo = &secretRepoStub{secrets: map[string]string{"project/project-1/example/api_key": "any-key"}}
+	s.repoImpl = New("example", filepath.Join(s.binDir, PLUGIN_PREFIX+"example"), s.secretRepo)
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+func (s *RepoImplTestSuite) writePlugin(name, script string, perm os.FileMode) string {
+	path := filepath.Join(s.T().TempDir(), PLUGIN_PREFIX+name)
+	s.Require().NoError(os.WriteFile(path, []byte(script), perm))
+	return path
+}
+
+func (s *RepoImplTestSuite) TestDiscover() {
+	dir := s.T().TempDir()
+	shadowed := s.writePlugin("example", "#!/bin/sh\n", 0755)
+	notExecutable := s.writePlugin("other", "#!/bin/sh\n", 0644)
+	s.Require().NoError(os.Mkdir(filepath.Join(dir, PLUGIN_PREFIX+"dir"), 0755))
+
+	pathList := s.binDir + string(os.PathListSeparator) + filepath.Dir(shadowed) + string(os.PathListSeparator) + filepath.Dir(notExecutable) + string(os.PathListSeparator) + dir
+	plugins := Discover(pathList, s.secretRepo)
+
+	s.Len(plugins, 1)
+	s.Equal(entity.IntegrationType("example"), plugins[0].Metadata().Type)
+	s.Equal(filepath.Join(s.binDir, PLUGIN_PREFIX+"example"), plugins[0].path)
+}
+
+func (s *RepoImplTestSuite) TestDescribe() {
+	definition := integration.Definition(s.repoImpl)
+
+	s.Equal(entity.IntegrationType("example"), definition.Type)
+	s.Equal([]entity.IntegrationField{
+		{Key: "file", Label: "Issues File", Help: "A JSON array of issues with id, key, name and description."},
+		{Key: "api_key", Label: "API Key", Type: entity.IntegrationFieldSecret},
+	}, definition.Fields)
+	s.Equal([]string{"api_key"}, definition.SecretFields())
+	s.Equal(entity.IntegrationCapabilities{Worklog: true, Transition: true}, definition.Capabilities)
+}
+
+func (s *RepoImplTestSuite) TestGetTasks() {
+	details := map[string]string{"file": s.issuesFile, "api_key": "secret:project/project-1/example/api_key"}
+
+	tasks, err := s.repoImpl.GetTasks(context.Background(), "project-1", details)
+
+	s.NoError(err)
+	s.Equal(entity.Tasks{
+		{
+			ID:          "10001",
+			ProjectID:   "project-1",
+			Name:        "any-name",
+			Description: "any-description",
+			Integration: entity.TaskIntegration{ID: "ACME-1", Type: "example"},
+		},
+	}, tasks)
+}
+
+func (s *RepoImplTestSuite) TestAddWorklog() {
+	integrationEntity := entity.Integration{Type: "example", Details: map[string]string{"file": s.issuesFile}}
+
+	err := s.repoImpl.AddWorklog(context.Background(), "10001", "any-name", 90*time.Minute, integrationEntity)
+
+	s.NoError(err)
+	worklog, err := os.ReadFile(s.issuesFile + ".worklog")
+	s.NoError(err)
+	s.Equal("10001\t1h30m0s\tany-name\n", string(worklog))
+}
+
+func (s *RepoImplTestSuite) TestTransition() {
+	integrationEntity := entity.Integration{Type: "example", Details: map[string]string{"file": s.issuesFile}}
+
+	err := s.repoImpl.Transition(context.Background(), "10001", integrationEntity)
+
+	s.NoError(err)
+	tasks, err := s.repoImpl.GetTasks(context.Background(), "project-1", integrationEntity.Details)
+	s.NoError(err)
+	s.Empty(tasks)
+}
+
+func (s *RepoImplTestSuite) TestErrors() {
+	tests := []struct {
+		name          string
+		script        string
+		expectedError string
+	}{
+		{
+			name:          "plugin reports an error",
+			script:        "#!/bin/sh\necho '{\"error\": \"any-error\"}'\n",
+			expectedError: "failing plugin failed to get_tasks: any-error",
+		},
+		{
+			name:          "plugin exits with an error",
+			script:        "#!/bin/sh\nexit 3\n",
+			expectedError: "failed to run failing plugin: exit status 3",
+		},
+		{
+			name:          "plugin writes an invalid response",
+			script:        "#!/bin/sh\necho 'not json'\n",
+			expectedError: "failed to decode get_tasks response: invalid character 'o' in literal null (expecting 'u')",
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			repoImpl := New("failing", s.writePlugin("failing", test.script, 0755), s.secretRepo)
+
+			_, err := repoImpl.GetTasks(context.Background(), "project-1", nil)
+
+			s.EqualError(err, test.expectedError)
+			s.Empty(integration.Definition(repoImpl).Fields)
+			_, err = repoImpl.Authorize(context.Background(), nil, nil)
+			s.Error(err)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package plugin
+
+import "encoding/json"
+
+// PROTOCOL_VERSION is sent with every request, plugins reject the versions
+// they do not speak.
+const PROTOCOL_VERSION = 1
+
+const (
+	MethodDescribe   = "describe"
+	MethodGetTasks   = "get_tasks"
+	MethodAddWorklog = "add_worklog"
+	MethodTransition = "transition"
+	MethodStart      = "start"
+)
+
+type RequestModel struct {
+	Version int    `json:"version"`
+	Method  string `json:"method"`
+	Params  any    `json:"params,omitempty"`
+}
+
+type ResponseModel struct {
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error"`
+}
+
+type FieldModel struct {
+	Key     string            `json:"key"`
+	Label   string            `json:"label"`
+	Help    string            `json:"help"`
+	Type    string            `json:"type"`
+	Default string            `json:"default"`
+	Options []string          `json:"options"`
+	When    map[string]string `json:"when"`
+}
+
+type CapabilitiesModel struct {
+	Worklog    bool `json:"worklog"`
+	Transition bool `json:"transition"`
+	Start      bool `json:"start"`
+}
+
+type DescribeResultModel struct {
+	Fields       []FieldModel      `json:"fields"`
+	Capabilities CapabilitiesModel `json:"capabilities"`
+}
+
+type GetTasksParamsModel struct {
+	ProjectID string            `json:"project_id"`
+	Details   map[string]string `json:"details"`
+}
+
+type TaskModel struct {
+	ID          string `json:"id"`
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type GetTasksResultModel struct {
+	Tasks []TaskModel `json:"tasks"`
+}
+
+type AddWorklogParamsModel struct {
+	IssueID          string            `json:"issue_id"`
+	TaskName         string            `json:"task_name"`
+	TimeSpentSeconds int64             `json:"time_spent_seconds"`
+	Details          map[string]string `json:"details"`
+}
+
+// TransitionParamsModel is also sent to start an issue.
+type TransitionParamsModel struct {
+	IssueID string            `json:"issue_id"`
+	Details map[string]string `json:"details"`
+}
@@ -6,20 +6,33 @@ import (
 	"fmt"
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/repository/secret"
+	"github.com/azisuazusa/todo-cli/internal/repository/transaction"
+	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 )
 
+type SecretRepo interface {
+	Set(ctx context.Context, key, value string) error
+}
+
 type RepoImpl struct {
-	db *sql.DB
+	db         *sql.DB
+	secretRepo SecretRepo
+}
+
+func New(db *sql.DB, secretRepo SecretRepo) *RepoImpl {
+	return &RepoImpl{db: db, secretRepo: secretRepo}
 }
 
-func New(db *sql.DB) *RepoImpl {
-	return &RepoImpl{db: db}
+// conn joins the transaction ctx runs in, if any.
+func (ri *RepoImpl) conn(ctx context.Context) transaction.Conn {
+	return transaction.From(ctx, ri.db)
 }
 
 func (ri *RepoImpl) GetAll(ctx context.Context) (entity.Projects, error) {
 	query := `SELECT * FROM projects`
-	rows, err := ri.db.QueryContext(ctx, query)
+	rows, err := ri.conn(ctx).QueryContext(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query projects: %w", err)
 	}
@@ -46,13 +59,22 @@ func (ri *RepoImpl) GetAll(ctx context.Context) (entity.Projects, error) {
 }
 
 func (ri *RepoImpl) Insert(ctx context.Context, projectEntity entity.Project) error {
+	if projectEntity.ID == "" {
+		projectEntity.ID = uuid.NewString()
+	}
+
+	projectEntity, err := ri.storeSecrets(ctx, projectEntity)
+	if err != nil {
+		return fmt.Errorf("failed to store secrets: %w", err)
+	}
+
 	project, err := CreateModel(projectEntity)
 	if err != nil {
 		return fmt.Errorf("failed to create project model: %w", err)
 	}
 
 	query := `INSERT INTO projects (id, name, description, is_selected, integrations) VALUES (?, ?, ?, ?, ?)`
-	_, err = ri.db.ExecContext(ctx, query, project.ID, project.Name, project.Description, project.IsSelected, project.Integrations)
+	_, err = ri.conn(ctx).ExecContext(ctx, query, project.ID, project.Name, project.Description, project.IsSelected, project.Integrations)
 	if err != nil {
 		return fmt.Errorf("failed to insert project: %w", err)
 	}
@@ -61,13 +83,18 @@ func (ri *RepoImpl) Insert(ctx context.Context, projectEntity entity.Project) er
 }
 
 func (ri *RepoImpl) Update(ctx context.Context, projectEntity entity.Project) error {
+	projectEntity, err := ri.storeSecrets(ctx, projectEntity)
+	if err != nil {
+		return fmt.Errorf("failed to store secrets: %w", err)
+	}
+
 	project, err := CreateModel(projectEntity)
 	if err != nil {
 		return fmt.Errorf("failed to create project model: %w", err)
 	}
 
 	query := `UPDATE projects SET name = ?, description = ?, is_selected = ?, integrations = ? WHERE id = ?`
-	_, err = ri.db.ExecContext(ctx, query, project.Name, project.Description, project.IsSelected, project.Integrations, project.ID)
+	_, err = ri.conn(ctx).ExecContext(ctx, query, project.Name, project.Description, project.IsSelected, project.Integrations, project.ID)
 	if err != nil {
 		return fmt.Errorf("failed to update project: %w", err)
 	}
@@ -77,7 +104,7 @@ func (ri *RepoImpl) Update(ctx context.Context, projectEntity entity.Project) er
 
 func (ri *RepoImpl) Delete(ctx context.Context, projectID string) error {
 	query := `DELETE FROM projects WHERE id = ?`
-	_, err := ri.db.ExecContext(ctx, query, projectID)
+	_, err := ri.conn(ctx).ExecContext(ctx, query, projectID)
 	if err != nil {
 		return fmt.Errorf("failed to delete project: %w", err)
 	}
@@ -88,7 +115,7 @@ func (ri *RepoImpl) Delete(ctx context.Context, projectID string) error {
 func (ri *RepoImpl) GetByID(ctx context.Context, id string) (entity.Project, error) {
 	var project ProjectModel
 	query := `SELECT * FROM projects WHERE id = ? LIMIT 1`
-	row := ri.db.QueryRowContext(ctx, query, id)
+	row := ri.conn(ctx).QueryRowContext(ctx, query, id)
 	err := row.Scan(&project.ID, &project.Name, &project.Description, &project.IsSelected, &project.Integrations)
 	if err != nil {
 		return entity.Project{}, fmt.Errorf("failed to scan project: %w", err)
@@ -105,7 +132,7 @@ func (ri *RepoImpl) GetByID(ctx context.Context, id string) (entity.Project, err
 func (ri *RepoImpl) GetSelectedProject(ctx context.Context) (entity.Project, error) {
 	var project ProjectModel
 	query := `SELECT * FROM projects WHERE is_selected = ? LIMIT 1`
-	row := ri.db.QueryRowContext(ctx, query, true)
+	row := ri.conn(ctx).QueryRowContext(ctx, query, true)
 	err := row.Scan(&project.ID, &project.Name, &project.Description, &project.IsSelected, &project.Integrations)
 	if err != nil {
 		return entity.Project{}, fmt.Errorf("failed to scan project: %w", err)
@@ -119,18 +146,49 @@ func (ri *RepoImpl) GetSelectedProject(ctx context.Context) (entity.Project, err
 	return projectEntity, nil
 }
 
-func (ri *RepoImpl) SetSelectedProject(ctx context.Context, id string) (err error) {
-	query := `UPDATE projects SET is_selected = ?`
-	_, err = ri.db.ExecContext(ctx, query, false)
-	if err != nil {
-		return fmt.Errorf("failed to update project: %w", err)
+// SetSelectedProject deselects the other projects in the same transaction,
+// so exactly one project stays selected.
+func (ri *RepoImpl) SetSelectedProject(ctx context.Context, id string) error {
+	return transaction.Run(ctx, ri.db, func(ctx context.Context) error {
+		query := `UPDATE projects SET is_selected = ?`
+		_, err := ri.conn(ctx).ExecContext(ctx, query, false)
+		if err != nil {
+			return fmt.Errorf("failed to update project: %w", err)
+		}
+
+		query = `UPDATE projects SET is_selected = ? WHERE id = ?`
+		_, err = ri.conn(ctx).ExecContext(ctx, query, true, id)
+		if err != nil {
+			return fmt.Errorf("failed to update project: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// storeSecrets keeps integration credentials out of the database, which is
+// uploaded as-is by the sync integrations. They are keyed by integration ID
+// so two integrations of the same type do not overwrite each other.
+func (ri *RepoImpl) storeSecrets(ctx context.Context, project entity.Project) (entity.Project, error) {
+	integrations := make([]entity.Integration, 0, len(project.Integrations))
+	for _, integration := range project.Integrations {
+		if integration.ID == "" {
+			integration.ID = uuid.NewString()
+		}
+
+		keyPrefix := fmt.Sprintf("project/%s/%s", project.ID, integration.ID)
+		details, err := secret.StoreDetails(ctx, ri.secretRepo, keyPrefix, integration.Details, integration.SecretKeys())
+		if err != nil {
+			return entity.Project{}, err
+		}
+
+		integration.Details = details
+		integrations = append(integrations, integration)
 	}
 
-	query = `UPDATE projects SET is_selected = ? WHERE id = ?`
-	_, err = ri.db.ExecContext(ctx, query, true, id)
-	if err != nil {
-		return fmt.Errorf("failed to update project: %w", err)
+	if len(integrations) > 0 {
+		project.Integrations = integrations
 	}
 
-	return nil
+	return project, nil
 }
@@ -11,17 +11,28 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
+type secretRepoStub struct {
+	secrets map[string]string
+}
+
+func (r *secretRepoStub) Set(ctx context.Context, key, value string) error {
+	r.secrets[key] = value
+	return nil
+}
+
 type RepoImplTestSuite struct {
 	suite.Suite
-	db       sqlmock.Sqlmock
-	repoImpl RepoImpl
+	db         sqlmock.Sqlmock
+	secretRepo *secretRepoStub
+	repoImpl   RepoImpl
 }
 
 func (t *RepoImplTestSuite) SetupTest() {
 	db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	t.db = mock
+	t.secretRepo = &secretRepoStub{secrets: map[string]string{}}
 
-	t.repoImpl = RepoImpl{db: db}
+	t.repoImpl = RepoImpl{db: db, secretRepo: t.secretRepo}
 }
 
 func TestRepoImpl(t *testing.T) {
@@ -72,6 +83,7 @@ func (t *RepoImplTestSuite) TestGetAll() {
 					IsSelected:  true,
 					Integrations: []entity.Integration{
 						{
+							ID:        "integration-1",
 							IsEnabled: true,
 							Type:      "JIRA",
 							Details: map[string]string{
@@ -83,7 +95,7 @@ func (t *RepoImplTestSuite) TestGetAll() {
 			},
 			expectedErr: nil,
 			mockFunc: func() {
-				rows := sqlmock.NewRows([]string{"id", "name", "description", "is_selected", "integrations"}).AddRow("project-1", "Project 1", "description", true, `[{"is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`)
+				rows := sqlmock.NewRows([]string{"id", "name", "description", "is_selected", "integrations"}).AddRow("project-1", "Project 1", "description", true, `[{"id":"integration-1","is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`)
 				t.db.ExpectQuery(query).WillReturnRows(rows)
 			},
 		},
@@ -121,6 +133,7 @@ func (t *RepoImplTestSuite) TestInsert() {
 				IsSelected:  true,
 				Integrations: []entity.Integration{
 					{
+						ID:        "integration-1",
 						IsEnabled: true,
 						Type:      "JIRA",
 						Details: map[string]string{
@@ -131,7 +144,7 @@ func (t *RepoImplTestSuite) TestInsert() {
 			},
 			expectedErr: sql.ErrConnDone,
 			mockFunc: func(project entity.Project) {
-				t.db.ExpectExec(query).WithArgs(project.ID, project.Name, project.Description, project.IsSelected, `[{"is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`).WillReturnError(sql.ErrConnDone)
+				t.db.ExpectExec(query).WithArgs(project.ID, project.Name, project.Description, project.IsSelected, `[{"id":"integration-1","is_enabled":true,"type":"JIRA","details":{"token":"secret:project/project-1/integration-1/token"}}]`).WillReturnError(sql.ErrConnDone)
 			},
 		},
 		{
@@ -143,6 +156,7 @@ func (t *RepoImplTestSuite) TestInsert() {
 				IsSelected:  true,
 				Integrations: []entity.Integration{
 					{
+						ID:        "integration-1",
 						IsEnabled: true,
 						Type:      "JIRA",
 						Details: map[string]string{
@@ -153,7 +167,7 @@ func (t *RepoImplTestSuite) TestInsert() {
 			},
 			expectedErr: nil,
 			mockFunc: func(project entity.Project) {
-				t.db.ExpectExec(query).WithArgs(project.ID, project.Name, project.Description, project.IsSelected, `[{"is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`).WillReturnResult(sqlmock.NewResult(1, 1))
+				t.db.ExpectExec(query).WithArgs(project.ID, project.Name, project.Description, project.IsSelected, `[{"id":"integration-1","is_enabled":true,"type":"JIRA","details":{"token":"secret:project/project-1/integration-1/token"}}]`).WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
 	}
@@ -168,6 +182,7 @@ func (t *RepoImplTestSuite) TestInsert() {
 				return
 			}
 			t.Nil(err)
+			t.Equal("token", t.secretRepo.secrets["project/project-1/integration-1/token"])
 		})
 	}
 }
@@ -189,6 +204,7 @@ func (t *RepoImplTestSuite) TestUpdate() {
 				IsSelected:  true,
 				Integrations: []entity.Integration{
 					{
+						ID:        "integration-1",
 						IsEnabled: true,
 						Type:      "JIRA",
 						Details: map[string]string{
@@ -199,7 +215,7 @@ func (t *RepoImplTestSuite) TestUpdate() {
 			},
 			expectedErr: sql.ErrConnDone,
 			mockFunc: func(project entity.Project) {
-				t.db.ExpectExec(query).WithArgs(project.Name, project.Description, project.IsSelected, `[{"is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`, project.ID).WillReturnError(sql.ErrConnDone)
+				t.db.ExpectExec(query).WithArgs(project.Name, project.Description, project.IsSelected, `[{"id":"integration-1","is_enabled":true,"type":"JIRA","details":{"token":"secret:project/project-1/integration-1/token"}}]`, project.ID).WillReturnError(sql.ErrConnDone)
 			},
 		},
 		{
@@ -211,6 +227,7 @@ func (t *RepoImplTestSuite) TestUpdate() {
 				IsSelected:  true,
 				Integrations: []entity.Integration{
 					{
+						ID:        "integration-1",
 						IsEnabled: true,
 						Type:      "JIRA",
 						Details: map[string]string{
@@ -221,7 +238,7 @@ func (t *RepoImplTestSuite) TestUpdate() {
 			},
 			expectedErr: nil,
 			mockFunc: func(project entity.Project) {
-				t.db.ExpectExec(query).WithArgs(project.Name, project.Description, project.IsSelected, `[{"is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`, project.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+				t.db.ExpectExec(query).WithArgs(project.Name, project.Description, project.IsSelected, `[{"id":"integration-1","is_enabled":true,"type":"JIRA","details":{"token":"secret:project/project-1/integration-1/token"}}]`, project.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
 	}
@@ -236,10 +253,51 @@ func (t *RepoImplTestSuite) TestUpdate() {
 				return
 			}
 			t.Nil(err)
+			t.Equal("token", t.secretRepo.secrets["project/project-1/integration-1/token"])
 		})
 	}
 }
 
+func (t *RepoImplTestSuite) TestUpdateSameIntegrationTypes() {
+	query := "UPDATE projects SET name = ?, description = ?, is_selected = ?, integrations = ? WHERE id = ?"
+	project := entity.Project{
+		ID:   "project-1",
+		Name: "Project 1",
+		Integrations: []entity.Integration{
+			{Type: "JIRA", Details: map[string]string{"token": "work-token"}},
+			{Type: "JIRA", Details: map[string]string{"token": "client-token"}},
+		},
+	}
+	t.db.ExpectExec(query).WithArgs(project.Name, sqlmock.AnyArg(), project.IsSelected, sqlmock.AnyArg(), project.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := t.repoImpl.Update(context.Background(), project)
+
+	t.NoError(err)
+	t.Len(t.secretRepo.secrets, 2)
+	var tokens []string
+	for _, token := range t.secretRepo.secrets {
+		tokens = append(tokens, token)
+	}
+	t.ElementsMatch([]string{"work-token", "client-token"}, tokens)
+}
+
+func (t *RepoImplTestSuite) TestUpdateSecretFields() {
+	query := "UPDATE projects SET name = ?, description = ?, is_selected = ?, integrations = ? WHERE id = ?"
+	project := entity.Project{
+		ID:   "project-1",
+		Name: "Project 1",
+		Integrations: []entity.Integration{
+			{ID: "integration-1", Type: "acme", Details: map[string]string{"file": "issues.json", "api_key": "any-key"}, SecretFields: []string{"api_key"}},
+		},
+	}
+	t.db.ExpectExec(query).WithArgs(project.Name, sqlmock.AnyArg(), project.IsSelected, `[{"id":"integration-1","is_enabled":false,"type":"acme","details":{"api_key":"secret:project/project-1/integration-1/api_key","file":"issues.json"},"secret_fields":["api_key"]}]`, project.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := t.repoImpl.Update(context.Background(), project)
+
+	t.NoError(err)
+	t.Equal("any-key", t.secretRepo.secrets["project/project-1/integration-1/api_key"])
+}
+
 func (t *RepoImplTestSuite) TestDelete() {
 	query := "DELETE FROM projects WHERE id = ?"
 	tests := []struct {
@@ -304,7 +362,7 @@ func (t *RepoImplTestSuite) TestGetByID() {
 			expected:    entity.Project{},
 			expectedErr: errors.New("sql: Scan error on column index 1, name \"name\": converting NULL to string is unsupported"),
 			mockFunc: func(projectID string) {
-				rows := sqlmock.NewRows([]string{"id", "name", "description", "is_selected", "integrations"}).AddRow(projectID, nil, "description", true, `[{"is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`)
+				rows := sqlmock.NewRows([]string{"id", "name", "description", "is_selected", "integrations"}).AddRow(projectID, nil, "description", true, `[{"id":"integration-1","is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`)
 				t.db.ExpectQuery(query).WithArgs(projectID).WillReturnRows(rows)
 			},
 		},
@@ -344,6 +402,7 @@ func (t *RepoImplTestSuite) TestGetByID() {
 				IsSelected:  true,
 				Integrations: []entity.Integration{
 					{
+						ID:        "integration-1",
 						IsEnabled: true,
 						Type:      "JIRA",
 						Details: map[string]string{
@@ -369,7 +428,7 @@ func (t *RepoImplTestSuite) TestGetByID() {
 						},
 					},
 				}
-				rows := sqlmock.NewRows([]string{"id", "name", "description", "is_selected", "integrations"}).AddRow(project.ID, project.Name, project.Description, project.IsSelected, `[{"is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`)
+				rows := sqlmock.NewRows([]string{"id", "name", "description", "is_selected", "integrations"}).AddRow(project.ID, project.Name, project.Description, project.IsSelected, `[{"id":"integration-1","is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`)
 				t.db.ExpectQuery(query).WithArgs(projectID).WillReturnRows(rows)
 			},
 		},
@@ -410,7 +469,7 @@ func (t *RepoImplTestSuite) TestGetSelectedProject() {
 			expected:    entity.Project{},
 			expectedErr: errors.New("sql: Scan error on column index 1, name \"name\": converting NULL to string is unsupported"),
 			mockFunc: func() {
-				rows := sqlmock.NewRows([]string{"id", "name", "description", "is_selected", "integrations"}).AddRow("project-1", nil, "description", true, `[{"is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`)
+				rows := sqlmock.NewRows([]string{"id", "name", "description", "is_selected", "integrations"}).AddRow("project-1", nil, "description", true, `[{"id":"integration-1","is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`)
 				t.db.ExpectQuery(query).WithArgs(true).WillReturnRows(rows)
 			},
 		},
@@ -448,6 +507,7 @@ func (t *RepoImplTestSuite) TestGetSelectedProject() {
 				IsSelected:  true,
 				Integrations: []entity.Integration{
 					{
+						ID:        "integration-1",
 						IsEnabled: true,
 						Type:      "JIRA",
 						Details: map[string]string{
@@ -473,7 +533,7 @@ func (t *RepoImplTestSuite) TestGetSelectedProject() {
 						},
 					},
 				}
-				rows := sqlmock.NewRows([]string{"id", "name", "description", "is_selected", "integrations"}).AddRow(project.ID, project.Name, project.Description, project.IsSelected, `[{"is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`)
+				rows := sqlmock.NewRows([]string{"id", "name", "description", "is_selected", "integrations"}).AddRow(project.ID, project.Name, project.Description, project.IsSelected, `[{"id":"integration-1","is_enabled":true,"type":"JIRA","details":{"token":"token"}}]`)
 				t.db.ExpectQuery(query).WithArgs(true).WillReturnRows(rows)
 			},
 		},
@@ -508,7 +568,9 @@ func (t *RepoImplTestSuite) TestSetSelectedProject() {
 			projectID:   "project-1",
 			expectedErr: sql.ErrConnDone,
 			mockFunc: func(_ string) {
+				t.db.ExpectBegin()
 				t.db.ExpectExec(queryDeselected).WithArgs(false).WillReturnError(sql.ErrConnDone)
+				t.db.ExpectRollback()
 			},
 		},
 		{
@@ -516,8 +578,10 @@ func (t *RepoImplTestSuite) TestSetSelectedProject() {
 			projectID:   "project-1",
 			expectedErr: sql.ErrConnDone,
 			mockFunc: func(projectID string) {
+				t.db.ExpectBegin()
 				t.db.ExpectExec(queryDeselected).WithArgs(false).WillReturnResult(sqlmock.NewResult(1, 1))
 				t.db.ExpectExec(querySelected).WithArgs(true, projectID).WillReturnError(sql.ErrConnDone)
+				t.db.ExpectRollback()
 			},
 		},
 		{
@@ -525,8 +589,10 @@ func (t *RepoImplTestSuite) TestSetSelectedProject() {
 			projectID:   "project-1",
 			expectedErr: nil,
 			mockFunc: func(projectID string) {
+				t.db.ExpectBegin()
 				t.db.ExpectExec(queryDeselected).WithArgs(false).WillReturnResult(sqlmock.NewResult(1, 1))
 				t.db.ExpectExec(querySelected).WithArgs(true, projectID).WillReturnResult(sqlmock.NewResult(1, 1))
+				t.db.ExpectCommit()
 			},
 		},
 	}
@@ -535,6 +601,7 @@ func (t *RepoImplTestSuite) TestSetSelectedProject() {
 		t.Run(tt.name, func() {
 			tt.mockFunc(tt.projectID)
 			err := t.repoImpl.SetSelectedProject(context.Background(), tt.projectID)
+			t.NoError(t.db.ExpectationsWereMet())
 			if err != nil {
 				err = errors.Unwrap(err)
 				t.Equal(tt.expectedErr.Error(), err.Error())
@@ -10,9 +10,11 @@ import (
 )
 
 type IntegrationModel struct {
-	IsEnabled bool              `json:"is_enabled"`
-	Type      string            `json:"type"`
-	Details   map[string]string `json:"details"`
+	ID           string            `json:"id,omitempty"`
+	IsEnabled    bool              `json:"is_enabled"`
+	Type         string            `json:"type"`
+	Details      map[string]string `json:"details"`
+	SecretFields []string          `json:"secret_fields,omitempty"`
 }
 
 type ProjectModel struct {
@@ -41,9 +43,11 @@ func (pm ProjectModel) ToEntity() (entity.Project, error) {
 		var integrations []entity.Integration
 		for _, integration := range integrationModels {
 			integrations = append(integrations, entity.Integration{
-				IsEnabled: integration.IsEnabled,
-				Type:      entity.IntegrationType(integration.Type),
-				Details:   integration.Details,
+				ID:           integration.ID,
+				IsEnabled:    integration.IsEnabled,
+				Type:         entity.IntegrationType(integration.Type),
+				Details:      integration.Details,
+				SecretFields: integration.SecretFields,
 			})
 		}
 
@@ -57,9 +61,11 @@ func CreateModel(entity entity.Project) (ProjectModel, error) {
 	var integrationModels []IntegrationModel
 	for _, integration := range entity.Integrations {
 		integrationModels = append(integrationModels, IntegrationModel{
-			IsEnabled: integration.IsEnabled,
-			Type:      string(integration.Type),
-			Details:   integration.Details,
+			ID:           integration.ID,
+			IsEnabled:    integration.IsEnabled,
+			Type:         string(integration.Type),
+			Details:      integration.Details,
+			SecretFields: integration.SecretFields,
 		})
 	}
 
@@ -0,0 +1,132 @@
+package report
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	htmlTemplate "html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+// executor is what text and HTML templates have in common.
+type executor interface {
+	Execute(w io.Writer, data any) error
+}
+
+// RepoImpl renders reports with a Go template. The template named like the
+// default one, e.g. report.md.tmpl, in the templates directory is used
+// instead of the default when it exists.
+type RepoImpl struct {
+	name  string
+	dir   string
+	parse func(name, content string) (executor, error)
+}
+
+// NewMarkdown renders reports with text/template.
+func NewMarkdown(dir string) *RepoImpl {
+	return &RepoImpl{
+		name: "report.md.tmpl",
+		dir:  dir,
+		parse: func(name, content string) (executor, error) {
+			return template.New(name).Funcs(template.FuncMap(funcs)).Parse(content)
+		},
+	}
+}
+
+// NewHTML renders reports with html/template, which escapes what the tasks
+// contain.
+func NewHTML(dir string) *RepoImpl {
+	return &RepoImpl{
+		name: "report.html.tmpl",
+		dir:  dir,
+		parse: func(name, content string) (executor, error) {
+			return htmlTemplate.New(name).Funcs(htmlTemplate.FuncMap(funcs)).Parse(content)
+		},
+	}
+}
+
+func (ri *RepoImpl) Render(ctx context.Context, w io.Writer, report entity.Report) error {
+	return ri.execute(w, report)
+}
+
+// StandupRepoImpl renders standups with text/template, the template being
+// standup.md.tmpl.
+type StandupRepoImpl struct {
+	repoImpl *RepoImpl
+}
+
+func NewStandup(dir string) *StandupRepoImpl {
+	repoImpl := NewMarkdown(dir)
+	repoImpl.name = "standup.md.tmpl"
+	return &StandupRepoImpl{repoImpl: repoImpl}
+}
+
+func (ri *StandupRepoImpl) Render(ctx context.Context, w io.Writer, standup entity.Standup) error {
+	return ri.repoImpl.execute(w, standup)
+}
+
+func (ri *RepoImpl) execute(w io.Writer, data any) error {
+	content, err := ri.template()
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := ri.parse(ri.name, content)
+	if err != nil {
+		return fmt.Errorf("failed to parse template %s: %w", ri.name, err)
+	}
+
+	if err = tmpl.Execute(w, data); err != nil {
+		return fmt.Errorf("failed to execute template %s: %w", ri.name, err)
+	}
+
+	return nil
+}
+
+func (ri *RepoImpl) template() (string, error) {
+	content, err := os.ReadFile(filepath.Join(ri.dir, ri.name))
+	if errors.Is(err, os.ErrNotExist) {
+		content, err = defaultTemplates.ReadFile("templates/" + ri.name)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to read template %s: %w", ri.name, err)
+	}
+
+	return string(content), nil
+}
+
+// funcs are available to every template.
+var funcs = map[string]any{
+	"duration": formatDuration,
+	"date": func(t time.Time) string {
+		return t.Format("2006-01-02")
+	},
+	"weekday": func(t time.Time) string {
+		return t.Format("Mon 2006-01-02")
+	},
+	"indent": func(depth int) string {
+		return strings.Repeat("  ", depth)
+	},
+}
+
+// formatDuration rounds to the minute, e.g. 1h05m.
+func formatDuration(duration time.Duration) string {
+	minutes := int(duration.Round(time.Minute).Minutes())
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+
+	return fmt.Sprintf("%dh%02dm", minutes/60, minutes%60)
+}
@@ -0,0 +1,140 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func testReport() entity.Report {
+	monday := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	review := entity.ReportTask{
+		Task:      entity.Task{ID: "task-1", Name: "Review <script>", Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA}},
+		IssueURL:  "https://any.atlassian.net/browse/TODO-1",
+		TimeSpent: 90 * time.Minute,
+	}
+	answer := entity.ReportTask{
+		Task:      entity.Task{ID: "task-2", Name: "Answer comments", CompletedAt: monday.Add(34 * time.Hour)},
+		TimeSpent: 4 * time.Hour,
+		Depth:     1,
+	}
+	review.SubTasks = []entity.ReportTask{answer}
+
+	return entity.Report{
+		Project: entity.Project{Name: "Work"},
+		Tasks:   []entity.ReportTask{review},
+		Week: entity.WeekSummary{
+			Start:     monday,
+			End:       monday.AddDate(0, 0, 7),
+			TimeSpent: 5*time.Hour + 30*time.Minute,
+			Days:      []entity.DaySummary{{Date: monday, TimeSpent: 90 * time.Minute}, {Date: monday.AddDate(0, 0, 1), TimeSpent: 4 * time.Hour}},
+			Tasks:     []entity.ReportTask{{Task: answer.Task, TimeSpent: 4 * time.Hour}, {Task: review.Task, IssueURL: review.IssueURL, TimeSpent: 90 * time.Minute}},
+			Completed: []entity.ReportTask{answer},
+		},
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := NewMarkdown(t.TempDir()).Render(context.Background(), &buf, testReport())
+
+	assert.NoError(t, err)
+	assert.Equal(t, `# Work
+
+## Tasks
+
+- [ ] [TODO-1](https://any.atlassian.net/browse/TODO-1) Review <script> (1h30m)
+  - [x] Answer comments (4h00m)
+
+## Week of 2024-01-08
+
+Time spent: 5h30m
+
+| Day | Time spent |
+| --- | --- |
+| Mon 2024-01-08 | 1h30m |
+| Tue 2024-01-09 | 4h00m |
+
+### Worked on
+
+- Answer comments (4h00m)
+- [TODO-1](https://any.atlassian.net/browse/TODO-1) Review <script> (1h30m)
+
+### Completed
+
+- Answer comments
+`, buf.String())
+}
+
+func TestRenderHTML(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := NewHTML(t.TempDir()).Render(context.Background(), &buf, testReport())
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), `<li><input type="checkbox" disabled> <a href="https://any.atlassian.net/browse/TODO-1">TODO-1</a> Review &lt;script&gt; (1h30m)
+<ul>
+<li><input type="checkbox" disabled checked> Answer comments (4h00m)</li>
+</ul></li>`)
+	assert.Contains(t, buf.String(), `<tr><td>Tue 2024-01-09</td><td>4h00m</td></tr>`)
+}
+
+func TestRenderOverridden(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "report.md.tmpl"), []byte(`{{.Project.Name}}: {{duration .Week.TimeSpent}}`), 0644))
+	var buf bytes.Buffer
+
+	err := NewMarkdown(dir).Render(context.Background(), &buf, testReport())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Work: 5h30m", buf.String())
+}
+
+func TestRenderInvalidTemplate(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "report.html.tmpl"), []byte(`{{.Project.Name`), 0644))
+
+	err := NewHTML(dir).Render(context.Background(), &bytes.Buffer{}, testReport())
+
+	assert.ErrorContains(t, err, "failed to parse template report.html.tmpl")
+}
+
+func TestRenderStandup(t *testing.T) {
+	friday := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+	review := entity.StandupTask{
+		Task:      entity.Task{Name: "Review", Integration: entity.TaskIntegration{ID: "TODO-1", Type: entity.IntegrationTypeJIRA}},
+		IssueURL:  "https://any.atlassian.net/browse/TODO-1",
+		TimeSpent: 2 * time.Hour,
+	}
+	standup := entity.Standup{
+		Date:  friday.AddDate(0, 0, 3),
+		Since: friday,
+		Yesterday: []entity.StandupTask{
+			review,
+			{Task: entity.Task{Name: "Answer comments", CompletedAt: friday.Add(time.Hour)}},
+		},
+		Today: []entity.StandupTask{review},
+	}
+	var buf bytes.Buffer
+
+	err := NewStandup(t.TempDir()).Render(context.Background(), &buf, standup)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `**Yesterday**
+- [TODO-1](https://any.atlassian.net/browse/TODO-1) Review (2h00m)
+- Answer comments, done
+
+**Today**
+- [TODO-1](https://any.atlassian.net/browse/TODO-1) Review
+
+**Blockers**
+- None
+`, buf.String())
+}
@@ -0,0 +1,98 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+type Getter interface {
+	Get(ctx context.Context, key string) (string, error)
+}
+
+type Setter interface {
+	Set(ctx context.Context, key, value string) error
+}
+
+// Repository is implemented by every secret store backend.
+type Repository interface {
+	Getter
+	Setter
+	Delete(ctx context.Context, key string) error
+}
+
+// StoreDetails moves the credentials found in details under secretKeys into
+// the secret store under keyPrefix and returns a copy of details holding only
+// references.
+func StoreDetails(ctx context.Context, store Setter, keyPrefix string, details map[string]string, secretKeys []string) (map[string]string, error) {
+	if details == nil {
+		return nil, nil
+	}
+
+	stored := make(map[string]string, len(details))
+	for key, value := range details {
+		stored[key] = value
+	}
+
+	for _, key := range secretKeys {
+		value := details[key]
+		if value == "" || entity.IsSecretReference(value) {
+			continue
+		}
+
+		secretKey := keyPrefix + "/" + key
+		if err := store.Set(ctx, secretKey, value); err != nil {
+			return nil, fmt.Errorf("failed to store %s: %w", key, err)
+		}
+
+		stored[key] = entity.SecretReferencePrefix + secretKey
+	}
+
+	return stored, nil
+}
+
+// ResolveDetails returns a copy of details with secret references replaced by
+// their values from the secret store.
+func ResolveDetails(ctx context.Context, store Getter, details map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string, len(details))
+	for key, value := range details {
+		if !entity.IsSecretReference(value) {
+			resolved[key] = value
+			continue
+		}
+
+		secretValue, err := store.Get(ctx, strings.TrimPrefix(value, entity.SecretReferencePrefix))
+		if errors.Is(err, ErrSecretNotFound) {
+			// The secret store is not synced, so references from another
+			// machine do not resolve until the integration is set up again
+			return nil, fmt.Errorf("failed to resolve %s: %w, set up the integration again on this machine", key, err)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve %s: %w", key, err)
+		}
+
+		resolved[key] = secretValue
+	}
+
+	return resolved, nil
+}
+
+// StoreDetail updates a single detail, writing through to the secret store when
+// the detail is a reference.
+func StoreDetail(ctx context.Context, store Setter, details map[string]string, key, value string) error {
+	reference := details[key]
+	if !entity.IsSecretReference(reference) {
+		details[key] = value
+		return nil
+	}
+
+	if err := store.Set(ctx, strings.TrimPrefix(reference, entity.SecretReferencePrefix), value); err != nil {
+		return fmt.Errorf("failed to store %s: %w", key, err)
+	}
+
+	return nil
+}
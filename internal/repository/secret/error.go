@@ -0,0 +1,9 @@
+package secret
+
+import "errors"
+
+var (
+	ErrSecretNotFound    = errors.New("secret not found")
+	ErrInvalidPassphrase = errors.New("invalid keystore passphrase")
+	ErrEmptyPassphrase   = errors.New("keystore passphrase must not be empty")
+)
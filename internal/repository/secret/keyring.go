@@ -0,0 +1,49 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zalando/go-keyring"
+)
+
+const KEYRING_SERVICE = "todo-cli"
+
+// KeyringImpl keeps secrets in the system keyring (macOS Keychain, Secret
+// Service on Linux, Windows Credential Manager).
+type KeyringImpl struct{}
+
+func NewKeyring() *KeyringImpl {
+	return &KeyringImpl{}
+}
+
+func (k *KeyringImpl) Get(ctx context.Context, key string) (string, error) {
+	value, err := keyring.Get(KEYRING_SERVICE, key)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return "", ErrSecretNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get secret from keyring: %w", err)
+	}
+
+	return value, nil
+}
+
+func (k *KeyringImpl) Set(ctx context.Context, key, value string) error {
+	if err := keyring.Set(KEYRING_SERVICE, key, value); err != nil {
+		return fmt.Errorf("failed to set secret in keyring: %w", err)
+	}
+
+	return nil
+}
+
+func (k *KeyringImpl) Delete(ctx context.Context, key string) error {
+	err := keyring.Delete(KEYRING_SERVICE, key)
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("failed to delete secret from keyring: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package secret
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+type KeystoreFileModel struct {
+	Version    int    `json:"version"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// KeystoreImpl keeps secrets in a local file encrypted with AES-GCM, using a
+// key derived from a passphrase with scrypt.
+type KeystoreImpl struct {
+	path       string
+	passphrase func() (string, error)
+
+	mu      sync.Mutex
+	loaded  bool
+	key     []byte
+	salt    []byte
+	secrets map[string]string
+}
+
+func NewKeystore(path string, passphrase func() (string, error)) *KeystoreImpl {
+	return &KeystoreImpl{
+		path:       path,
+		passphrase: passphrase,
+	}
+}
+
+func (k *KeystoreImpl) Get(ctx context.Context, key string) (string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if err := k.load(); err != nil {
+		return "", err
+	}
+
+	value, ok := k.secrets[key]
+	if !ok {
+		return "", ErrSecretNotFound
+	}
+
+	return value, nil
+}
+
+func (k *KeystoreImpl) Set(ctx context.Context, key, value string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if err := k.load(); err != nil {
+		return err
+	}
+
+	k.secrets[key] = value
+	return k.save()
+}
+
+func (k *KeystoreImpl) Delete(ctx context.Context, key string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if err := k.load(); err != nil {
+		return err
+	}
+
+	if _, ok := k.secrets[key]; !ok {
+		return nil
+	}
+
+	delete(k.secrets, key)
+	return k.save()
+}
+
+func (k *KeystoreImpl) load() error {
+	if k.loaded {
+		return nil
+	}
+
+	passphrase, err := k.passphrase()
+	if err != nil {
+		return fmt.Errorf("failed to get passphrase: %w", err)
+	}
+
+	content, err := os.ReadFile(k.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read keystore: %w", err)
+	}
+
+	if errors.Is(err, os.ErrNotExist) {
+		k.salt = make([]byte, 16)
+		if _, err = io.ReadFull(rand.Reader, k.salt); err != nil {
+			return fmt.Errorf("failed to generate salt: %w", err)
+		}
+
+		if k.key, err = deriveKey(passphrase, k.salt); err != nil {
+			return err
+		}
+
+		k.secrets = map[string]string{}
+		k.loaded = true
+		return nil
+	}
+
+	var model KeystoreFileModel
+	if err = json.Unmarshal(content, &model); err != nil {
+		return fmt.Errorf("failed to unmarshal keystore: %w", err)
+	}
+
+	key, err := deriveKey(passphrase, model.Salt)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := gcm.Open(nil, model.Nonce, model.Ciphertext, nil)
+	if err != nil {
+		return ErrInvalidPassphrase
+	}
+
+	secrets := map[string]string{}
+	if err = json.Unmarshal(plaintext, &secrets); err != nil {
+		return fmt.Errorf("failed to unmarshal secrets: %w", err)
+	}
+
+	k.key = key
+	k.salt = model.Salt
+	k.secrets = secrets
+	k.loaded = true
+	return nil
+}
+
+func (k *KeystoreImpl) save() error {
+	plaintext, err := json.Marshal(k.secrets)
+	if err != nil {
+		return fmt.Errorf("failed to marshal secrets: %w", err)
+	}
+
+	gcm, err := newGCM(k.key)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	content, err := json.Marshal(KeystoreFileModel{
+		Version:    1,
+		Salt:       k.salt,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plaintext, nil),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal keystore: %w", err)
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(k.path), ".todo-cli-secrets-*")
+	if err != nil {
+		return fmt.Errorf("failed to create keystore: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err = tmpFile.Write(content); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to write keystore: %w", err)
+	}
+
+	if err = tmpFile.Close(); err != nil {
+		return fmt.Errorf("failed to write keystore: %w", err)
+	}
+
+	if err = os.Rename(tmpFile.Name(), k.path); err != nil {
+		return fmt.Errorf("failed to write keystore: %w", err)
+	}
+
+	return nil
+}
+
+func deriveKey(passphrase string, salt []byte) ([]byte, error) {
+	if strings.TrimSpace(passphrase) == "" {
+		return nil, ErrEmptyPassphrase
+	}
+
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	return gcm, nil
+}
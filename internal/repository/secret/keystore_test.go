@@ -0,0 +1,93 @@
+package secret
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type KeystoreImplTestSuite struct {
+	suite.Suite
+	path string
+}
+
+func (s *KeystoreImplTestSuite) SetupTest() {
+	s.path = filepath.Join(s.T().TempDir(), ".todo-cli-secrets")
+}
+
+func TestKeystoreImpl(t *testing.T) {
+	suite.Run(t, new(KeystoreImplTestSuite))
+}
+
+func passphrase(value string) func() (string, error) {
+	return func() (string, error) {
+		return value, nil
+	}
+}
+
+func (s *KeystoreImplTestSuite) TestSetAndGet() {
+	keystore := NewKeystore(s.path, passphrase("any-passphrase"))
+	s.NoError(keystore.Set(context.Background(), "project/project-1/JIRA/token", "any-token"))
+
+	value, err := NewKeystore(s.path, passphrase("any-passphrase")).Get(context.Background(), "project/project-1/JIRA/token")
+	s.NoError(err)
+	s.Equal("any-token", value)
+
+	content, err := os.ReadFile(s.path)
+	s.NoError(err)
+	s.False(strings.Contains(string(content), "any-token"))
+
+	info, err := os.Stat(s.path)
+	s.NoError(err)
+	s.Equal(os.FileMode(0600), info.Mode().Perm())
+}
+
+func (s *KeystoreImplTestSuite) TestGet() {
+	s.NoError(NewKeystore(s.path, passphrase("any-passphrase")).Set(context.Background(), "any-key", "any-value"))
+
+	tests := []struct {
+		name        string
+		passphrase  string
+		key         string
+		expectedErr error
+	}{
+		{
+			name:        "invalid passphrase",
+			passphrase:  "other-passphrase",
+			key:         "any-key",
+			expectedErr: ErrInvalidPassphrase,
+		},
+		{
+			name:        "empty passphrase",
+			passphrase:  " ",
+			key:         "any-key",
+			expectedErr: ErrEmptyPassphrase,
+		},
+		{
+			name:        "secret not found",
+			passphrase:  "any-passphrase",
+			key:         "other-key",
+			expectedErr: ErrSecretNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			_, err := NewKeystore(s.path, passphrase(test.passphrase)).Get(context.Background(), test.key)
+			s.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func (s *KeystoreImplTestSuite) TestDelete() {
+	keystore := NewKeystore(s.path, passphrase("any-passphrase"))
+	s.NoError(keystore.Set(context.Background(), "any-key", "any-value"))
+	s.NoError(keystore.Delete(context.Background(), "any-key"))
+
+	_, err := NewKeystore(s.path, passphrase("any-passphrase")).Get(context.Background(), "any-key")
+	s.ErrorIs(err, ErrSecretNotFound)
+}
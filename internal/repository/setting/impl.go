@@ -5,28 +5,48 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 	"github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
+	"github.com/azisuazusa/todo-cli/internal/repository/secret"
+	"github.com/azisuazusa/todo-cli/internal/repository/transaction"
 	_ "github.com/mattn/go-sqlite3"
 )
 
+type SecretRepo interface {
+	Set(ctx context.Context, key, value string) error
+}
+
 type RepoImpl struct {
-	db *sql.DB
+	db         *sql.DB
+	secretRepo SecretRepo
 }
 
-func New(db *sql.DB) *RepoImpl {
+func New(db *sql.DB, secretRepo SecretRepo) *RepoImpl {
 	return &RepoImpl{
-		db: db,
+		db:         db,
+		secretRepo: secretRepo,
 	}
 }
 
+// conn joins the transaction ctx runs in, if any.
+func (r *RepoImpl) conn(ctx context.Context) transaction.Conn {
+	return transaction.From(ctx, r.db)
+}
+
 func (r *RepoImpl) SetSyncIntegration(ctx context.Context, integration syncintegration.SyncIntegration) error {
+	details, err := secret.StoreDetails(ctx, r.secretRepo, fmt.Sprintf("sync/%s", integration.Type), integration.Details, entity.SecretDetailKeys)
+	if err != nil {
+		return fmt.Errorf("failed to store secrets: %w", err)
+	}
+
+	integration.Details = details
 	model, err := CreateModelFromSyncIntegration(integration)
 	if err != nil {
 		return fmt.Errorf("failed to create model from integration: %w", err)
 	}
 
 	upsertQuery := "INSERT INTO settings (key, value) VALUES (?, ?) ON CONFLICT(key) DO UPDATE SET value = ?"
-	_, err = r.db.ExecContext(ctx, upsertQuery, model.Key, model.Value, model.Value)
+	_, err = r.conn(ctx).ExecContext(ctx, upsertQuery, model.Key, model.Value, model.Value)
 	if err != nil {
 		return fmt.Errorf("failed to insert setting: %w", err)
 	}
@@ -36,7 +56,7 @@ func (r *RepoImpl) SetSyncIntegration(ctx context.Context, integration syncinteg
 
 func (r *RepoImpl) GetSyncIntegration(ctx context.Context) (syncintegration.SyncIntegration, error) {
 	var model SettingModel
-	err := r.db.QueryRowContext(ctx, "SELECT key, value FROM settings WHERE key = ?", KeySyncIntegration).Scan(&model.Key, &model.Value)
+	err := r.conn(ctx).QueryRowContext(ctx, "SELECT key, value FROM settings WHERE key = ?", KeySyncIntegration).Scan(&model.Key, &model.Value)
 	if err != nil && err != sql.ErrNoRows {
 		return syncintegration.SyncIntegration{}, fmt.Errorf("failed to get setting: %w", err)
 	}
@@ -47,3 +67,38 @@ func (r *RepoImpl) GetSyncIntegration(ctx context.Context) (syncintegration.Sync
 
 	return model.ToSyncIntegration()
 }
+
+func (r *RepoImpl) SetTrackingSetting(ctx context.Context, setting entity.TrackingSetting) error {
+	model, err := CreateModelFromTrackingSetting(setting)
+	if err != nil {
+		return fmt.Errorf("failed to create model from tracking setting: %w", err)
+	}
+
+	upsertQuery := "INSERT INTO settings (key, value) VALUES (?, ?) ON CONFLICT(key) DO UPDATE SET value = ?"
+	_, err = r.conn(ctx).ExecContext(ctx, upsertQuery, model.Key, model.Value, model.Value)
+	if err != nil {
+		return fmt.Errorf("failed to insert setting: %w", err)
+	}
+
+	return nil
+}
+
+// GetTrackingSetting returns the default setting until one is set.
+func (r *RepoImpl) GetTrackingSetting(ctx context.Context) (entity.TrackingSetting, error) {
+	var model SettingModel
+	err := r.conn(ctx).QueryRowContext(ctx, "SELECT key, value FROM settings WHERE key = ?", KeyTracking).Scan(&model.Key, &model.Value)
+	if err != nil && err != sql.ErrNoRows {
+		return entity.TrackingSetting{}, fmt.Errorf("failed to get setting: %w", err)
+	}
+
+	if err == sql.ErrNoRows {
+		return entity.DefaultTrackingSetting(), nil
+	}
+
+	setting, err := model.ToTrackingSetting()
+	if err != nil {
+		return entity.TrackingSetting{}, fmt.Errorf("failed to parse tracking setting: %w", err)
+	}
+
+	return setting, nil
+}
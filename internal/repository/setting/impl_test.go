@@ -2,25 +2,39 @@ package setting
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 	"github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
 	"github.com/stretchr/testify/suite"
 )
 
+type secretRepoStub struct {
+	secrets map[string]string
+}
+
+func (r *secretRepoStub) Set(ctx context.Context, key, value string) error {
+	r.secrets[key] = value
+	return nil
+}
+
 type RepoImplTestSuite struct {
 	suite.Suite
-	db       sqlmock.Sqlmock
-	repoImpl RepoImpl
+	db         sqlmock.Sqlmock
+	secretRepo *secretRepoStub
+	repoImpl   RepoImpl
 }
 
 func (s *RepoImplTestSuite) SetupTest() {
 	db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	s.db = mock
+	s.secretRepo = &secretRepoStub{secrets: map[string]string{}}
 
-	s.repoImpl = RepoImpl{db: db}
+	s.repoImpl = RepoImpl{db: db, secretRepo: s.secretRepo}
 }
 
 func TestRepoImpl(t *testing.T) {
@@ -44,6 +58,9 @@ func (s *RepoImplTestSuite) TestSetSyncIntegration() {
 			},
 			expectedErr: errors.New("any-error"),
 			mock: func(paramIntegration syncintegration.SyncIntegration) {
+				paramIntegration.Details = map[string]string{
+					"token": "secret:sync/dropbox/token",
+				}
 				model, _ := CreateModelFromSyncIntegration(paramIntegration)
 				query := "INSERT INTO settings (key, value) VALUES (?, ?) ON CONFLICT(key) DO UPDATE SET value = ?"
 				s.db.ExpectExec(query).
@@ -61,6 +78,9 @@ func (s *RepoImplTestSuite) TestSetSyncIntegration() {
 			},
 			expectedErr: nil,
 			mock: func(paramIntegration syncintegration.SyncIntegration) {
+				paramIntegration.Details = map[string]string{
+					"token": "secret:sync/dropbox/token",
+				}
 				model, _ := CreateModelFromSyncIntegration(paramIntegration)
 				query := "INSERT INTO settings (key, value) VALUES (?, ?) ON CONFLICT(key) DO UPDATE SET value = ?"
 				s.db.ExpectExec(query).
@@ -80,6 +100,7 @@ func (s *RepoImplTestSuite) TestSetSyncIntegration() {
 			}
 
 			s.Equal(tt.expectedErr, err)
+			s.Equal("token-test", s.secretRepo.secrets["sync/dropbox/token"])
 		})
 	}
 }
@@ -134,3 +155,64 @@ func (s *RepoImplTestSuite) TestGetSyncIntegration() {
 		})
 	}
 }
+
+func (s *RepoImplTestSuite) TestGetTrackingSetting() {
+	query := "SELECT key, value FROM settings WHERE key = ?"
+	tests := []struct {
+		name        string
+		expected    entity.TrackingSetting
+		expectedErr error
+		mock        func()
+	}{
+		{
+			name:        "failed to get tracking setting",
+			expectedErr: errors.New("any-error"),
+			mock: func() {
+				s.db.ExpectQuery(query).WithArgs("tracking").WillReturnError(errors.New("any-error"))
+			},
+		},
+		{
+			name:     "not set yet",
+			expected: entity.DefaultTrackingSetting(),
+			mock: func() {
+				s.db.ExpectQuery(query).WithArgs("tracking").WillReturnError(sql.ErrNoRows)
+			},
+		},
+		{
+			name:     "success to get tracking setting",
+			expected: entity.TrackingSetting{MaxSession: 10 * time.Hour, IdleAfter: 15 * time.Minute},
+			mock: func() {
+				s.db.ExpectQuery(query).
+					WithArgs("tracking").
+					WillReturnRows(sqlmock.NewRows([]string{"key", "value"}).AddRow("tracking", `{"max_session":"10h"}`))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mock()
+			actual, err := s.repoImpl.GetTrackingSetting(context.Background())
+
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			s.Equal(tt.expectedErr, err)
+			s.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func (s *RepoImplTestSuite) TestSetTrackingSetting() {
+	setting := entity.TrackingSetting{MaxSession: 10 * time.Hour}
+	model, _ := CreateModelFromTrackingSetting(setting)
+	query := "INSERT INTO settings (key, value) VALUES (?, ?) ON CONFLICT(key) DO UPDATE SET value = ?"
+	s.db.ExpectExec(query).
+		WithArgs(model.Key, model.Value, model.Value).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := s.repoImpl.SetTrackingSetting(context.Background(), setting)
+
+	s.NoError(err)
+}
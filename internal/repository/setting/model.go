@@ -2,17 +2,32 @@ package setting
 
 import (
 	"encoding/json"
+	"time"
 
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 	"github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
 )
 
-const KeySyncIntegration = "sync_integration"
+const (
+	KeySyncIntegration = "sync_integration"
+	KeyTracking        = "tracking"
+)
 
 type IntegrationModel struct {
 	Type    string            `json:"type"`
 	Details map[string]string `json:"details"`
 }
 
+// TrackingModel keeps durations as text so the setting stays readable in
+// the database.
+type TrackingModel struct {
+	MaxSession string `json:"max_session"`
+	AutoTrim   bool   `json:"auto_trim"`
+	IdleSource string `json:"idle_source"`
+	IdleFile   string `json:"idle_file"`
+	IdleAfter  string `json:"idle_after"`
+}
+
 type SettingModel struct {
 	Key   string
 	Value string
@@ -47,3 +62,49 @@ func (sm SettingModel) ToSyncIntegration() (syncintegration.SyncIntegration, err
 		Details: integrationModel.Details,
 	}, nil
 }
+
+func CreateModelFromTrackingSetting(setting entity.TrackingSetting) (SettingModel, error) {
+	trackingModel := TrackingModel{
+		MaxSession: setting.MaxSession.String(),
+		AutoTrim:   setting.AutoTrim,
+		IdleSource: string(setting.IdleSource),
+		IdleFile:   setting.IdleFile,
+		IdleAfter:  setting.IdleAfter.String(),
+	}
+
+	valueBytes, err := json.Marshal(trackingModel)
+	if err != nil {
+		return SettingModel{}, err
+	}
+
+	return SettingModel{
+		Key:   KeyTracking,
+		Value: string(valueBytes),
+	}, nil
+}
+
+func (sm SettingModel) ToTrackingSetting() (entity.TrackingSetting, error) {
+	var trackingModel TrackingModel
+	err := json.Unmarshal([]byte(sm.Value), &trackingModel)
+	if err != nil {
+		return entity.TrackingSetting{}, err
+	}
+
+	setting := entity.DefaultTrackingSetting()
+	setting.AutoTrim = trackingModel.AutoTrim
+	setting.IdleSource = entity.IdleSourceType(trackingModel.IdleSource)
+	setting.IdleFile = trackingModel.IdleFile
+	if trackingModel.MaxSession != "" {
+		if setting.MaxSession, err = time.ParseDuration(trackingModel.MaxSession); err != nil {
+			return entity.TrackingSetting{}, err
+		}
+	}
+
+	if trackingModel.IdleAfter != "" {
+		if setting.IdleAfter, err = time.ParseDuration(trackingModel.IdleAfter); err != nil {
+			return entity.TrackingSetting{}, err
+		}
+	}
+
+	return setting, nil
+}
@@ -2,7 +2,9 @@ package setting
 
 import (
 	"testing"
+	"time"
 
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 	"github.com/azisuazusa/todo-cli/internal/domain/syncintegration"
 	"github.com/stretchr/testify/assert"
 )
@@ -45,3 +47,36 @@ func TestSettingModelToSyncIntegration(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+func TestTrackingSettingModel(t *testing.T) {
+	setting := entity.TrackingSetting{
+		MaxSession: 10 * time.Hour,
+		AutoTrim:   true,
+		IdleSource: entity.IdleSourceFile,
+		IdleFile:   "/home/user/.todo-activity",
+		IdleAfter:  time.Hour,
+	}
+
+	model, err := CreateModelFromTrackingSetting(setting)
+
+	assert.NoError(t, err)
+	assert.Equal(t, SettingModel{
+		Key:   "tracking",
+		Value: `{"max_session":"10h0m0s","auto_trim":true,"idle_source":"file","idle_file":"/home/user/.todo-activity","idle_after":"1h0m0s"}`,
+	}, model)
+
+	actual, err := model.ToTrackingSetting()
+
+	assert.NoError(t, err)
+	assert.Equal(t, setting, actual)
+}
+
+func TestSettingModelToTrackingSettingDefaults(t *testing.T) {
+	actual, err := SettingModel{Key: "tracking", Value: `{"idle_source":"x11"}`}.ToTrackingSetting()
+
+	expected := entity.DefaultTrackingSetting()
+	expected.IdleSource = entity.IdleSourceX11
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,119 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	slackDomain "github.com/azisuazusa/todo-cli/internal/domain/slack"
+	"github.com/azisuazusa/todo-cli/internal/repository/secret"
+)
+
+const SLACK_API_URL = "https://slack.com/api"
+
+type SecretRepo interface {
+	Get(ctx context.Context, key string) (string, error)
+}
+
+type RepoImpl struct {
+	secretRepo SecretRepo
+	apiURL     string
+	httpClient *http.Client
+}
+
+func New(secretRepo SecretRepo) *RepoImpl {
+	return &RepoImpl{
+		secretRepo: secretRepo,
+		apiURL:     SLACK_API_URL,
+		httpClient: &http.Client{},
+	}
+}
+
+func (ri *RepoImpl) Metadata() entity.IntegrationMetadata {
+	return entity.IntegrationMetadata{
+		Type:        entity.IntegrationTypeSlack,
+		Description: "Show the started task as your Slack status",
+	}
+}
+
+func (ri *RepoImpl) Schema() []entity.IntegrationField {
+	return []entity.IntegrationField{
+		{
+			Key:   "token",
+			Label: "Slack User OAuth Token",
+			Help:  "Create a Slack app with the users.profile:write user scope and install it to your workspace.",
+			Type:  entity.IntegrationFieldSecret,
+		},
+		{
+			Key:     "text",
+			Label:   "Slack Status Text",
+			Type:    entity.IntegrationFieldSelect,
+			Options: []string{slackDomain.StatusTextName, slackDomain.StatusTextKey},
+		},
+		{Key: "emoji", Label: "Slack Status Emoji", Default: slackDomain.DefaultStatusEmoji},
+		{
+			Key:   "expiration",
+			Label: "Slack Status Expiration (e.g. 25m, empty to keep it until the task stops)",
+			Type:  entity.IntegrationFieldDuration,
+		},
+	}
+}
+
+// GetTasks returns no tasks, Slack only shows the started task.
+func (ri *RepoImpl) GetTasks(ctx context.Context, projectID string, details map[string]string) (entity.Tasks, error) {
+	return nil, nil
+}
+
+// SetStatus updates the status of the user owning the token, an empty status
+// clears it. The token needs the users.profile:write scope.
+func (ri *RepoImpl) SetStatus(ctx context.Context, status slackDomain.Status, integrationEntity entity.Integration) error {
+	details, err := secret.ResolveDetails(ctx, ri.secretRepo, integrationEntity.Details)
+	if err != nil {
+		return fmt.Errorf("failed to resolve slack credentials: %w", err)
+	}
+
+	profile := ProfileModel{
+		StatusText:  status.Text,
+		StatusEmoji: status.Emoji,
+	}
+
+	if !status.Expiration.IsZero() {
+		profile.StatusExpiration = status.Expiration.Unix()
+	}
+
+	body, err := json.Marshal(ProfileRequestModel{Profile: profile})
+	if err != nil {
+		return fmt.Errorf("failed to marshal profile: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ri.apiURL+"/users.profile.set", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+details["token"])
+	resp, err := ri.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to set status: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to set status: unexpected status code %d", resp.StatusCode)
+	}
+
+	var response ResponseModel
+	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if !response.OK {
+		return fmt.Errorf("failed to set status: %s", response.Error)
+	}
+
+	return nil
+}
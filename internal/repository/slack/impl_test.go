@@ -0,0 +1,127 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	slackDomain "github.com/azisuazusa/todo-cli/internal/domain/slack"
+	"github.com/stretchr/testify/suite"
+)
+
+type secretRepoStub struct {
+	secrets map[string]string
+}
+
+func (r *secretRepoStub) Get(ctx context.Context, key string) (string, error) {
+	value, ok := r.secrets[key]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+
+	return value, nil
+}
+
+type RepoImplTestSuite struct {
+	suite.Suite
+	server        *httptest.Server
+	authorization string
+	request       ProfileRequestModel
+	repoImpl      *RepoImpl
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	s.request = ProfileRequestModel{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users.profile.set", func(w http.ResponseWriter, r *http.Request) {
+		s.authorization = r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&s.request)
+
+		if s.authorization != "Bearer xoxp-token" {
+			json.NewEncoder(w).Encode(ResponseModel{OK: false, Error: "invalid_auth"})
+			return
+		}
+
+		json.NewEncoder(w).Encode(ResponseModel{OK: true})
+	})
+	s.server = httptest.NewServer(mux)
+	s.repoImpl = &RepoImpl{
+		secretRepo: &secretRepoStub{secrets: map[string]string{
+			"project/project-1/Slack/token": "xoxp-token",
+		}},
+		apiURL:     s.server.URL,
+		httpClient: s.server.Client(),
+	}
+}
+
+func (s *RepoImplTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+func (s *RepoImplTestSuite) TestSetStatus() {
+	expiration := time.Date(2024, 3, 11, 10, 25, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		status          slackDomain.Status
+		token           string
+		expectedRequest ProfileRequestModel
+		expectedErr     bool
+	}{
+		{
+			name:   "set status",
+			status: slackDomain.Status{Text: "TODO-1", Emoji: ":tomato:", Expiration: expiration},
+			token:  "secret:project/project-1/Slack/token",
+			expectedRequest: ProfileRequestModel{Profile: ProfileModel{
+				StatusText:       "TODO-1",
+				StatusEmoji:      ":tomato:",
+				StatusExpiration: expiration.Unix(),
+			}},
+		},
+		{
+			name:            "clear status",
+			status:          slackDomain.Status{},
+			token:           "xoxp-token",
+			expectedRequest: ProfileRequestModel{Profile: ProfileModel{}},
+		},
+		{
+			name:        "invalid token",
+			status:      slackDomain.Status{Text: "TODO-1"},
+			token:       "other-token",
+			expectedErr: true,
+		},
+		{
+			name:        "missing secret",
+			status:      slackDomain.Status{Text: "TODO-1"},
+			token:       "secret:project/project-2/Slack/token",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			err := s.repoImpl.SetStatus(context.Background(), test.status, entity.Integration{
+				IsEnabled: true,
+				Type:      entity.IntegrationTypeSlack,
+				Details:   map[string]string{"token": test.token},
+			})
+			if test.expectedErr {
+				s.Error(err)
+				return
+			}
+
+			s.NoError(err)
+			s.Equal("Bearer xoxp-token", s.authorization)
+			s.Equal(test.expectedRequest, s.request)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package slack
+
+type ProfileModel struct {
+	StatusText       string `json:"status_text"`
+	StatusEmoji      string `json:"status_emoji"`
+	StatusExpiration int64  `json:"status_expiration"`
+}
+
+type ProfileRequestModel struct {
+	Profile ProfileModel `json:"profile"`
+}
+
+type ResponseModel struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
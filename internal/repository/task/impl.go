@@ -7,9 +7,24 @@ import (
 
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
 	"github.com/azisuazusa/todo-cli/internal/domain/task"
+	"github.com/azisuazusa/todo-cli/internal/repository/transaction"
 	_ "github.com/mattn/go-sqlite3"
 )
 
+// CREATE_STARTED_INDEX_QUERY enforces that at most one task is started.
+const CREATE_STARTED_INDEX_QUERY = `CREATE UNIQUE INDEX IF NOT EXISTS tasks_single_started ON tasks (is_started) WHERE is_started = true`
+
+// MIGRATIONS add the columns which are missing from older databases, in the
+// order they were introduced.
+var MIGRATIONS = []struct {
+	Column string
+	Query  string
+}{
+	{Column: "due_at", Query: `ALTER TABLE tasks ADD COLUMN due_at DATETIME`},
+	{Column: "priority", Query: `ALTER TABLE tasks ADD COLUMN priority VARCHAR`},
+	{Column: "tags", Query: `ALTER TABLE tasks ADD COLUMN tags TEXT`},
+}
+
 type RepoImpl struct {
 	db *sql.DB
 }
@@ -18,10 +33,72 @@ func New(db *sql.DB) *RepoImpl {
 	return &RepoImpl{db: db}
 }
 
+// Migrate adds the columns and the index introduced since the tasks table was
+// set up, also to databases synced from devices running an older version.
+// Nothing is done before `todo setup` created the table.
+func (ri *RepoImpl) Migrate(ctx context.Context) error {
+	rows, err := ri.conn(ctx).QueryContext(ctx, `SELECT name FROM pragma_table_info('tasks')`)
+	if err != nil {
+		return fmt.Errorf("failed to query task columns: %w", err)
+	}
+	defer rows.Close()
+
+	columns := map[string]bool{}
+	for rows.Next() {
+		var column string
+		if err = rows.Scan(&column); err != nil {
+			return fmt.Errorf("failed to scan task column: %w", err)
+		}
+
+		columns[column] = true
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate task columns: %w", err)
+	}
+	rows.Close()
+
+	if len(columns) == 0 {
+		return nil
+	}
+
+	for _, migration := range MIGRATIONS {
+		if columns[migration.Column] {
+			continue
+		}
+
+		if _, err = ri.conn(ctx).ExecContext(ctx, migration.Query); err != nil {
+			return fmt.Errorf("failed to add task column %s: %w", migration.Column, err)
+		}
+	}
+
+	// Databases from before the index may have several started tasks, it is
+	// created once `todo repair` or starting a task left only one
+	var started int
+	if err = ri.conn(ctx).QueryRowContext(ctx, `SELECT COUNT(*) FROM tasks WHERE is_started = true`).Scan(&started); err != nil {
+		return fmt.Errorf("failed to count started tasks: %w", err)
+	}
+
+	if started > 1 {
+		return nil
+	}
+
+	if _, err = ri.conn(ctx).ExecContext(ctx, CREATE_STARTED_INDEX_QUERY); err != nil {
+		return fmt.Errorf("failed to create started task index: %w", err)
+	}
+
+	return nil
+}
+
+// conn joins the transaction ctx runs in, if any.
+func (ri *RepoImpl) conn(ctx context.Context) transaction.Conn {
+	return transaction.From(ctx, ri.db)
+}
+
 func (ri *RepoImpl) GetUncompleteParentTasks(ctx context.Context, projectID string) (entity.Tasks, error) {
 	var tasks []entity.Task
 	query := `SELECT * FROM tasks WHERE completed_at IS NULL AND project_id = ? AND (parent_task_id = '' OR parent_task_id IS NULL)`
-	rows, err := ri.db.QueryContext(ctx, query, projectID)
+	rows, err := ri.conn(ctx).QueryContext(ctx, query, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query tasks: %w", err)
 	}
@@ -29,7 +106,7 @@ func (ri *RepoImpl) GetUncompleteParentTasks(ctx context.Context, projectID stri
 
 	for rows.Next() {
 		var task TaskModel
-		err := rows.Scan(&task.ID, &task.ProjectID, &task.Name, &task.Description, &task.IsStarted, &task.CompletedAt, &task.ParentTaskID, &task.Integration, &task.Histories)
+		err := rows.Scan(&task.ID, &task.ProjectID, &task.Name, &task.Description, &task.IsStarted, &task.CompletedAt, &task.ParentTaskID, &task.Integration, &task.Histories, &task.DueAt, &task.Priority, &task.Tags)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan task: %w", err)
 		}
@@ -47,7 +124,7 @@ func (ri *RepoImpl) GetUncompleteParentTasks(ctx context.Context, projectID stri
 
 func (ri *RepoImpl) GetUncompleteSubTask(ctx context.Context, projectID string) (map[string]entity.Tasks, error) {
 	query := `SELECT * FROM tasks WHERE completed_at IS NULL AND project_id = ? AND (parent_task_id IS NOT NULL AND parent_task_id != '')`
-	rows, err := ri.db.QueryContext(ctx, query, projectID)
+	rows, err := ri.conn(ctx).QueryContext(ctx, query, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query tasks: %w", err)
 	}
@@ -56,7 +133,7 @@ func (ri *RepoImpl) GetUncompleteSubTask(ctx context.Context, projectID string)
 	tasks := map[string]entity.Tasks{}
 	for rows.Next() {
 		var task TaskModel
-		err := rows.Scan(&task.ID, &task.ProjectID, &task.Name, &task.Description, &task.IsStarted, &task.CompletedAt, &task.ParentTaskID, &task.Integration, &task.Histories)
+		err := rows.Scan(&task.ID, &task.ProjectID, &task.Name, &task.Description, &task.IsStarted, &task.CompletedAt, &task.ParentTaskID, &task.Integration, &task.Histories, &task.DueAt, &task.Priority, &task.Tags)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan task: %w", err)
 		}
@@ -72,14 +149,69 @@ func (ri *RepoImpl) GetUncompleteSubTask(ctx context.Context, projectID string)
 	return tasks, nil
 }
 
+func (ri *RepoImpl) GetAll(ctx context.Context) (entity.Tasks, error) {
+	var tasks entity.Tasks
+	query := `SELECT * FROM tasks`
+	rows, err := ri.conn(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var task TaskModel
+		err := rows.Scan(&task.ID, &task.ProjectID, &task.Name, &task.Description, &task.IsStarted, &task.CompletedAt, &task.ParentTaskID, &task.Integration, &task.Histories, &task.DueAt, &task.Priority, &task.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+
+		taskEntity, err := task.ToEntity()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert task to entity: %w", err)
+		}
+
+		tasks = append(tasks, taskEntity)
+	}
+
+	return tasks, nil
+}
+
+// GetSubTasks returns every subtask of the parent, completed or not.
+func (ri *RepoImpl) GetSubTasks(ctx context.Context, parentTaskID string) (entity.Tasks, error) {
+	var tasks entity.Tasks
+	query := `SELECT * FROM tasks WHERE parent_task_id = ?`
+	rows, err := ri.conn(ctx).QueryContext(ctx, query, parentTaskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var task TaskModel
+		err := rows.Scan(&task.ID, &task.ProjectID, &task.Name, &task.Description, &task.IsStarted, &task.CompletedAt, &task.ParentTaskID, &task.Integration, &task.Histories, &task.DueAt, &task.Priority, &task.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+
+		taskEntity, err := task.ToEntity()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert task to entity: %w", err)
+		}
+
+		tasks = append(tasks, taskEntity)
+	}
+
+	return tasks, nil
+}
+
 func (ri *RepoImpl) Insert(ctx context.Context, taskEntity entity.Task) error {
 	task, err := CreateModel(taskEntity)
 	if err != nil {
 		return fmt.Errorf("failed to create task model: %w", err)
 	}
 
-	query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration, histories) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
-	_, err = ri.db.ExecContext(ctx, query, task.ID, task.ProjectID, task.Name, task.Description, task.IsStarted, task.CompletedAt, task.ParentTaskID, task.Integration, task.Histories)
+	query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration, histories, due_at, priority, tags) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err = ri.conn(ctx).ExecContext(ctx, query, task.ID, task.ProjectID, task.Name, task.Description, task.IsStarted, task.CompletedAt, task.ParentTaskID, task.Integration, task.Histories, task.DueAt, task.Priority, task.Tags)
 	if err != nil {
 		return fmt.Errorf("failed to insert task: %w", err)
 	}
@@ -93,8 +225,8 @@ func (ri *RepoImpl) Update(ctx context.Context, taskEntity entity.Task) error {
 		return fmt.Errorf("failed to create task model: %w", err)
 	}
 
-	query := `UPDATE tasks SET project_id = ?, name = ?, description = ?, is_started = ?, completed_at = ?, parent_task_id = ?, integration = ?, histories = ? WHERE id = ?`
-	_, err = ri.db.ExecContext(ctx, query, task.ProjectID, task.Name, task.Description, task.IsStarted, task.CompletedAt, task.ParentTaskID, task.Integration, task.Histories, task.ID)
+	query := `UPDATE tasks SET project_id = ?, name = ?, description = ?, is_started = ?, completed_at = ?, parent_task_id = ?, integration = ?, histories = ?, due_at = ?, priority = ?, tags = ? WHERE id = ?`
+	_, err = ri.conn(ctx).ExecContext(ctx, query, task.ProjectID, task.Name, task.Description, task.IsStarted, task.CompletedAt, task.ParentTaskID, task.Integration, task.Histories, task.DueAt, task.Priority, task.Tags, task.ID)
 	if err != nil {
 		return fmt.Errorf("failed to update task: %w", err)
 	}
@@ -104,7 +236,7 @@ func (ri *RepoImpl) Update(ctx context.Context, taskEntity entity.Task) error {
 
 func (ri *RepoImpl) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM tasks WHERE id = ?`
-	_, err := ri.db.ExecContext(ctx, query, id)
+	_, err := ri.conn(ctx).ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete task: %w", err)
 	}
@@ -114,15 +246,19 @@ func (ri *RepoImpl) Delete(ctx context.Context, id string) error {
 
 func (ri *RepoImpl) GetByID(ctx context.Context, id string) (entity.Task, error) {
 	query := `SELECT * FROM tasks WHERE id = ?`
-	row := ri.db.QueryRowContext(ctx, query, id)
+	row := ri.conn(ctx).QueryRowContext(ctx, query, id)
+
+	var taskModel TaskModel
+	err := row.Scan(&taskModel.ID, &taskModel.ProjectID, &taskModel.Name, &taskModel.Description, &taskModel.IsStarted, &taskModel.CompletedAt, &taskModel.ParentTaskID, &taskModel.Integration, &taskModel.Histories, &taskModel.DueAt, &taskModel.Priority, &taskModel.Tags)
+	if err == sql.ErrNoRows {
+		return entity.Task{}, task.ErrTaskNotFound
+	}
 
-	var task TaskModel
-	err := row.Scan(&task.ID, &task.ProjectID, &task.Name, &task.Description, &task.IsStarted, &task.CompletedAt, &task.ParentTaskID, &task.Integration, &task.Histories)
 	if err != nil {
 		return entity.Task{}, fmt.Errorf("failed to scan task: %w", err)
 	}
 
-	taskEntity, err := task.ToEntity()
+	taskEntity, err := taskModel.ToEntity()
 	if err != nil {
 		return entity.Task{}, fmt.Errorf("failed to convert task to entity: %w", err)
 	}
@@ -136,8 +272,10 @@ func (ri *RepoImpl) Upsert(ctx context.Context, taskEntity entity.Task) error {
 		return fmt.Errorf("failed to create task model: %w", err)
 	}
 
-	query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration) VALUES (?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT(id) DO UPDATE SET project_id = ?, name = ?, description = ?, parent_task_id = ?, integration = ?`
-	_, err = ri.db.ExecContext(ctx, query, task.ID, task.ProjectID, task.Name, task.Description, task.IsStarted, task.CompletedAt, task.ParentTaskID, task.Integration, task.ProjectID, task.Name, task.Description, task.ParentTaskID, task.Integration)
+	// Integrations only ever complete a task or set its due date, clearing them
+	// is left to the user
+	query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration, due_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT(id) DO UPDATE SET project_id = ?, name = ?, description = ?, parent_task_id = ?, integration = ?, completed_at = COALESCE(excluded.completed_at, tasks.completed_at), due_at = COALESCE(excluded.due_at, tasks.due_at)`
+	_, err = ri.conn(ctx).ExecContext(ctx, query, task.ID, task.ProjectID, task.Name, task.Description, task.IsStarted, task.CompletedAt, task.ParentTaskID, task.Integration, task.DueAt, task.ProjectID, task.Name, task.Description, task.ParentTaskID, task.Integration)
 	if err != nil {
 		return fmt.Errorf("failed to upsert task: %w", err)
 	}
@@ -147,10 +285,10 @@ func (ri *RepoImpl) Upsert(ctx context.Context, taskEntity entity.Task) error {
 
 func (ri *RepoImpl) GetStartedTask(ctx context.Context) (entity.Task, error) {
 	query := `SELECT * FROM tasks WHERE is_started = true LIMIT 1`
-	row := ri.db.QueryRowContext(ctx, query)
+	row := ri.conn(ctx).QueryRowContext(ctx, query)
 
 	var taskModel TaskModel
-	err := row.Scan(&taskModel.ID, &taskModel.ProjectID, &taskModel.Name, &taskModel.Description, &taskModel.IsStarted, &taskModel.CompletedAt, &taskModel.ParentTaskID, &taskModel.Integration, &taskModel.Histories)
+	err := row.Scan(&taskModel.ID, &taskModel.ProjectID, &taskModel.Name, &taskModel.Description, &taskModel.IsStarted, &taskModel.CompletedAt, &taskModel.ParentTaskID, &taskModel.Integration, &taskModel.Histories, &taskModel.DueAt, &taskModel.Priority, &taskModel.Tags)
 	if err != nil && err != sql.ErrNoRows {
 		return entity.Task{}, fmt.Errorf("failed to scan task: %w", err)
 	}
@@ -167,17 +305,63 @@ func (ri *RepoImpl) GetStartedTask(ctx context.Context) (entity.Task, error) {
 	return taskEntity, nil
 }
 
-func (ri *RepoImpl) SetStartedTask(ctx context.Context, task entity.Task) error {
-	taskModel, err := CreateModel(task)
+// SetStartedTask stops every other started task at the time task was started
+// and saves task in one transaction, so concurrent invocations can never leave
+// more than one task started. The stopped tasks are returned.
+func (ri *RepoImpl) SetStartedTask(ctx context.Context, startedTask entity.Task) (entity.Tasks, error) {
+	var stoppedTasks entity.Tasks
+	err := transaction.Run(ctx, ri.db, func(ctx context.Context) (err error) {
+		stoppedTasks, err = ri.stopStartedTasks(ctx, startedTask)
+		if err != nil {
+			return err
+		}
+
+		return ri.Update(ctx, startedTask)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to create task model: %w", err)
+		return nil, err
 	}
 
-	query := `UPDATE tasks SET project_id = ?, name = ?, description = ?, is_started = ?, completed_at = ?, parent_task_id = ?, integration = ?, histories = ? WHERE id = ?`
-	_, err = ri.db.ExecContext(ctx, query, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories, taskModel.ID)
+	return stoppedTasks, nil
+}
+
+// stopStartedTasks stops every started task but startedTask at the time it
+// was started.
+func (ri *RepoImpl) stopStartedTasks(ctx context.Context, startedTask entity.Task) (entity.Tasks, error) {
+	query := `SELECT * FROM tasks WHERE is_started = true AND id != ?`
+	rows, err := ri.conn(ctx).QueryContext(ctx, query, startedTask.ID)
 	if err != nil {
-		return fmt.Errorf("failed to update task: %w", err)
+		return nil, fmt.Errorf("failed to query tasks: %w", err)
 	}
+	defer rows.Close()
 
-	return nil
+	var stoppedTasks entity.Tasks
+	for rows.Next() {
+		var task TaskModel
+		err := rows.Scan(&task.ID, &task.ProjectID, &task.Name, &task.Description, &task.IsStarted, &task.CompletedAt, &task.ParentTaskID, &task.Integration, &task.Histories, &task.DueAt, &task.Priority, &task.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+
+		taskEntity, err := task.ToEntity()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert task to entity: %w", err)
+		}
+
+		taskEntity.StopAt(startedTask.SessionStartedAt())
+		stoppedTasks = append(stoppedTasks, taskEntity)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate tasks: %w", err)
+	}
+	rows.Close()
+
+	for _, stoppedTask := range stoppedTasks {
+		if err = ri.Update(ctx, stoppedTask); err != nil {
+			return nil, err
+		}
+	}
+
+	return stoppedTasks, nil
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/domain/task"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/suite"
 )
@@ -53,14 +54,14 @@ func (s *RepoImplTestSuite) TestGetUncompleteParentTasks() {
 				query := `SELECT * FROM tasks WHERE completed_at IS NULL AND project_id = ? AND (parent_task_id = '' OR parent_task_id IS NULL)`
 				s.db.ExpectQuery(query).WithArgs(projectID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
 			},
-			expectedError: errors.New("sql: expected 1 destination arguments in Scan, not 9"),
+			expectedError: errors.New("sql: expected 1 destination arguments in Scan, not 12"),
 		},
 		{
 			name:      "success",
 			projectID: "1",
 			mock: func(projectID string) {
 				query := `SELECT * FROM tasks WHERE completed_at IS NULL AND project_id = ? AND (parent_task_id = '' OR parent_task_id IS NULL)`
-				s.db.ExpectQuery(query).WithArgs(projectID).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id", "name", "description", "is_started", "completed_at", "parent_task_id", "integration", "histories"}).AddRow("1", "1", "name", "description", false, nil, nil, nil, nil))
+				s.db.ExpectQuery(query).WithArgs(projectID).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id", "name", "description", "is_started", "completed_at", "parent_task_id", "integration", "histories", "due_at", "priority", "tags"}).AddRow("1", "1", "name", "description", false, nil, nil, nil, nil, nil, nil, nil))
 			},
 			expectedResult: entity.Tasks{
 				{
@@ -118,14 +119,14 @@ func (s *RepoImplTestSuite) TestGetUncompleteSubTask() {
 				query := `SELECT * FROM tasks WHERE completed_at IS NULL AND project_id = ? AND (parent_task_id IS NOT NULL AND parent_task_id != '')`
 				s.db.ExpectQuery(query).WithArgs(projectID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
 			},
-			expectedError: errors.New("sql: expected 1 destination arguments in Scan, not 9"),
+			expectedError: errors.New("sql: expected 1 destination arguments in Scan, not 12"),
 		},
 		{
 			name:      "success",
 			projectID: "1",
 			mock: func(projectID string) {
 				query := `SELECT * FROM tasks WHERE completed_at IS NULL AND project_id = ? AND (parent_task_id IS NOT NULL AND parent_task_id != '')`
-				s.db.ExpectQuery(query).WithArgs(projectID).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id", "name", "description", "is_started", "completed_at", "parent_task_id", "integration", "histories"}).AddRow("1", "1", "name", "description", false, nil, "1", nil, nil))
+				s.db.ExpectQuery(query).WithArgs(projectID).WillReturnRows(sqlmock.NewRows([]string{"id", "project_id", "name", "description", "is_started", "completed_at", "parent_task_id", "integration", "histories", "due_at", "priority", "tags"}).AddRow("1", "1", "name", "description", false, nil, "1", nil, nil, nil, nil, nil))
 			},
 			expectedResult: map[string]entity.Tasks{
 				"1": {
@@ -186,8 +187,8 @@ func (s *RepoImplTestSuite) TestInsert() {
 			},
 			mock: func(task entity.Task) {
 				taskModel, _ := CreateModel(task)
-				query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration, histories) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
-				s.db.ExpectExec(query).WithArgs(taskModel.ID, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories).WillReturnError(errors.New("any-error"))
+				query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration, histories, due_at, priority, tags) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+				s.db.ExpectExec(query).WithArgs(taskModel.ID, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories, taskModel.DueAt, taskModel.Priority, taskModel.Tags).WillReturnError(errors.New("any-error"))
 			},
 			expectedError: errors.New("any-error"),
 		},
@@ -209,8 +210,8 @@ func (s *RepoImplTestSuite) TestInsert() {
 			},
 			mock: func(task entity.Task) {
 				taskModel, _ := CreateModel(task)
-				query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration, histories) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
-				s.db.ExpectExec(query).WithArgs(taskModel.ID, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories).WillReturnResult(sqlmock.NewResult(1, 1))
+				query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration, histories, due_at, priority, tags) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+				s.db.ExpectExec(query).WithArgs(taskModel.ID, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories, taskModel.DueAt, taskModel.Priority, taskModel.Tags).WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
 	}
@@ -254,8 +255,8 @@ func (s *RepoImplTestSuite) TestUpdate() {
 			},
 			mock: func(task entity.Task) {
 				taskModel, _ := CreateModel(task)
-				query := `UPDATE tasks SET project_id = ?, name = ?, description = ?, is_started = ?, completed_at = ?, parent_task_id = ?, integration = ?, histories = ? WHERE id = ?`
-				s.db.ExpectExec(query).WithArgs(taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories, taskModel.ID).WillReturnError(errors.New("any-error"))
+				query := `UPDATE tasks SET project_id = ?, name = ?, description = ?, is_started = ?, completed_at = ?, parent_task_id = ?, integration = ?, histories = ?, due_at = ?, priority = ?, tags = ? WHERE id = ?`
+				s.db.ExpectExec(query).WithArgs(taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories, taskModel.DueAt, taskModel.Priority, taskModel.Tags, taskModel.ID).WillReturnError(errors.New("any-error"))
 			},
 			expectedError: errors.New("any-error"),
 		},
@@ -277,8 +278,8 @@ func (s *RepoImplTestSuite) TestUpdate() {
 			},
 			mock: func(task entity.Task) {
 				taskModel, _ := CreateModel(task)
-				query := `UPDATE tasks SET project_id = ?, name = ?, description = ?, is_started = ?, completed_at = ?, parent_task_id = ?, integration = ?, histories = ? WHERE id = ?`
-				s.db.ExpectExec(query).WithArgs(taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories, taskModel.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+				query := `UPDATE tasks SET project_id = ?, name = ?, description = ?, is_started = ?, completed_at = ?, parent_task_id = ?, integration = ?, histories = ?, due_at = ?, priority = ?, tags = ? WHERE id = ?`
+				s.db.ExpectExec(query).WithArgs(taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories, taskModel.DueAt, taskModel.Priority, taskModel.Tags, taskModel.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
 	}
@@ -354,13 +355,22 @@ func (s *RepoImplTestSuite) TestGetByID() {
 			},
 			expectedError: errors.New("any-error"),
 		},
+		{
+			name:   "task not found",
+			taskID: "1",
+			mock: func(taskID string) {
+				query := `SELECT * FROM tasks WHERE id = ?`
+				s.db.ExpectQuery(query).WithArgs(taskID).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			expectedError: task.ErrTaskNotFound,
+		},
 		{
 			name:   "success",
 			taskID: "1",
 			mock: func(taskID string) {
 				query := `SELECT * FROM tasks WHERE id = ?`
-				rows := sqlmock.NewRows([]string{"id", "project_id", "name", "description", "is_started", "completed_at", "parent_task_id", "integration", "histories"}).
-					AddRow(taskID, "1", "name", "description", false, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "1", "{}", "[]")
+				rows := sqlmock.NewRows([]string{"id", "project_id", "name", "description", "is_started", "completed_at", "parent_task_id", "integration", "histories", "due_at", "priority", "tags"}).
+					AddRow(taskID, "1", "name", "description", false, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "1", "{}", "[]", time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC), "A", `["home","blocked"]`)
 				s.db.ExpectQuery(query).WithArgs(taskID).WillReturnRows(rows)
 			},
 			expectedTask: entity.Task{
@@ -370,9 +380,12 @@ func (s *RepoImplTestSuite) TestGetByID() {
 				Description:  "description",
 				IsStarted:    false,
 				CompletedAt:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				DueAt:        time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
 				ParentTaskID: "1",
 				Integration:  entity.TaskIntegration{},
 				Histories:    []entity.TaskHistory(nil),
+				Priority:     "A",
+				Tags:         []string{"home", "blocked"},
 			},
 		},
 	}
@@ -383,8 +396,8 @@ func (s *RepoImplTestSuite) TestGetByID() {
 
 			task, err := s.repoImpl.GetByID(context.Background(), tt.taskID)
 
-			if err != nil {
-				err = errors.Unwrap(err)
+			if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
+				err = unwrappedErr
 			}
 			s.Equal(tt.expectedError, err)
 			s.Equal(tt.expectedTask, task)
@@ -417,8 +430,8 @@ func (s *RepoImplTestSuite) TestUpsert() {
 			},
 			mock: func(task entity.Task) {
 				taskModel, _ := CreateModel(task)
-				query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration) VALUES (?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT(id) DO UPDATE SET project_id = ?, name = ?, description = ?, parent_task_id = ?, integration = ?`
-				s.db.ExpectExec(query).WithArgs(taskModel.ID, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.ParentTaskID, taskModel.Integration).WillReturnError(errors.New("any-error"))
+				query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration, due_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT(id) DO UPDATE SET project_id = ?, name = ?, description = ?, parent_task_id = ?, integration = ?, completed_at = COALESCE(excluded.completed_at, tasks.completed_at), due_at = COALESCE(excluded.due_at, tasks.due_at)`
+				s.db.ExpectExec(query).WithArgs(taskModel.ID, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.DueAt, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.ParentTaskID, taskModel.Integration).WillReturnError(errors.New("any-error"))
 			},
 			expectedError: errors.New("any-error"),
 		},
@@ -440,8 +453,8 @@ func (s *RepoImplTestSuite) TestUpsert() {
 			},
 			mock: func(task entity.Task) {
 				taskModel, _ := CreateModel(task)
-				query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration) VALUES (?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT(id) DO UPDATE SET project_id = ?, name = ?, description = ?, parent_task_id = ?, integration = ?`
-				s.db.ExpectExec(query).WithArgs(taskModel.ID, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.ParentTaskID, taskModel.Integration).WillReturnResult(sqlmock.NewResult(1, 1))
+				query := `INSERT INTO tasks (id, project_id, name, description, is_started, completed_at, parent_task_id, integration, due_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT(id) DO UPDATE SET project_id = ?, name = ?, description = ?, parent_task_id = ?, integration = ?, completed_at = COALESCE(excluded.completed_at, tasks.completed_at), due_at = COALESCE(excluded.due_at, tasks.due_at)`
+				s.db.ExpectExec(query).WithArgs(taskModel.ID, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.DueAt, taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.ParentTaskID, taskModel.Integration).WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
 	}
@@ -478,8 +491,8 @@ func (s *RepoImplTestSuite) TestGetStartedTask() {
 			name: "success",
 			mock: func() {
 				query := `SELECT * FROM tasks WHERE is_started = true LIMIT 1`
-				rows := sqlmock.NewRows([]string{"id", "project_id", "name", "description", "is_started", "completed_at", "parent_task_id", "integration", "histories"}).
-					AddRow("1", "1", "name", "description", true, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "1", "{}", "[]")
+				rows := sqlmock.NewRows([]string{"id", "project_id", "name", "description", "is_started", "completed_at", "parent_task_id", "integration", "histories", "due_at", "priority", "tags"}).
+					AddRow("1", "1", "name", "description", true, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "1", "{}", "[]", nil, nil, nil)
 				s.db.ExpectQuery(query).WillReturnRows(rows)
 			},
 		},
@@ -500,69 +513,275 @@ func (s *RepoImplTestSuite) TestGetStartedTask() {
 }
 
 func (s *RepoImplTestSuite) TestSetStartedtask() {
+	startedAt := time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC)
+	startedTask := entity.Task{
+		ID:        "1",
+		ProjectID: "1",
+		Name:      "name",
+		IsStarted: true,
+		Histories: []entity.TaskHistory{{StartedAt: startedAt}},
+	}
+	stoppedTask := entity.Task{
+		ID:        "2",
+		ProjectID: "1",
+		Name:      "other",
+		Histories: []entity.TaskHistory{{StartedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), StoppedAt: startedAt}},
+	}
+	selectQuery := `SELECT * FROM tasks WHERE is_started = true AND id != ?`
+	updateQuery := `UPDATE tasks SET project_id = ?, name = ?, description = ?, is_started = ?, completed_at = ?, parent_task_id = ?, integration = ?, histories = ?, due_at = ?, priority = ?, tags = ? WHERE id = ?`
+	expectUpdate := func(task entity.Task) *sqlmock.ExpectedExec {
+		taskModel, _ := CreateModel(task)
+		return s.db.ExpectExec(updateQuery).WithArgs(taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories, taskModel.DueAt, taskModel.Priority, taskModel.Tags, taskModel.ID)
+	}
+	columns := []string{"id", "project_id", "name", "description", "is_started", "completed_at", "parent_task_id", "integration", "histories", "due_at", "priority", "tags"}
+
 	tests := []struct {
-		name          string
-		task          entity.Task
-		mock          func(task entity.Task)
-		expectedError error
+		name           string
+		mock           func()
+		expectedResult entity.Tasks
+		expectedError  error
 	}{
 		{
-			name: "failed to set started task",
-			task: entity.Task{
-				ID:           "1",
-				ProjectID:    "1",
-				Name:         "name",
-				Description:  "description",
-				IsStarted:    false,
-				CompletedAt:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				ParentTaskID: "1",
-				Integration: entity.TaskIntegration{
-					ID:   "1",
-					Type: entity.IntegrationType("JIRA"),
-				},
-				Histories: []entity.TaskHistory(nil),
+			name: "failed to begin transaction",
+			mock: func() {
+				s.db.ExpectBegin().WillReturnError(errors.New("any-error"))
 			},
-			mock: func(task entity.Task) {
-				taskModel, _ := CreateModel(task)
-				query := `UPDATE tasks SET project_id = ?, name = ?, description = ?, is_started = ?, completed_at = ?, parent_task_id = ?, integration = ?, histories = ? WHERE id = ?`
-				s.db.ExpectExec(query).WithArgs(taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories, taskModel.ID).WillReturnError(errors.New("any-error"))
+			expectedError: errors.New("any-error"),
+		},
+		{
+			name: "failed to query started tasks",
+			mock: func() {
+				s.db.ExpectBegin()
+				s.db.ExpectQuery(selectQuery).WithArgs("1").WillReturnError(errors.New("any-error"))
+				s.db.ExpectRollback()
+			},
+			expectedError: errors.New("any-error"),
+		},
+		{
+			name: "failed to update task",
+			mock: func() {
+				s.db.ExpectBegin()
+				s.db.ExpectQuery(selectQuery).WithArgs("1").WillReturnRows(sqlmock.NewRows(columns))
+				expectUpdate(startedTask).WillReturnError(errors.New("any-error"))
+				s.db.ExpectRollback()
+			},
+			expectedError: errors.New("any-error"),
+		},
+		{
+			name: "stops the other started task",
+			mock: func() {
+				s.db.ExpectBegin()
+				s.db.ExpectQuery(selectQuery).WithArgs("1").WillReturnRows(sqlmock.NewRows(columns).
+					AddRow("2", "1", "other", nil, true, nil, nil, nil, `[{"started_at":"2021-01-01T00:00:00Z","stopped_at":"0001-01-01T00:00:00Z"}]`, nil, nil, nil))
+				expectUpdate(stoppedTask).WillReturnResult(sqlmock.NewResult(1, 1))
+				expectUpdate(startedTask).WillReturnResult(sqlmock.NewResult(1, 1))
+				s.db.ExpectCommit()
+			},
+			expectedResult: entity.Tasks{stoppedTask},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mock()
+
+			res, err := s.repoImpl.SetStartedTask(context.Background(), startedTask)
+
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+			s.Equal(tt.expectedError, err)
+			s.Equal(tt.expectedResult, res)
+			s.NoError(s.db.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *RepoImplTestSuite) TestGetSubTasks() {
+	query := `SELECT * FROM tasks WHERE parent_task_id = ?`
+	tests := []struct {
+		name           string
+		expectedResult entity.Tasks
+		expectedError  error
+		mock           func()
+	}{
+		{
+			name: "failed to get sub tasks",
+			mock: func() {
+				s.db.ExpectQuery(query).WithArgs("1").WillReturnError(errors.New("any-error"))
 			},
 			expectedError: errors.New("any-error"),
 		},
 		{
 			name: "success",
-			task: entity.Task{
-				ID:           "1",
-				ProjectID:    "1",
-				Name:         "name",
-				Description:  "description",
-				IsStarted:    false,
-				CompletedAt:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				ParentTaskID: "1",
-				Integration: entity.TaskIntegration{
-					ID:   "1",
-					Type: entity.IntegrationType("JIRA"),
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"id", "project_id", "name", "description", "is_started", "completed_at", "parent_task_id", "integration", "histories", "due_at", "priority", "tags"}).
+					AddRow("2", "1", "name", "description", false, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "1", "{}", "[]", nil, nil, nil).
+					AddRow("3", "1", "name", nil, false, nil, "1", "{}", "[]", nil, nil, nil)
+				s.db.ExpectQuery(query).WithArgs("1").WillReturnRows(rows)
+			},
+			expectedResult: entity.Tasks{
+				{
+					ID:           "2",
+					ProjectID:    "1",
+					Name:         "name",
+					Description:  "description",
+					CompletedAt:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					ParentTaskID: "1",
+				},
+				{
+					ID:           "3",
+					ProjectID:    "1",
+					Name:         "name",
+					ParentTaskID: "1",
 				},
-				Histories: []entity.TaskHistory(nil),
 			},
-			mock: func(task entity.Task) {
-				taskModel, _ := CreateModel(task)
-				query := `UPDATE tasks SET project_id = ?, name = ?, description = ?, is_started = ?, completed_at = ?, parent_task_id = ?, integration = ?, histories = ? WHERE id = ?`
-				s.db.ExpectExec(query).WithArgs(taskModel.ProjectID, taskModel.Name, taskModel.Description, taskModel.IsStarted, taskModel.CompletedAt, taskModel.ParentTaskID, taskModel.Integration, taskModel.Histories, taskModel.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mock()
+
+			tasks, err := s.repoImpl.GetSubTasks(context.Background(), "1")
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			s.Equal(tt.expectedError, err)
+			s.Equal(tt.expectedResult, tasks)
+		})
+	}
+}
+
+func (s *RepoImplTestSuite) TestGetAll() {
+	query := `SELECT * FROM tasks`
+	tests := []struct {
+		name           string
+		expectedResult entity.Tasks
+		expectedError  error
+		mock           func()
+	}{
+		{
+			name: "failed to get tasks",
+			mock: func() {
+				s.db.ExpectQuery(query).WillReturnError(errors.New("any-error"))
+			},
+			expectedError: errors.New("any-error"),
+		},
+		{
+			name: "success",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"id", "project_id", "name", "description", "is_started", "completed_at", "parent_task_id", "integration", "histories", "due_at", "priority", "tags"}).
+					AddRow("1", "1", "name", nil, true, nil, nil, "{}", `[{"started_at":"2021-01-01T00:00:00Z","stopped_at":"0001-01-01T00:00:00Z"}]`, nil, nil, nil)
+				s.db.ExpectQuery(query).WillReturnRows(rows)
+			},
+			expectedResult: entity.Tasks{
+				{
+					ID:        "1",
+					ProjectID: "1",
+					Name:      "name",
+					IsStarted: true,
+					Histories: []entity.TaskHistory{
+						{StartedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+					},
+				},
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
-			tt.mock(tt.task)
+			tt.mock()
+
+			tasks, err := s.repoImpl.GetAll(context.Background())
+			if err != nil {
+				err = errors.Unwrap(err)
+			}
+
+			s.Equal(tt.expectedError, err)
+			s.Equal(tt.expectedResult, tasks)
+		})
+	}
+}
 
-			err := s.repoImpl.SetStartedTask(context.Background(), tt.task)
+func (s *RepoImplTestSuite) TestMigrate() {
+	query := `SELECT name FROM pragma_table_info('tasks')`
+	countQuery := `SELECT COUNT(*) FROM tasks WHERE is_started = true`
+	tests := []struct {
+		name          string
+		expectedError error
+		mock          func()
+	}{
+		{
+			name: "failed to query columns",
+			mock: func() {
+				s.db.ExpectQuery(query).WillReturnError(errors.New("any-error"))
+			},
+			expectedError: errors.New("any-error"),
+		},
+		{
+			name: "table is not set up yet",
+			mock: func() {
+				s.db.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+			},
+		},
+		{
+			name: "failed to add column",
+			mock: func() {
+				s.db.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("id").AddRow("histories"))
+				s.db.ExpectExec(`ALTER TABLE tasks ADD COLUMN due_at DATETIME`).WillReturnError(errors.New("any-error"))
+			},
+			expectedError: errors.New("any-error"),
+		},
+		{
+			name: "success adding missing column",
+			mock: func() {
+				s.db.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("id").AddRow("histories"))
+				s.db.ExpectExec(`ALTER TABLE tasks ADD COLUMN due_at DATETIME`).WillReturnResult(sqlmock.NewResult(0, 0))
+				s.db.ExpectExec(`ALTER TABLE tasks ADD COLUMN priority VARCHAR`).WillReturnResult(sqlmock.NewResult(0, 0))
+				s.db.ExpectExec(`ALTER TABLE tasks ADD COLUMN tags TEXT`).WillReturnResult(sqlmock.NewResult(0, 0))
+				s.db.ExpectQuery(countQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				s.db.ExpectExec(CREATE_STARTED_INDEX_QUERY).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "success when up to date",
+			mock: func() {
+				s.db.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("id").AddRow("due_at").AddRow("priority").AddRow("tags"))
+				s.db.ExpectQuery(countQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				s.db.ExpectExec(CREATE_STARTED_INDEX_QUERY).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "several started tasks",
+			mock: func() {
+				s.db.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("id").AddRow("due_at").AddRow("priority").AddRow("tags"))
+				s.db.ExpectQuery(countQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			},
+		},
+		{
+			name: "failed to create index",
+			mock: func() {
+				s.db.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("id").AddRow("due_at").AddRow("priority").AddRow("tags"))
+				s.db.ExpectQuery(countQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				s.db.ExpectExec(CREATE_STARTED_INDEX_QUERY).WillReturnError(errors.New("any-error"))
+			},
+			expectedError: errors.New("any-error"),
+		},
+	}
 
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mock()
+
+			err := s.repoImpl.Migrate(context.Background())
 			if err != nil {
 				err = errors.Unwrap(err)
 			}
+
 			s.Equal(tt.expectedError, err)
+			s.NoError(s.db.ExpectationsWereMet())
 		})
 	}
 }
@@ -10,14 +10,22 @@ import (
 	"github.com/google/uuid"
 )
 
-type TaskHistoryModel struct {
+type TaskPauseModel struct {
 	StartedAt time.Time `json:"started_at"`
 	StoppedAt time.Time `json:"stopped_at"`
+	Reason    string    `json:"reason,omitempty"`
+}
+
+type TaskHistoryModel struct {
+	StartedAt time.Time        `json:"started_at"`
+	StoppedAt time.Time        `json:"stopped_at"`
+	Pauses    []TaskPauseModel `json:"pauses,omitempty"`
 }
 
 type TaskIntegrationModel struct {
-	ID   string `json:"id"`
-	Type string `json:"type"`
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Revision string `json:"revision,omitempty"`
 }
 
 type TaskModel struct {
@@ -30,6 +38,9 @@ type TaskModel struct {
 	ParentTaskID sql.NullString
 	Integration  sql.NullString
 	Histories    sql.NullString
+	DueAt        sql.NullTime
+	Priority     sql.NullString
+	Tags         sql.NullString
 }
 
 func (tm TaskModel) ToEntity() (entity.Task, error) {
@@ -40,7 +51,9 @@ func (tm TaskModel) ToEntity() (entity.Task, error) {
 		Description:  tm.Description.String,
 		IsStarted:    tm.IsStarted,
 		CompletedAt:  tm.CompletedAt.Time,
+		DueAt:        tm.DueAt.Time,
 		ParentTaskID: tm.ParentTaskID.String,
+		Priority:     tm.Priority.String,
 	}
 
 	if tm.Integration.Valid {
@@ -51,8 +64,15 @@ func (tm TaskModel) ToEntity() (entity.Task, error) {
 		}
 
 		task.Integration = entity.TaskIntegration{
-			ID:   integrationModel.ID,
-			Type: entity.IntegrationType(integrationModel.Type),
+			ID:       integrationModel.ID,
+			Type:     entity.IntegrationType(integrationModel.Type),
+			Revision: integrationModel.Revision,
+		}
+	}
+
+	if tm.Tags.Valid {
+		if err := json.Unmarshal([]byte(tm.Tags.String), &task.Tags); err != nil {
+			return entity.Task{}, fmt.Errorf("failed to unmarshal tags: %w", err)
 		}
 	}
 
@@ -64,10 +84,20 @@ func (tm TaskModel) ToEntity() (entity.Task, error) {
 		}
 
 		for _, historyModel := range historyModels {
-			task.Histories = append(task.Histories, entity.TaskHistory{
+			history := entity.TaskHistory{
 				StartedAt: historyModel.StartedAt,
 				StoppedAt: historyModel.StoppedAt,
-			})
+			}
+
+			for _, pauseModel := range historyModel.Pauses {
+				history.Pauses = append(history.Pauses, entity.TaskPause{
+					StartedAt: pauseModel.StartedAt,
+					StoppedAt: pauseModel.StoppedAt,
+					Reason:    pauseModel.Reason,
+				})
+			}
+
+			task.Histories = append(task.Histories, history)
 		}
 	}
 
@@ -76,8 +106,9 @@ func (tm TaskModel) ToEntity() (entity.Task, error) {
 
 func CreateModel(task entity.Task) (TaskModel, error) {
 	integrationBytes, err := json.Marshal(TaskIntegrationModel{
-		ID:   task.Integration.ID,
-		Type: string(task.Integration.Type),
+		ID:       task.Integration.ID,
+		Type:     string(task.Integration.Type),
+		Revision: task.Integration.Revision,
 	})
 	if err != nil {
 		return TaskModel{}, fmt.Errorf("failed to marshal integration: %w", err)
@@ -85,16 +116,34 @@ func CreateModel(task entity.Task) (TaskModel, error) {
 
 	var historyModels []TaskHistoryModel
 	for _, history := range task.Histories {
-		historyModels = append(historyModels, TaskHistoryModel{
+		historyModel := TaskHistoryModel{
 			StartedAt: history.StartedAt,
 			StoppedAt: history.StoppedAt,
-		})
+		}
+
+		for _, pause := range history.Pauses {
+			historyModel.Pauses = append(historyModel.Pauses, TaskPauseModel{
+				StartedAt: pause.StartedAt,
+				StoppedAt: pause.StoppedAt,
+				Reason:    pause.Reason,
+			})
+		}
+
+		historyModels = append(historyModels, historyModel)
 	}
 	historiesBytes, err := json.Marshal(historyModels)
 	if err != nil {
 		return TaskModel{}, fmt.Errorf("failed to marshal histories: %w", err)
 	}
 
+	var tagsBytes []byte
+	if len(task.Tags) > 0 {
+		tagsBytes, err = json.Marshal(task.Tags)
+		if err != nil {
+			return TaskModel{}, fmt.Errorf("failed to marshal tags: %w", err)
+		}
+	}
+
 	if task.ID == "" {
 		task.ID = uuid.NewString()
 	}
@@ -109,5 +158,8 @@ func CreateModel(task entity.Task) (TaskModel, error) {
 		ParentTaskID: sql.NullString{String: task.ParentTaskID, Valid: task.ParentTaskID != ""},
 		Integration:  sql.NullString{String: string(integrationBytes), Valid: len(integrationBytes) > 0},
 		Histories:    sql.NullString{String: string(historiesBytes), Valid: len(historiesBytes) > 0},
+		DueAt:        sql.NullTime{Time: task.DueAt, Valid: !task.DueAt.IsZero()},
+		Priority:     sql.NullString{String: task.Priority, Valid: task.Priority != ""},
+		Tags:         sql.NullString{String: string(tagsBytes), Valid: len(tagsBytes) > 0},
 	}, nil
 }
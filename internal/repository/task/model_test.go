@@ -191,3 +191,34 @@ func TestCreateModel(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedTask, got)
 }
+
+func TestCreateModelWithPauses(t *testing.T) {
+	paramTask := entity.Task{
+		ID:        "1",
+		ProjectID: "1",
+		Name:      "test",
+		Histories: []entity.TaskHistory{
+			{
+				StartedAt: time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC),
+				StoppedAt: time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+				Pauses: []entity.TaskPause{
+					{
+						StartedAt: time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC),
+						StoppedAt: time.Date(2021, 1, 1, 10, 30, 0, 0, time.UTC),
+						Reason:    "meeting",
+					},
+				},
+			},
+		},
+	}
+
+	got, err := CreateModel(paramTask)
+
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"started_at":"2021-01-01T09:00:00Z","stopped_at":"2021-01-01T12:00:00Z","pauses":[{"started_at":"2021-01-01T10:00:00Z","stopped_at":"2021-01-01T10:30:00Z","reason":"meeting"}]}]`, got.Histories.String)
+
+	task, err := got.ToEntity()
+
+	assert.Nil(t, err)
+	assert.Equal(t, paramTask.Histories, task.Histories)
+}
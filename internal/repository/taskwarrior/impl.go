@@ -0,0 +1,350 @@
+package taskwarrior
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// Taskwarrior priorities are H, M and L, they are the first three letters of
+// the todo.txt ones used here.
+var (
+	fromPriority = map[string]string{"H": "A", "M": "B", "L": "C"}
+	toPriority   = map[string]string{"A": "H", "B": "M", "C": "L"}
+)
+
+// RepoImpl reads what task export writes and writes what task import reads.
+// Task UUIDs are kept as task IDs, annotations become notes and a task which
+// depends on others becomes their parent. When Timewarrior is installed, the
+// intervals tagged with the UUID or the description of a task become its
+// sessions.
+type RepoImpl struct {
+	now         func() time.Time
+	timewarrior func(ctx context.Context) ([]byte, error)
+}
+
+func New() *RepoImpl {
+	return &RepoImpl{
+		now:         time.Now,
+		timewarrior: timewExport,
+	}
+}
+
+// timewExport returns nothing when timew is not installed.
+func timewExport(ctx context.Context) ([]byte, error) {
+	if _, err := exec.LookPath("timew"); err != nil {
+		return nil, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "timew", "export")
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to run timew: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.Bytes(), nil
+}
+
+func (ri *RepoImpl) Decode(ctx context.Context, r io.Reader) (entity.Transfer, error) {
+	taskModels, err := decodeTasks(r)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	var transfer entity.Transfer
+	projects := map[string]bool{}
+	for _, taskModel := range taskModels {
+		// Deleted tasks are gone and recurring ones are templates of the
+		// pending tasks Taskwarrior creates from them
+		if taskModel.Status == statusDeleted || taskModel.Status == statusRecurring {
+			continue
+		}
+
+		task := entity.Task{
+			ID:        taskModel.UUID,
+			ProjectID: taskModel.Project,
+			Name:      taskModel.Description,
+			DueAt:     taskModel.Due.value(),
+			Priority:  fromPriority[taskModel.Priority],
+			Tags:      taskModel.Tags,
+		}
+
+		if taskModel.Status == statusCompleted {
+			task.CompletedAt = taskModel.End.value()
+			if task.CompletedAt.IsZero() {
+				task.CompletedAt = ri.now()
+			}
+		}
+
+		if task.ProjectID != "" && !projects[task.ProjectID] {
+			projects[task.ProjectID] = true
+			transfer.Projects = append(transfer.Projects, entity.Project{Name: task.ProjectID})
+		}
+
+		for _, annotation := range taskModel.Annotations {
+			transfer.Notes = append(transfer.Notes, entity.TaskNote{
+				TaskID:    task.ID,
+				Content:   annotation.Description,
+				CreatedAt: annotation.Entry.value(),
+			})
+		}
+
+		transfer.Tasks = append(transfer.Tasks, task)
+	}
+
+	setParents(transfer.Tasks, taskModels)
+	if err = ri.addSessions(ctx, transfer.Tasks); err != nil {
+		return entity.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+// decodeTasks reads a JSON array, or one JSON object per line as task import
+// also accepts.
+func decodeTasks(r io.Reader) ([]TaskModel, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tasks: %w", err)
+	}
+
+	var taskModels []TaskModel
+	content = bytes.TrimSpace(content)
+	if bytes.HasPrefix(content, []byte("[")) {
+		if err = json.Unmarshal(content, &taskModels); err != nil {
+			return nil, fmt.Errorf("failed to read tasks: %w", err)
+		}
+
+		return taskModels, nil
+	}
+
+	for i, line := range bytes.Split(content, []byte("\n")) {
+		line = bytes.TrimSuffix(bytes.TrimSpace(line), []byte(","))
+		if len(line) == 0 {
+			continue
+		}
+
+		var taskModel TaskModel
+		if err = json.Unmarshal(line, &taskModel); err != nil {
+			return nil, fmt.Errorf("failed to read task on line %d: %w", i+1, err)
+		}
+
+		taskModels = append(taskModels, taskModel)
+	}
+
+	return taskModels, nil
+}
+
+// setParents makes a task the parent of the tasks it depends on, a task
+// which more than one task depends on stays with the first one.
+func setParents(tasks entity.Tasks, taskModels []TaskModel) {
+	indexes := map[string]int{}
+	for i, task := range tasks {
+		indexes[task.ID] = i
+	}
+
+	for _, taskModel := range taskModels {
+		if _, ok := indexes[taskModel.UUID]; !ok {
+			continue
+		}
+
+		for _, dependency := range taskModel.Depends {
+			i, ok := indexes[dependency]
+			if !ok || tasks[i].ParentTaskID != "" || isAncestor(tasks, indexes, dependency, taskModel.UUID) {
+				continue
+			}
+
+			tasks[i].ParentTaskID = taskModel.UUID
+		}
+	}
+}
+
+// isAncestor tells whether id is taskID or one of its parents, so making id a
+// subtask of taskID would make a loop.
+func isAncestor(tasks entity.Tasks, indexes map[string]int, id, taskID string) bool {
+	for taskID != "" {
+		if taskID == id {
+			return true
+		}
+
+		i, ok := indexes[taskID]
+		if !ok {
+			return false
+		}
+
+		taskID = tasks[i].ParentTaskID
+	}
+
+	return false
+}
+
+// addSessions adds the finished Timewarrior intervals of the tasks as their
+// sessions. An interval belongs to the task whose UUID it is tagged with, or
+// else to the only task whose description it is tagged with, which is what
+// the hook shipped with Timewarrior does.
+func (ri *RepoImpl) addSessions(ctx context.Context, tasks entity.Tasks) error {
+	output, err := ri.timewarrior(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(bytes.TrimSpace(output)) == 0 {
+		return nil
+	}
+
+	var intervals []IntervalModel
+	if err = json.Unmarshal(output, &intervals); err != nil {
+		return fmt.Errorf("failed to read Timewarrior intervals: %w", err)
+	}
+
+	indexes := map[string]int{}
+	descriptions := map[string]int{}
+	for i, task := range tasks {
+		indexes[task.ID] = i
+		if _, ok := descriptions[task.Name]; ok {
+			descriptions[task.Name] = -1
+			continue
+		}
+
+		descriptions[task.Name] = i
+	}
+
+	for _, interval := range intervals {
+		if interval.Start == nil || interval.End == nil {
+			continue
+		}
+
+		i := intervalTask(interval, indexes, descriptions)
+		if i < 0 {
+			continue
+		}
+
+		tasks[i].Histories = append(tasks[i].Histories, entity.TaskHistory{
+			StartedAt: interval.Start.value(),
+			StoppedAt: interval.End.value(),
+		})
+	}
+
+	for _, task := range tasks {
+		sort.SliceStable(task.Histories, func(i, j int) bool {
+			return task.Histories[i].StartedAt.Before(task.Histories[j].StartedAt)
+		})
+	}
+
+	return nil
+}
+
+func intervalTask(interval IntervalModel, indexes, descriptions map[string]int) int {
+	for _, tag := range interval.Tags {
+		if i, ok := indexes[tag]; ok {
+			return i
+		}
+	}
+
+	for _, tag := range interval.Tags {
+		if i, ok := descriptions[tag]; ok && i >= 0 {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Encode writes a JSON array with one task per line, like task export does.
+// The description of a task is left out, Taskwarrior has no place for it.
+func (ri *RepoImpl) Encode(ctx context.Context, w io.Writer, transfer entity.Transfer) error {
+	projectNames := map[string]string{}
+	for _, project := range transfer.Projects {
+		projectNames[project.ID] = project.Name
+	}
+
+	dependencies := map[string]Dependencies{}
+	for _, task := range transfer.Tasks {
+		if task.ParentTaskID != "" {
+			dependencies[task.ParentTaskID] = append(dependencies[task.ParentTaskID], taskUUID(task.ID))
+		}
+	}
+
+	annotations := map[string][]AnnotationModel{}
+	for _, note := range transfer.Notes {
+		annotations[note.TaskID] = append(annotations[note.TaskID], AnnotationModel{
+			Entry:       newTimestamp(note.CreatedAt),
+			Description: note.Content,
+		})
+	}
+
+	lines := make([]string, 0, len(transfer.Tasks))
+	for _, task := range transfer.Tasks {
+		taskModel := createTaskModel(task)
+		taskModel.Project = projectNames[task.ProjectID]
+		taskModel.Depends = dependencies[task.ID]
+		taskModel.Annotations = annotations[task.ID]
+		line, err := json.Marshal(taskModel)
+		if err != nil {
+			return fmt.Errorf("failed to encode task: %w", err)
+		}
+
+		lines = append(lines, string(line))
+	}
+
+	if _, err := fmt.Fprintf(w, "[\n%s\n]\n", strings.Join(lines, ",\n")); err != nil {
+		return fmt.Errorf("failed to write tasks: %w", err)
+	}
+
+	return nil
+}
+
+func createTaskModel(task entity.Task) TaskModel {
+	taskModel := TaskModel{
+		UUID:        taskUUID(task.ID),
+		Description: task.Name,
+		Status:      statusPending,
+		Due:         newTimestamp(task.DueAt),
+		Tags:        task.Tags,
+		Priority:    toPriority[task.Priority],
+	}
+
+	// The task was added when it was first started, or completed
+	entry := task.CompletedAt
+	if len(task.Histories) > 0 && (entry.IsZero() || task.Histories[0].StartedAt.Before(entry)) {
+		entry = task.Histories[0].StartedAt
+	}
+
+	taskModel.Entry = newTimestamp(entry)
+	if !task.CompletedAt.IsZero() {
+		taskModel.Status = statusCompleted
+		taskModel.End = newTimestamp(task.CompletedAt)
+	}
+
+	if task.IsStarted {
+		taskModel.Start = newTimestamp(task.SessionStartedAt())
+	}
+
+	// Priorities below C are all low
+	if taskModel.Priority == "" && task.Priority != "" {
+		taskModel.Priority = "L"
+	}
+
+	return taskModel
+}
+
+// taskUUID returns the ID of a task, which is a UUID unless it was imported
+// from elsewhere, then a UUID is derived from it.
+func taskUUID(id string) string {
+	if _, err := uuid.Parse(id); err == nil {
+		return id
+	}
+
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("todo-cli:"+id)).String()
+}
@@ -0,0 +1,154 @@
+package taskwarrior
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	uuid1 = "5b1a7f0e-35c4-4d89-9b5e-0f5d0d7c1a01"
+	uuid2 = "5b1a7f0e-35c4-4d89-9b5e-0f5d0d7c1a02"
+	uuid3 = "5b1a7f0e-35c4-4d89-9b5e-0f5d0d7c1a03"
+)
+
+func TestDecode(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	content := `[
+{"uuid":"` + uuid1 + `","description":"Paint the fence","status":"pending","entry":"20240101T090000Z","project":"Home","tags":["weekend"],"priority":"H","depends":["` + uuid2 + `","` + uuid3 + `"],"due":"20240106T000000Z","annotations":[{"entry":"20240102T080000Z","description":"Buy white paint"}]},
+{"uuid":"` + uuid2 + `","description":"Sand","status":"completed","end":"20240103T100000Z","project":"Home","depends":"` + uuid1 + `"},
+{"uuid":"` + uuid3 + `","description":"Prime","status":"completed","priority":"L"},
+{"uuid":"5b1a7f0e-35c4-4d89-9b5e-0f5d0d7c1a04","description":"Gone","status":"deleted"}
+]`
+	intervals := `[
+{"id":3,"start":"20240102T090000Z","end":"20240102T100000Z","tags":["Home","Paint the fence"]},
+{"id":2,"start":"20240101T090000Z","end":"20240101T093000Z","tags":["` + uuid1 + `"]},
+{"id":1,"start":"20240103T090000Z","tags":["Sand"]}
+]`
+	repoImpl := &RepoImpl{
+		now: func() time.Time { return now },
+		timewarrior: func(ctx context.Context) ([]byte, error) {
+			return []byte(intervals), nil
+		},
+	}
+
+	transfer, err := repoImpl.Decode(context.Background(), strings.NewReader(content))
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.Projects{{Name: "Home"}}, transfer.Projects)
+	assert.Equal(t, entity.Tasks{
+		{
+			ID:        uuid1,
+			ProjectID: "Home",
+			Name:      "Paint the fence",
+			Priority:  "A",
+			Tags:      []string{"weekend"},
+			DueAt:     time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC).Local(),
+			Histories: []entity.TaskHistory{
+				{StartedAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC).Local(), StoppedAt: time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC).Local()},
+				{StartedAt: time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC).Local(), StoppedAt: time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC).Local()},
+			},
+		},
+		// The dependency back on its parent would make a loop
+		{ID: uuid2, ProjectID: "Home", Name: "Sand", ParentTaskID: uuid1, CompletedAt: time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC).Local()},
+		{ID: uuid3, Name: "Prime", Priority: "C", ParentTaskID: uuid1, CompletedAt: now},
+	}, transfer.Tasks)
+	assert.Equal(t, entity.TaskNotes{
+		{TaskID: uuid1, Content: "Buy white paint", CreatedAt: time.Date(2024, 1, 2, 8, 0, 0, 0, time.UTC).Local()},
+	}, transfer.Notes)
+}
+
+func TestDecodeLines(t *testing.T) {
+	content := `{"uuid":"` + uuid1 + `","description":"Paint the fence","status":"pending"},
+{"uuid":"` + uuid2 + `","description":"Sand","status":"waiting"}
+`
+	repoImpl := &RepoImpl{timewarrior: func(ctx context.Context) ([]byte, error) { return nil, nil }}
+
+	transfer, err := repoImpl.Decode(context.Background(), strings.NewReader(content))
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.Tasks{{ID: uuid1, Name: "Paint the fence"}, {ID: uuid2, Name: "Sand"}}, transfer.Tasks)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		timewarrior func(ctx context.Context) ([]byte, error)
+		expectedErr string
+	}{
+		{
+			name:        "invalid date",
+			content:     `[{"uuid":"` + uuid1 + `","description":"Sand","status":"pending","due":"tomorrow"}]`,
+			timewarrior: func(ctx context.Context) ([]byte, error) { return nil, nil },
+			expectedErr: "failed to read tasks",
+		},
+		{
+			name:        "invalid line",
+			content:     "{\"uuid\":\"" + uuid1 + "\"}\nnot json\n",
+			timewarrior: func(ctx context.Context) ([]byte, error) { return nil, nil },
+			expectedErr: "failed to read task on line 2",
+		},
+		{
+			name:        "failed to run timew",
+			content:     "[]",
+			timewarrior: func(ctx context.Context) ([]byte, error) { return nil, errors.New("any-error") },
+			expectedErr: "any-error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repoImpl := &RepoImpl{now: time.Now, timewarrior: test.timewarrior}
+
+			_, err := repoImpl.Decode(context.Background(), strings.NewReader(test.content))
+
+			assert.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	startedAt := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	transfer := entity.Transfer{
+		Projects: entity.Projects{{ID: "project-1", Name: "Home"}},
+		Tasks: entity.Tasks{
+			{
+				ID:        uuid1,
+				ProjectID: "project-1",
+				Name:      "Paint the fence",
+				Priority:  "D",
+				Tags:      []string{"weekend"},
+				DueAt:     time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+				IsStarted: true,
+				Histories: []entity.TaskHistory{{StartedAt: startedAt}},
+			},
+			{
+				ID:           "imported-id",
+				ProjectID:    "project-1",
+				Name:         "Sand",
+				ParentTaskID: uuid1,
+				CompletedAt:  time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		Notes: entity.TaskNotes{{TaskID: uuid1, Content: "Buy white paint", CreatedAt: startedAt}},
+	}
+	var buf bytes.Buffer
+
+	err := New().Encode(context.Background(), &buf, transfer)
+
+	derivedUUID := taskUUID("imported-id")
+	assert.NoError(t, err)
+	assert.Equal(t, `[
+{"uuid":"`+uuid1+`","description":"Paint the fence","status":"pending","entry":"20240102T090000Z","start":"20240102T090000Z","due":"20240106T000000Z","project":"Home","tags":["weekend"],"priority":"L","depends":["`+derivedUUID+`"],"annotations":[{"entry":"20240102T090000Z","description":"Buy white paint"}]},
+{"uuid":"`+derivedUUID+`","description":"Sand","status":"completed","entry":"20240103T100000Z","end":"20240103T100000Z","project":"Home"}
+]
+`, buf.String())
+	assert.Equal(t, derivedUUID, taskUUID("imported-id"))
+}
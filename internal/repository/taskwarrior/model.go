@@ -0,0 +1,113 @@
+package taskwarrior
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const (
+	timeLayout = "20060102T150405Z"
+
+	statusPending   = "pending"
+	statusWaiting   = "waiting"
+	statusCompleted = "completed"
+	statusDeleted   = "deleted"
+	statusRecurring = "recurring"
+)
+
+// TaskModel is a task as written by task export, see
+// https://taskwarrior.org/docs/design/task/.
+type TaskModel struct {
+	UUID        string            `json:"uuid"`
+	Description string            `json:"description"`
+	Status      string            `json:"status"`
+	Entry       *Timestamp        `json:"entry,omitempty"`
+	Start       *Timestamp        `json:"start,omitempty"`
+	End         *Timestamp        `json:"end,omitempty"`
+	Due         *Timestamp        `json:"due,omitempty"`
+	Project     string            `json:"project,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Priority    string            `json:"priority,omitempty"`
+	Depends     Dependencies      `json:"depends,omitempty"`
+	Annotations []AnnotationModel `json:"annotations,omitempty"`
+}
+
+type AnnotationModel struct {
+	Entry       *Timestamp `json:"entry,omitempty"`
+	Description string     `json:"description"`
+}
+
+// IntervalModel is a time interval as written by timew export, it has no end
+// while it is being tracked.
+type IntervalModel struct {
+	Start *Timestamp `json:"start"`
+	End   *Timestamp `json:"end,omitempty"`
+	Tags  []string   `json:"tags,omitempty"`
+}
+
+// Timestamp is a time in the UTC basic ISO 8601 format Taskwarrior uses.
+type Timestamp struct {
+	time.Time
+}
+
+func newTimestamp(t time.Time) *Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &Timestamp{Time: t}
+}
+
+func (t *Timestamp) value() time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return t.Local()
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.UTC().Format(timeLayout))
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	parsed, err := time.Parse(timeLayout, value)
+	if err != nil {
+		return err
+	}
+
+	t.Time = parsed
+	return nil
+}
+
+// Dependencies are the UUIDs of the tasks a task depends on. Taskwarrior
+// before 2.6 writes them as a single comma separated string.
+type Dependencies []string
+
+func (d *Dependencies) UnmarshalJSON(data []byte) error {
+	var uuids []string
+	if err := json.Unmarshal(data, &uuids); err == nil {
+		*d = uuids
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*d = nil
+	for _, uuid := range strings.Split(value, ",") {
+		if uuid = strings.TrimSpace(uuid); uuid != "" {
+			*d = append(*d, uuid)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package todotxt
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	// Extensions written as key:value
+	keyID       = "id"
+	keyParent   = "parent"
+	keyDue      = "due"
+	keyPriority = "pri"
+)
+
+var (
+	priorityPattern = regexp.MustCompile(`^\(([A-Z])\)$`)
+	datePattern     = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+)
+
+// RepoImpl reads and writes todo.txt files, see
+// https://github.com/todotxt/todo.txt. The first +project of a task is its
+// project, @contexts are its tags, and the id:, parent:, due: and pri:
+// extensions keep the task ID, its parent task, its due date and the priority
+// of a completed task.
+type RepoImpl struct {
+	now func() time.Time
+}
+
+func New() *RepoImpl {
+	return &RepoImpl{now: time.Now}
+}
+
+func (ri *RepoImpl) Decode(ctx context.Context, r io.Reader) (entity.Transfer, error) {
+	var transfer entity.Transfer
+	projects := map[string]bool{}
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		task, err := ri.parseTask(line)
+		if err != nil {
+			return entity.Transfer{}, fmt.Errorf("failed to parse line %d: %w", lineNumber, err)
+		}
+
+		if task.ProjectID != "" && !projects[task.ProjectID] {
+			projects[task.ProjectID] = true
+			transfer.Projects = append(transfer.Projects, entity.Project{Name: task.ProjectID})
+		}
+
+		transfer.Tasks = append(transfer.Tasks, task)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return entity.Transfer{}, fmt.Errorf("failed to read todo.txt: %w", err)
+	}
+
+	return transfer, nil
+}
+
+// parseTask reads a line such as
+// x 2024-01-02 2024-01-01 Paint the fence +Home @weekend due:2024-01-06.
+func (ri *RepoImpl) parseTask(line string) (entity.Task, error) {
+	var task entity.Task
+	tokens := strings.Fields(line)
+	if tokens[0] == "x" {
+		task.CompletedAt = ri.now()
+		tokens = tokens[1:]
+		if len(tokens) > 0 && datePattern.MatchString(tokens[0]) {
+			completedAt, err := time.ParseInLocation(dateLayout, tokens[0], time.Local)
+			if err != nil {
+				return entity.Task{}, fmt.Errorf("failed to parse completion date: %w", err)
+			}
+
+			task.CompletedAt = completedAt
+			tokens = tokens[1:]
+		}
+	}
+
+	if len(tokens) > 0 {
+		if match := priorityPattern.FindStringSubmatch(tokens[0]); match != nil {
+			task.Priority = match[1]
+			tokens = tokens[1:]
+		}
+	}
+
+	// The creation date is not kept
+	if len(tokens) > 0 && datePattern.MatchString(tokens[0]) {
+		tokens = tokens[1:]
+	}
+
+	var words []string
+	for _, token := range tokens {
+		switch key, value, ok := extension(token); {
+		case strings.HasPrefix(token, "+") && len(token) > 1 && task.ProjectID == "":
+			task.ProjectID = token[1:]
+		case strings.HasPrefix(token, "@") && len(token) > 1:
+			task.Tags = append(task.Tags, token[1:])
+		case ok && key == keyID:
+			task.ID = value
+		case ok && key == keyParent:
+			task.ParentTaskID = value
+		case ok && key == keyDue:
+			dueAt, err := time.ParseInLocation(dateLayout, value, time.Local)
+			if err != nil {
+				return entity.Task{}, fmt.Errorf("failed to parse due date: %w", err)
+			}
+
+			task.DueAt = dueAt
+		case ok && key == keyPriority && task.Priority == "" && len(value) == 1 && unicode.IsUpper(rune(value[0])):
+			task.Priority = value
+		default:
+			words = append(words, token)
+		}
+	}
+
+	task.Name = strings.Join(words, " ")
+	if task.Name == "" {
+		return entity.Task{}, fmt.Errorf("task without a description: %s", line)
+	}
+
+	return task, nil
+}
+
+// extension splits a key:value token, URLs are not extensions.
+func extension(token string) (string, string, bool) {
+	key, value, ok := strings.Cut(token, ":")
+	if !ok || key == "" || value == "" || strings.HasPrefix(value, "//") || strings.ContainsAny(key, "+@") {
+		return "", "", false
+	}
+
+	return strings.ToLower(key), value, true
+}
+
+// Encode writes one line per task, open tasks first as todo.txt clients keep
+// completed ones at the end or in done.txt. The description of a task and
+// its time spent are left out.
+func (ri *RepoImpl) Encode(ctx context.Context, w io.Writer, transfer entity.Transfer) error {
+	projectNames := map[string]string{}
+	for _, project := range transfer.Projects {
+		projectNames[project.ID] = project.Name
+	}
+
+	tasks := append(entity.Tasks{}, transfer.Tasks...)
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].CompletedAt.IsZero() && !tasks[j].CompletedAt.IsZero()
+	})
+
+	for _, task := range tasks {
+		if _, err := fmt.Fprintln(w, formatTask(task, projectNames[task.ProjectID])); err != nil {
+			return fmt.Errorf("failed to write todo.txt: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func formatTask(task entity.Task, projectName string) string {
+	var tokens []string
+	if !task.CompletedAt.IsZero() {
+		// A completion date needs a creation date, the first session is the
+		// closest we know
+		createdAt := task.CompletedAt
+		if len(task.Histories) > 0 && task.Histories[0].StartedAt.Before(createdAt) {
+			createdAt = task.Histories[0].StartedAt
+		}
+
+		tokens = append(tokens, "x", task.CompletedAt.Local().Format(dateLayout), createdAt.Local().Format(dateLayout))
+	} else if task.Priority != "" {
+		tokens = append(tokens, "("+task.Priority+")")
+	}
+
+	tokens = append(tokens, strings.Fields(task.Name)...)
+	if projectName != "" {
+		tokens = append(tokens, "+"+word(projectName))
+	}
+
+	for _, tag := range task.Tags {
+		tokens = append(tokens, "@"+word(tag))
+	}
+
+	if !task.DueAt.IsZero() {
+		tokens = append(tokens, keyDue+":"+task.DueAt.Local().Format(dateLayout))
+	}
+
+	if !task.CompletedAt.IsZero() && task.Priority != "" {
+		tokens = append(tokens, keyPriority+":"+task.Priority)
+	}
+
+	tokens = append(tokens, keyID+":"+task.ID)
+	if task.ParentTaskID != "" {
+		tokens = append(tokens, keyParent+":"+task.ParentTaskID)
+	}
+
+	return strings.Join(tokens, " ")
+}
+
+// word joins the words of a name with underscores, todo.txt projects and
+// contexts can not contain spaces.
+func word(name string) string {
+	return strings.Join(strings.Fields(name), "_")
+}
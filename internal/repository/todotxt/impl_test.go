@@ -0,0 +1,98 @@
+package todotxt
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	now := time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC)
+	repoImpl := &RepoImpl{now: func() time.Time { return now }}
+	content := `(A) 2024-01-01 Call Mom +Family @phone due:2024-01-05
+Paint the fence +Home_Improvement +Garden @weekend @outside see https://example.com/paint id:task-1
+
+x 2024-01-02 2024-01-01 Buy paint +Home_Improvement pri:B id:task-2 parent:task-1
+x Done without a date
+`
+
+	transfer, err := repoImpl.Decode(context.Background(), strings.NewReader(content))
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.Projects{{Name: "Family"}, {Name: "Home_Improvement"}}, transfer.Projects)
+	assert.Equal(t, entity.Tasks{
+		{ProjectID: "Family", Name: "Call Mom", Priority: "A", Tags: []string{"phone"}, DueAt: time.Date(2024, 1, 5, 0, 0, 0, 0, time.Local)},
+		{ID: "task-1", ProjectID: "Home_Improvement", Name: "Paint the fence +Garden see https://example.com/paint", Tags: []string{"weekend", "outside"}},
+		{ID: "task-2", ProjectID: "Home_Improvement", Name: "Buy paint", Priority: "B", ParentTaskID: "task-1", CompletedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.Local)},
+		{Name: "Done without a date", CompletedAt: now},
+	}, transfer.Tasks)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectedErr string
+	}{
+		{
+			name:        "invalid due date",
+			content:     "Call Mom\nPay rent due:tomorrow\n",
+			expectedErr: "failed to parse line 2: failed to parse due date",
+		},
+		{
+			name:        "without description",
+			content:     "(A) +Home @phone\n",
+			expectedErr: "failed to parse line 1: task without a description",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New().Decode(context.Background(), strings.NewReader(test.content))
+
+			assert.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	transfer := entity.Transfer{
+		Projects: entity.Projects{{ID: "project-1", Name: "Home Improvement"}},
+		Tasks: entity.Tasks{
+			{
+				ID:          "task-2",
+				ProjectID:   "project-1",
+				Name:        "Buy paint",
+				Priority:    "B",
+				CompletedAt: time.Date(2024, 1, 2, 15, 0, 0, 0, time.Local),
+				Histories:   []entity.TaskHistory{{StartedAt: time.Date(2023, 12, 30, 9, 0, 0, 0, time.Local)}},
+			},
+			{ID: "task-1", ProjectID: "project-1", Name: "Paint the fence", Priority: "A", Tags: []string{"weekend", "long tag"}, DueAt: time.Date(2024, 1, 6, 0, 0, 0, 0, time.Local)},
+			{ID: "task-3", ProjectID: "project-1", Name: "Sand", ParentTaskID: "task-1"},
+		},
+	}
+	var buf bytes.Buffer
+
+	err := New().Encode(context.Background(), &buf, transfer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `(A) Paint the fence +Home_Improvement @weekend @long_tag due:2024-01-06 id:task-1
+Sand +Home_Improvement id:task-3 parent:task-1
+x 2024-01-02 2023-12-30 Buy paint +Home_Improvement pri:B id:task-2
+`, buf.String())
+
+	// What is written reads back the same
+	decoded, err := New().Decode(context.Background(), &buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "task-1", decoded.Tasks[0].ID)
+	assert.Equal(t, []string{"weekend", "long_tag"}, decoded.Tasks[0].Tags)
+	assert.Equal(t, "task-1", decoded.Tasks[1].ParentTaskID)
+	assert.Equal(t, "B", decoded.Tasks[2].Priority)
+	assert.True(t, entity.SameProjectName("Home Improvement", decoded.Projects[0].Name))
+}
@@ -0,0 +1,72 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Conn is what the repositories run their queries on, the transaction of
+// the context or the database itself.
+type Conn interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txKey struct{}
+
+// From returns the transaction ctx runs in, or db outside of one.
+func From(ctx context.Context, db *sql.DB) Conn {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+
+	return db
+}
+
+// Run calls fn in a transaction which is committed when fn succeeds and
+// rolled back otherwise. Repositories given the context passed to fn take
+// part in it, and a Run inside fn joins the outer transaction.
+func Run(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err = fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+type RepoImpl struct {
+	db *sql.DB
+}
+
+func New(db *sql.DB) *RepoImpl {
+	return &RepoImpl{db: db}
+}
+
+func (ri *RepoImpl) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return Run(ctx, ri.db, fn)
+}
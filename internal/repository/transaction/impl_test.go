@@ -0,0 +1,129 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RepoImplTestSuite struct {
+	suite.Suite
+	db       *sql.DB
+	mock     sqlmock.Sqlmock
+	repoImpl *RepoImpl
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	db, mock, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.db = db
+	s.mock = mock
+	s.repoImpl = New(db)
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+func (s *RepoImplTestSuite) TestWithinTransaction() {
+	insert := func(ctx context.Context, name string) error {
+		_, err := From(ctx, s.db).ExecContext(ctx, `INSERT INTO projects (name) VALUES (?)`, name)
+		return err
+	}
+
+	tests := []struct {
+		name        string
+		expectedErr error
+		mock        func()
+		fn          func(ctx context.Context) error
+	}{
+		{
+			name:        "failed to begin transaction",
+			expectedErr: errors.New("any-error"),
+			mock: func() {
+				s.mock.ExpectBegin().WillReturnError(errors.New("any-error"))
+			},
+			fn: func(ctx context.Context) error {
+				return nil
+			},
+		},
+		{
+			name:        "rolled back when failing midway",
+			expectedErr: errors.New("any-error"),
+			mock: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(`INSERT INTO projects (name) VALUES (?)`).WithArgs("first").WillReturnResult(sqlmock.NewResult(1, 1))
+				s.mock.ExpectExec(`INSERT INTO projects (name) VALUES (?)`).WithArgs("second").WillReturnError(errors.New("any-error"))
+				s.mock.ExpectRollback()
+			},
+			fn: func(ctx context.Context) error {
+				if err := insert(ctx, "first"); err != nil {
+					return err
+				}
+
+				return insert(ctx, "second")
+			},
+		},
+		{
+			name:        "failed to commit",
+			expectedErr: errors.New("any-error"),
+			mock: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(`INSERT INTO projects (name) VALUES (?)`).WithArgs("first").WillReturnResult(sqlmock.NewResult(1, 1))
+				s.mock.ExpectCommit().WillReturnError(errors.New("any-error"))
+			},
+			fn: func(ctx context.Context) error {
+				return insert(ctx, "first")
+			},
+		},
+		{
+			name: "nested transaction joins the outer one",
+			mock: func() {
+				s.mock.ExpectBegin()
+				s.mock.ExpectExec(`INSERT INTO projects (name) VALUES (?)`).WithArgs("first").WillReturnResult(sqlmock.NewResult(1, 1))
+				s.mock.ExpectExec(`INSERT INTO projects (name) VALUES (?)`).WithArgs("second").WillReturnResult(sqlmock.NewResult(2, 1))
+				s.mock.ExpectCommit()
+			},
+			fn: func(ctx context.Context) error {
+				if err := insert(ctx, "first"); err != nil {
+					return err
+				}
+
+				return s.repoImpl.WithinTransaction(ctx, func(ctx context.Context) error {
+					return insert(ctx, "second")
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.mock()
+
+			err := s.repoImpl.WithinTransaction(context.Background(), tt.fn)
+			if unwrappedErr := errors.Unwrap(err); unwrappedErr != nil {
+				err = unwrappedErr
+			}
+
+			s.Equal(tt.expectedErr, err)
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func (s *RepoImplTestSuite) TestFrom() {
+	s.Equal(s.db, From(context.Background(), s.db))
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectCommit()
+
+	err := Run(context.Background(), s.db, func(ctx context.Context) error {
+		s.IsType(&sql.Tx{}, From(ctx, s.db))
+		return nil
+	})
+
+	s.NoError(err)
+}
@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/azisuazusa/todo-cli/internal/repository/event"
+)
+
+const (
+	SignatureHeader = "X-Todo-CLI-Signature"
+	EventHeader     = "X-Todo-CLI-Event"
+
+	defaultRetries = 3
+)
+
+type SecretRepo interface {
+	Get(ctx context.Context, key string) (string, error)
+}
+
+// RepoImpl posts task events to the webhooks listed in a JSON config file.
+type RepoImpl struct {
+	configPath string
+	secretRepo SecretRepo
+	httpClient *http.Client
+	retryDelay time.Duration
+}
+
+func New(configPath string, secretRepo SecretRepo) *RepoImpl {
+	return &RepoImpl{
+		configPath: configPath,
+		secretRepo: secretRepo,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		retryDelay: time.Second,
+	}
+}
+
+func (ri *RepoImpl) Handle(ctx context.Context, eventEntity entity.Event) error {
+	webhooks, err := ri.getWebhooks()
+	if err != nil {
+		return err
+	}
+
+	payload := event.CreateEventModel(eventEntity)
+	var errs []error
+	for _, webhook := range webhooks {
+		if !webhook.IsSubscribed(payload.Event) {
+			continue
+		}
+
+		if err = ri.send(ctx, webhook, payload.Event, payload); err != nil {
+			errs = append(errs, fmt.Errorf("failed to send webhook to %s: %w", webhook.URL, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// PostStandup posts the standup summary to the webhooks subscribed to Standup
+// events. Unlike task events, it is only sent to the webhooks listing it.
+func (ri *RepoImpl) PostStandup(ctx context.Context, text string) error {
+	webhooks, err := ri.getWebhooks()
+	if err != nil {
+		return err
+	}
+
+	payload := StandupModel{Event: StandupEvent, OccurredAt: time.Now(), Text: text}
+	var errs []error
+	isPosted := false
+	for _, webhook := range webhooks {
+		if !slices.Contains(webhook.Events, StandupEvent) {
+			continue
+		}
+
+		isPosted = true
+		webhook.Template = webhook.StandupTemplate
+		if err = ri.send(ctx, webhook, payload.Event, payload); err != nil {
+			errs = append(errs, fmt.Errorf("failed to send webhook to %s: %w", webhook.URL, err))
+		}
+	}
+
+	if !isPosted {
+		return entity.ErrNoStandupWebhook
+	}
+
+	return errors.Join(errs...)
+}
+
+func (ri *RepoImpl) getWebhooks() ([]WebhookModel, error) {
+	content, err := os.ReadFile(ri.configPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhooks config: %w", err)
+	}
+
+	var webhooks []WebhookModel
+	if err = json.Unmarshal(content, &webhooks); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhooks config: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (ri *RepoImpl) send(ctx context.Context, webhook WebhookModel, eventName string, payload any) error {
+	body, err := createBody(webhook, payload)
+	if err != nil {
+		return err
+	}
+
+	secret := webhook.Secret
+	if entity.IsSecretReference(secret) {
+		secret, err = ri.secretRepo.Get(ctx, strings.TrimPrefix(secret, entity.SecretReferencePrefix))
+		if err != nil {
+			return fmt.Errorf("failed to resolve webhook secret: %w", err)
+		}
+	}
+
+	retries := defaultRetries
+	if webhook.Retries != nil {
+		retries = *webhook.Retries
+	}
+
+	for attempt := 0; ; attempt++ {
+		isRetryable, err := ri.post(ctx, webhook, eventName, secret, body)
+		if err == nil {
+			return nil
+		}
+
+		if !isRetryable || attempt >= retries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(ri.retryDelay << attempt):
+		}
+	}
+}
+
+// post sends the request once and reports whether a failure is worth retrying.
+func (ri *RepoImpl) post(ctx context.Context, webhook WebhookModel, eventName, secret string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	contentType := webhook.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(EventHeader, eventName)
+	for key, value := range webhook.Headers {
+		req.Header.Set(key, value)
+	}
+
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+
+	resp, err := ri.httpClient.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	isRetryable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return isRetryable, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+}
+
+// Sign returns the signature receivers use to verify a payload, the hex
+// encoded HMAC-SHA256 of the body prefixed with "sha256=".
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func createBody(webhook WebhookModel, payload any) ([]byte, error) {
+	if webhook.Template == "" {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal payload: %w", err)
+		}
+
+		return body, nil
+	}
+
+	tmpl, err := template.New("webhook").Funcs(template.FuncMap{
+		"json": func(value any) (string, error) {
+			content, err := json.Marshal(value)
+			return string(content), err
+		},
+	}).Parse(webhook.Template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var body bytes.Buffer
+	if err = tmpl.Execute(&body, payload); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return body.Bytes(), nil
+}
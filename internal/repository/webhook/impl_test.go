@@ -0,0 +1,238 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/azisuazusa/todo-cli/internal/domain/entity"
+	"github.com/stretchr/testify/suite"
+)
+
+type secretRepoStub struct {
+	secrets map[string]string
+}
+
+func (r *secretRepoStub) Get(ctx context.Context, key string) (string, error) {
+	value, ok := r.secrets[key]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+
+	return value, nil
+}
+
+type request struct {
+	header http.Header
+	body   string
+}
+
+type RepoImplTestSuite struct {
+	suite.Suite
+	server    *httptest.Server
+	requests  []request
+	failures  int
+	repoImpl  *RepoImpl
+	event     entity.Event
+	configDir string
+}
+
+func (s *RepoImplTestSuite) SetupTest() {
+	s.requests = nil
+	s.failures = 0
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		s.requests = append(s.requests, request{header: r.Header, body: string(body)})
+		if r.URL.Path == "/bad-request" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if s.failures > 0 {
+			s.failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	}))
+	s.configDir = s.T().TempDir()
+	s.repoImpl = &RepoImpl{
+		configPath: filepath.Join(s.configDir, "webhooks.json"),
+		secretRepo: &secretRepoStub{secrets: map[string]string{"webhook/secret": "stored-secret"}},
+		httpClient: s.server.Client(),
+		retryDelay: time.Millisecond,
+	}
+	s.event = entity.Event{
+		Type: entity.EventTypeTaskStarted,
+		Task: entity.Task{
+			ID:        "task-1",
+			ProjectID: "project-1",
+			Name:      "any \"name\"",
+			Integration: entity.TaskIntegration{
+				ID:   "TODO-1",
+				Type: entity.IntegrationTypeJIRA,
+			},
+		},
+		OccurredAt: time.Date(2024, 3, 11, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func (s *RepoImplTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func TestRepoImpl(t *testing.T) {
+	suite.Run(t, new(RepoImplTestSuite))
+}
+
+func (s *RepoImplTestSuite) writeConfig(webhooks []WebhookModel) {
+	content, err := json.Marshal(webhooks)
+	s.NoError(err)
+	s.NoError(os.WriteFile(s.repoImpl.configPath, content, 0600))
+}
+
+func retries(n int) *int {
+	return &n
+}
+
+func (s *RepoImplTestSuite) TestHandleWithoutConfig() {
+	s.NoError(s.repoImpl.Handle(context.Background(), s.event))
+	s.Empty(s.requests)
+}
+
+func (s *RepoImplTestSuite) TestHandle() {
+	tests := []struct {
+		name         string
+		webhook      WebhookModel
+		failures     int
+		expectedBody string
+		expectedSig  string
+		expectedReqs int
+		expectedErr  bool
+	}{
+		{
+			name:         "default payload",
+			webhook:      WebhookModel{URL: s.server.URL},
+			expectedBody: `{"event":"TaskStarted","occurred_at":"2024-03-11T10:00:00Z","task":{"id":"task-1","project_id":"project-1","name":"any \"name\"","description":"","is_started":false,"integration_id":"TODO-1","integration_type":"JIRA","time_spent_seconds":0}}`,
+			expectedReqs: 1,
+		},
+		{
+			name: "templated body with signature",
+			webhook: WebhookModel{
+				URL:      s.server.URL,
+				Secret:   "any-secret",
+				Template: `{"text": {{ json (printf "Started %s (%s)" .Task.Name .Task.IntegrationID) }}}`,
+			},
+			expectedBody: `{"text": "Started any \"name\" (TODO-1)"}`,
+			expectedSig:  Sign("any-secret", []byte(`{"text": "Started any \"name\" (TODO-1)"}`)),
+			expectedReqs: 1,
+		},
+		{
+			name: "secret reference",
+			webhook: WebhookModel{
+				URL:      s.server.URL,
+				Secret:   "secret:webhook/secret",
+				Template: `{{ .Event }}`,
+			},
+			expectedBody: `TaskStarted`,
+			expectedSig:  Sign("stored-secret", []byte(`TaskStarted`)),
+			expectedReqs: 1,
+		},
+		{
+			name: "not subscribed",
+			webhook: WebhookModel{
+				URL:    s.server.URL,
+				Events: []string{string(entity.EventTypeTaskCompleted)},
+			},
+			expectedReqs: 0,
+		},
+		{
+			name:         "retried until success",
+			webhook:      WebhookModel{URL: s.server.URL, Template: `ok`},
+			failures:     2,
+			expectedBody: `ok`,
+			expectedReqs: 3,
+		},
+		{
+			name:         "retries exhausted",
+			webhook:      WebhookModel{URL: s.server.URL, Template: `ok`, Retries: retries(1)},
+			failures:     5,
+			expectedReqs: 2,
+			expectedErr:  true,
+		},
+		{
+			name:         "client error is not retried",
+			webhook:      WebhookModel{URL: s.server.URL + "/bad-request"},
+			expectedReqs: 1,
+			expectedErr:  true,
+		},
+		{
+			name:        "invalid template",
+			webhook:     WebhookModel{URL: s.server.URL, Template: `{{ .Unknown`},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.requests = nil
+			s.failures = test.failures
+			s.writeConfig([]WebhookModel{test.webhook})
+
+			err := s.repoImpl.Handle(context.Background(), s.event)
+			s.Len(s.requests, test.expectedReqs)
+			if test.expectedErr {
+				s.Error(err)
+				return
+			}
+
+			s.NoError(err)
+			if test.expectedReqs == 0 {
+				return
+			}
+
+			last := s.requests[len(s.requests)-1]
+			s.Equal(test.expectedBody, last.body)
+			s.Equal(test.expectedSig, last.header.Get(SignatureHeader))
+			s.Equal(string(entity.EventTypeTaskStarted), last.header.Get(EventHeader))
+		})
+	}
+}
+
+func (s *RepoImplTestSuite) TestPostStandup() {
+	s.writeConfig([]WebhookModel{
+		{URL: s.server.URL},
+		{
+			URL:             s.server.URL,
+			Events:          []string{"TaskStarted", StandupEvent},
+			Secret:          "any-secret",
+			Template:        `{"text": {{ json .Task.Name }}}`,
+			StandupTemplate: `{"text": {{ json .Text }}}`,
+		},
+		{URL: s.server.URL, Events: []string{StandupEvent}, Template: `{"text": {{ json .Task.Name }}}`},
+	})
+
+	err := s.repoImpl.PostStandup(context.Background(), "**Yesterday**\n- Review\n")
+
+	s.NoError(err)
+	s.Require().Len(s.requests, 2)
+	s.Equal(`{"text": "**Yesterday**\n- Review\n"}`, s.requests[0].body)
+	s.Contains(s.requests[1].body, `"text":"**Yesterday**\n- Review\n"`)
+	s.Equal(Sign("any-secret", []byte(s.requests[0].body)), s.requests[0].header.Get(SignatureHeader))
+	s.Equal(StandupEvent, s.requests[0].header.Get(EventHeader))
+}
+
+func (s *RepoImplTestSuite) TestPostStandupWithoutWebhook() {
+	s.writeConfig([]WebhookModel{{URL: s.server.URL}})
+
+	err := s.repoImpl.PostStandup(context.Background(), "any-standup")
+
+	s.ErrorIs(err, entity.ErrNoStandupWebhook)
+	s.Empty(s.requests)
+}
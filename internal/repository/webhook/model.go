@@ -0,0 +1,41 @@
+package webhook
+
+import "time"
+
+// StandupEvent is what webhooks list in their events to receive standups.
+const StandupEvent = "Standup"
+
+type WebhookModel struct {
+	URL      string   `json:"url"`
+	Secret   string   `json:"secret"`
+	Events   []string `json:"events"`
+	Template string   `json:"template"`
+	// StandupTemplate renders standups, Template is only executed with task
+	// events.
+	StandupTemplate string            `json:"standup_template"`
+	ContentType     string            `json:"content_type"`
+	Headers         map[string]string `json:"headers"`
+	Retries         *int              `json:"retries"`
+}
+
+func (w WebhookModel) IsSubscribed(event string) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+
+	for _, subscribedEvent := range w.Events {
+		if subscribedEvent == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StandupModel is the payload of a posted standup, Text is the rendered
+// summary.
+type StandupModel struct {
+	Event      string    `json:"event"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Text       string    `json:"text"`
+}